@@ -6,6 +6,7 @@ import (
 	"go-scaffold/internal/app/controller"
 	"go-scaffold/internal/app/facade"
 	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/service"
 	"go-scaffold/internal/app/usecase"
 )
 
@@ -14,4 +15,5 @@ var ProviderSet = wire.NewSet(
 	controller.ProviderSet,
 	usecase.ProviderSet,
 	repository.ProviderSet,
+	service.ProviderSet,
 )
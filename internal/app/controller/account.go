@@ -2,31 +2,52 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"math"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
-	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/service"
 	"go-scaffold/internal/app/usecase"
 	berr "go-scaffold/internal/errors"
 	"go-scaffold/pkg/validator"
 )
 
 type AccountController struct {
+	logger   *slog.Logger
+	hasher   domain.PasswordHasher
 	auc      usecase.AccountUseCaseInterface
+	tfuc     usecase.TwoFactorUseCaseInterface
+	ltuc     usecase.LoginThrottleUseCaseInterface
+	rcuc     usecase.AccountRecoveryUseCaseInterface
+	oiduc    usecase.OIDCUseCaseInterface
 	uuc      usecase.UserUseCaseInterface
 	userRepo repository.UserRepositoryInterface
 }
 
 func NewAccountController(
+	logger *slog.Logger,
+	hasher domain.PasswordHasher,
 	auc usecase.AccountUseCaseInterface,
+	tfuc usecase.TwoFactorUseCaseInterface,
+	ltuc usecase.LoginThrottleUseCaseInterface,
+	rcuc usecase.AccountRecoveryUseCaseInterface,
+	oiduc usecase.OIDCUseCaseInterface,
 	uuc usecase.UserUseCaseInterface,
 	userRepo repository.UserRepositoryInterface,
 ) *AccountController {
 	return &AccountController{
+		logger:   logger,
+		hasher:   hasher,
 		auc:      auc,
+		tfuc:     tfuc,
+		ltuc:     ltuc,
+		rcuc:     rcuc,
+		oiduc:    oiduc,
 		uuc:      uuc,
 		userRepo: userRepo,
 	}
@@ -34,21 +55,23 @@ func NewAccountController(
 
 type AccountRegisterRequest struct {
 	UserAttr
+	Client domain.SessionClient `json:"client"`
 }
 
-func (r AccountRegisterRequest) toEntity() domain.User {
+func (r AccountRegisterRequest) toEntity(password domain.Password) domain.User {
 	return domain.User{
+		TenantID: domain.DefaultTenantID,
 		Username: r.Username,
-		Password: domain.Plaintext(r.Password).Encrypt(),
+		Password: password,
 		Nickname: r.Nickname,
 		Phone:    r.Phone,
-		Salt:     uuid.New().String(),
+		Email:    r.Email,
 	}
 }
 
 type AccountRegisterResponse struct {
-	User  *domain.UserProfile `json:"user"`
-	Token string              `json:"token"`
+	User  *domain.UserProfile  `json:"user"`
+	Token *domain.AccountToken `json:"token"`
 }
 
 func (c *AccountController) Register(ctx context.Context, req AccountRegisterRequest) (*AccountRegisterResponse, error) {
@@ -64,12 +87,25 @@ func (c *AccountController) Register(ctx context.Context, req AccountRegisterReq
 		return nil, berr.ErrBadCall.WithMsg("username already exist").WithError(errors.New("username already exist"))
 	}
 
-	user, err := c.uuc.Create(ctx, req.toEntity())
+	if err := checkEmail(ctx, c.userRepo, req.Email, 0); err != nil {
+		return nil, err
+	}
+
+	password, err := c.hasher.Hash(domain.Plaintext(req.Password))
 	if err != nil {
 		return nil, err
 	}
 
-	token, err := c.auc.Login(ctx, *user)
+	user, err := c.uuc.Create(ctx, req.toEntity(password))
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Email != "" {
+		c.sendEmailVerification(ctx, *user)
+	}
+
+	token, err := c.auc.Login(ctx, *user, req.Client)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +117,9 @@ func (c *AccountController) Register(ctx context.Context, req AccountRegisterReq
 }
 
 type AccountLoginRequest struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+	Username string               `json:"username"`
+	Password string               `json:"password"`
+	Client   domain.SessionClient `json:"client"`
 }
 
 func (r AccountLoginRequest) Validate() error {
@@ -100,8 +137,12 @@ func (r AccountLoginRequest) Validate() error {
 }
 
 type AccountLoginResponse struct {
-	User  *domain.UserProfile `json:"user"`
-	Token string              `json:"token"`
+	User  *domain.UserProfile  `json:"user"`
+	Token *domain.AccountToken `json:"token"`
+	// Challenge the second factor is required, the token is issued after the challenge is verified
+	Challenge *domain.LoginChallenge `json:"challenge"`
+	// RecoveryCodes the recovery codes generated when TOTP is enrolled during the login
+	RecoveryCodes []string `json:"recoveryCodes"`
 }
 
 func (c *AccountController) Login(ctx context.Context, req AccountLoginRequest) (*AccountLoginResponse, error) {
@@ -109,29 +150,154 @@ func (c *AccountController) Login(ctx context.Context, req AccountLoginRequest)
 		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
+	if err := c.ltuc.Check(ctx, req.Username, req.Client.IP); err != nil {
+		return nil, c.loginThrottledError(err)
+	}
+
 	user, err := c.userRepo.FindOneByUsername(ctx, req.Username)
 	if repository.IsNotFound(err) {
+		if err := c.loginFailed(ctx, req.Username, req.Client.IP, 0); err != nil {
+			return nil, err
+		}
 		return nil, berr.ErrBadCall.WithMsg("username or password is incorrect").WithError(errors.New("username not exist"))
 	} else if err != nil {
 		return nil, err
 	}
 
-	if !user.Password.Verify(domain.Plaintext(req.Password)) {
+	// the service account authenticates with the API keys only,
+	// and the user without password signs in through the identity provider only
+	if user.ServiceAccount || !user.HasPassword() {
+		if err := c.loginFailed(ctx, req.Username, req.Client.IP, user.ID); err != nil {
+			return nil, err
+		}
+		return nil, berr.ErrBadCall.WithMsg("username or password is incorrect").WithError(errors.New("the user can not log in with password"))
+	}
+
+	plaintext := domain.Plaintext(req.Password)
+
+	ok, err := c.hasher.Verify(user.Password, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := c.loginFailed(ctx, req.Username, req.Client.IP, user.ID); err != nil {
+			return nil, err
+		}
 		return nil, berr.ErrBadCall.WithMsg("username or password is incorrect").WithError(errors.New("password incorrect"))
 	}
 
-	token, err := c.auc.Login(ctx, *user)
+	if c.hasher.NeedsRehash(user.Password) {
+		c.rehashPassword(ctx, user, plaintext)
+	}
+
+	// the failures are reset after the second factor passes, see LoginTOTP
+	challenge, err := c.tfuc.Challenge(ctx, *user, req.Client)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &AccountLoginResponse{Challenge: challenge}, nil
+	}
+
+	if err := c.ltuc.Succeed(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
+	token, err := c.auc.Login(ctx, *user, req.Client)
 	if err != nil {
 		return nil, err
 	}
 
 	return &AccountLoginResponse{
-		user.ToProfile(),
-		token,
+		User:  user.ToProfile(),
+		Token: token,
 	}, nil
 }
 
-func (c *AccountController) Logout(ctx context.Context, id int64) error {
+// loginFailed count the failure of the password or the second factor,
+// the lockout is logged with the user ID, which is 0 if the username does not exist
+func (c *AccountController) loginFailed(ctx context.Context, username, ip string, userID int64) error {
+	ret, err := c.ltuc.Fail(ctx, username, ip)
+	if err != nil {
+		return err
+	}
+
+	if ret.Locked {
+		c.logger.Warn("login locked out after too many failed attempts",
+			slog.Int64("user", userID),
+			slog.String("username", username),
+			slog.String("ip", ip),
+			slog.String("subject", string(ret.Subject)),
+		)
+	}
+
+	return nil
+}
+
+func (c *AccountController) loginThrottledError(err error) error {
+	var throttled *usecase.LoginThrottledError
+	if !errors.As(err, &throttled) {
+		return err
+	}
+
+	seconds := int64(math.Ceil(throttled.RetryAfter.Seconds()))
+	if throttled.Locked {
+		return berr.ErrCallsTooFrequently.WithMsg(fmt.Sprintf("account is locked, try again in %d seconds", seconds)).WithError(err)
+	}
+	return berr.ErrCallsTooFrequently.WithMsg(fmt.Sprintf("too many failed attempts, try again in %d seconds", seconds)).WithError(err)
+}
+
+// rehashPassword upgrade the password hash of user in place,
+// failure does not prevent the user from logging in, the next login will try again
+func (c *AccountController) rehashPassword(ctx context.Context, user *domain.User, plaintext domain.Plaintext) {
+	logger := c.logger.With(slog.Int64("user", user.ID), slog.String("algorithm", user.Password.Algorithm()))
+
+	password, err := c.hasher.Hash(plaintext)
+	if err != nil {
+		logger.Error("hash password error", slog.Any("error", err))
+		return
+	}
+
+	e := *user
+	e.Password = password
+	if _, err := c.uuc.Update(ctx, e); err != nil {
+		logger.Error("upgrade password hash error", slog.Any("error", err))
+		return
+	}
+
+	logger.Info("password hash upgraded")
+}
+
+type AccountRefreshTokenRequest struct {
+	RefreshToken string               `json:"refreshToken"`
+	Client       domain.SessionClient `json:"client"`
+}
+
+func (r AccountRefreshTokenRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.RefreshToken, validation.Required.Error("refresh token is required")),
+	)
+}
+
+func (c *AccountController) RefreshToken(ctx context.Context, req AccountRefreshTokenRequest) (*domain.AccountToken, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	token, err := c.auc.RefreshToken(ctx, req.RefreshToken, req.Client)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrInvalidAuthorized.WithMsg("refresh token is invalid or expired").WithError(err)
+	} else if errors.Is(err, usecase.ErrRefreshTokenReused) {
+		c.logger.Warn("refresh token reused, the token family has been revoked", slog.Any("error", err))
+		return nil, berr.ErrInvalidAuthorized.WithMsg("refresh token has been used").WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (c *AccountController) Logout(ctx context.Context, id int64, token string) error {
 	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
@@ -143,12 +309,96 @@ func (c *AccountController) Logout(ctx context.Context, id int64) error {
 		return err
 	}
 
-	return c.auc.Logout(ctx, *user)
+	return c.auc.Logout(ctx, *user, token)
+}
+
+type AccountSessionListRequest struct {
+	UserID int64  `json:"userID"`
+	Token  string `json:"token"` // the access token of the current session, optional
+}
+
+func (r AccountSessionListRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required.Error("user id is required")),
+	)
+}
+
+type AccountSessionListResponse struct {
+	Items   []*domain.Session `json:"items"`
+	Current string            `json:"current"` // the ID of the current session
+}
+
+func (c *AccountController) ListSessions(ctx context.Context, req AccountSessionListRequest) (*AccountSessionListResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.userRepo.FindOne(ctx, req.UserID)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(errors.New("user not exist in the tenant"))
+	}
+
+	list, err := c.auc.ListSessions(ctx, *user)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &AccountSessionListResponse{Items: list}
+
+	if req.Token != "" {
+		claims, err := service.ParseAccountTokenUnverified(req.Token)
+		if err != nil {
+			return nil, err
+		}
+		ret.Current = claims.Data.FamilyID
+	}
+
+	return ret, nil
+}
+
+type AccountSessionRevokeRequest struct {
+	UserID int64  `json:"userID"`
+	ID     string `json:"id"`
+}
+
+func (r AccountSessionRevokeRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required.Error("user id is required")),
+		validation.Field(&r.ID, validation.Required.Error("id is required")),
+	)
+}
+
+func (c *AccountController) RevokeSession(ctx context.Context, req AccountSessionRevokeRequest) error {
+	if err := req.Validate(); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.userRepo.FindOne(ctx, req.UserID)
+	if repository.IsNotFound(err) {
+		return berr.ErrResourceNotFound.WithMsg("user not exist").WithError(err)
+	} else if err != nil {
+		return err
+	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return berr.ErrResourceNotFound.WithMsg("user not exist").WithError(errors.New("user not exist in the tenant"))
+	}
+
+	err = c.auc.RevokeSession(ctx, *user, req.ID)
+	if repository.IsNotFound(err) {
+		return berr.ErrResourceNotFound.WithMsg("session not exist").WithError(err)
+	}
+	return err
 }
 
 type AccountUpdateProfileRequest struct {
 	ID       int64  `json:"id"`
 	Nickname string `json:"nickname"`
+	Email    string `json:"email"` // optional, the verification mail is sent if it is changed
 }
 
 func (r AccountUpdateProfileRequest) Validate() error {
@@ -158,6 +408,10 @@ func (r AccountUpdateProfileRequest) Validate() error {
 			validation.Required.Error("nickname is required"),
 			validation.Length(8, 16).Error("nickname must be 8 ~ 16 characters"),
 		),
+		validation.Field(&r.Email,
+			validation.Length(0, 255).Error("email must be at most 255 characters"),
+			validation.By(validator.IsEmail),
+		),
 	)
 }
 
@@ -172,10 +426,24 @@ func (c *AccountController) UpdateProfile(ctx context.Context, req AccountUpdate
 	} else if err != nil {
 		return err
 	}
+	if err := checkEmail(ctx, c.userRepo, req.Email, e.ID); err != nil {
+		return err
+	}
+
+	changed := e.Email != req.Email
 	e.Nickname = req.Nickname
+	e.ChangeEmail(req.Email)
 
-	_, err = c.uuc.Update(ctx, *e)
-	return err
+	user, err := c.uuc.Update(ctx, *e)
+	if err != nil {
+		return err
+	}
+
+	if changed && user.Email != "" {
+		c.sendEmailVerification(ctx, *user)
+	}
+
+	return nil
 }
 
 func (c *AccountController) GetProfile(ctx context.Context, id int64) (*domain.UserProfile, error) {
@@ -198,5 +466,5 @@ func (c *AccountController) GetPermissions(ctx context.Context, id int64) ([]*do
 		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	return c.uuc.GetPermissions(ctx, id)
+	return c.uuc.GetPermissions(ctx, domain.TenantFromContext(ctx), id)
 }
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/service"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+)
+
+type AccountOIDCAuthorizeResponse struct {
+	AuthorizationURL string    `json:"authorizationURL"`
+	Binding          string    `json:"-"` // kept by the browser and sent back with the callback
+	ExpiresAt        time.Time `json:"-"`
+}
+
+// OIDCAuthorize start the login through the identity provider, returns the URL that the user signs in at
+func (c *AccountController) OIDCAuthorize(ctx context.Context, provider string) (*AccountOIDCAuthorizeResponse, error) {
+	if err := validation.Validate(provider, validation.Required.Error("provider is required")); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	u, auth, err := c.oiduc.Authorize(ctx, provider)
+	if err != nil {
+		return nil, c.oidcError(err)
+	}
+
+	return &AccountOIDCAuthorizeResponse{
+		AuthorizationURL: u,
+		Binding:          auth.Binding,
+		ExpiresAt:        auth.ExpiresAt,
+	}, nil
+}
+
+type AccountOIDCLoginRequest struct {
+	Provider string               `json:"provider"`
+	State    string               `json:"state"`
+	Binding  string               `json:"-"`
+	Code     string               `json:"code"`
+	Client   domain.SessionClient `json:"client"`
+}
+
+func (r AccountOIDCLoginRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Provider, validation.Required.Error("provider is required")),
+		validation.Field(&r.State, validation.Required.Error("state is required")),
+		validation.Field(&r.Code, validation.Required.Error("code is required")),
+	)
+}
+
+// OIDCLogin finish the login with the authorization code that the identity provider redirects back with,
+// the second factor is still challenged if it is enabled or required
+func (c *AccountController) OIDCLogin(ctx context.Context, req AccountOIDCLoginRequest) (*AccountLoginResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.oiduc.Callback(ctx, req.Provider, req.State, req.Binding, req.Code)
+	if err != nil {
+		return nil, c.oidcError(err)
+	}
+	if user.ServiceAccount {
+		return nil, berr.ErrBadCall.WithMsg("service account can not log in").WithError(errors.New("service account can not log in"))
+	}
+
+	challenge, err := c.tfuc.Challenge(ctx, *user, req.Client)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &AccountLoginResponse{Challenge: challenge}, nil
+	}
+
+	token, err := c.auc.Login(ctx, *user, req.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountLoginResponse{
+		User:  user.ToProfile(),
+		Token: token,
+	}, nil
+}
+
+// oidcError convert the error of the OIDC use case to the business error
+func (c *AccountController) oidcError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrUnknownOIDCProvider):
+		return berr.ErrResourceNotFound.WithMsg("unknown identity provider").WithError(err)
+	case errors.Is(err, usecase.ErrOIDCAuthorizationInvalid):
+		return berr.ErrBadCall.WithMsg("state is invalid, expired or issued to another browser").WithError(err)
+	case errors.Is(err, usecase.ErrOIDCLoginFailed):
+		return berr.ErrBadCall.WithMsg("failed to log in through the identity provider").WithError(err)
+	}
+	return err
+}
@@ -7,28 +7,36 @@ import (
 	"github.com/casbin/casbin/v2"
 	"github.com/pkg/errors"
 
+	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
 	berr "go-scaffold/internal/errors"
+	icasbin "go-scaffold/internal/pkg/casbin"
 )
 
 type AccountPermissionController struct {
 	roleRepo       repository.RoleRepositoryInterface
 	permissionRepo repository.PermissionRepositoryInterface
-	enforcer       *casbin.Enforcer
+	enforcer       *casbin.SyncedEnforcer
+	decisions      *icasbin.DecisionCache
 }
 
 func NewAccountPermissionController(
 	roleRepo repository.RoleRepositoryInterface,
 	permissionRepo repository.PermissionRepositoryInterface,
-	enforcer *casbin.Enforcer,
+	enforcer *casbin.SyncedEnforcer,
+	decisions *icasbin.DecisionCache,
 ) *AccountPermissionController {
 	return &AccountPermissionController{
 		roleRepo:       roleRepo,
 		permissionRepo: permissionRepo,
 		enforcer:       enforcer,
+		decisions:      decisions,
 	}
 }
 
+// ValidatePermission the permission is validated within the tenant that the request acts in,
+// the conditions of the policies are evaluated against the environment carried by the context,
+// the permission and the decision are cached, so the common case makes no database round trip
 func (c *AccountPermissionController) ValidatePermission(ctx context.Context, user int64, permissionKey string) (bool, error) {
 	permission, err := c.permissionRepo.FindOneByKey(ctx, permissionKey)
 	if repository.IsNotFound(err) {
@@ -36,7 +44,13 @@ func (c *AccountPermissionController) ValidatePermission(ctx context.Context, us
 	} else if err != nil {
 		return false, err
 	}
-	result, err := c.enforcer.Enforce(repository.GetPolicyUser(user), fmt.Sprintf("%d", permission.ID))
+	result, err := c.decisions.Enforce(
+		c.enforcer,
+		repository.GetPolicyUser(user),
+		repository.GetPolicyDomain(domain.TenantFromContext(ctx)),
+		fmt.Sprintf("%d", permission.ID),
+		icasbin.EnvironmentFromContext(ctx),
+	)
 	if err != nil {
 		return false, berr.ErrAccessDenied.WithError(errors.WithStack(err))
 	}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+	"go-scaffold/pkg/validator"
+)
+
+type AccountForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+func (r AccountForgotPasswordRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Email,
+			validation.Required.Error("email is required"),
+			validation.By(validator.IsEmail),
+		),
+	)
+}
+
+// ForgotPassword send the password reset mail,
+// it succeeds whether the email exists or not, so that the emails can not be enumerated
+func (c *AccountController) ForgotPassword(ctx context.Context, req AccountForgotPasswordRequest) error {
+	if err := req.Validate(); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	return c.rcuc.ForgotPassword(ctx, req.Email)
+}
+
+type AccountResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+func (r AccountResetPasswordRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Token, validation.Required.Error("token is required")),
+		validation.Field(&r.Password,
+			validation.Required.Error("password is required"),
+			validation.Length(8, 18).Error("password must be 8 ~ 18 characters"),
+			validation.By(validator.PasswordComplexity),
+		),
+	)
+}
+
+// ResetPassword reset the password with the token in the password reset mail,
+// the sessions on all the devices are revoked and the login lockout is lifted
+func (c *AccountController) ResetPassword(ctx context.Context, req AccountResetPasswordRequest) error {
+	if err := req.Validate(); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	password, err := c.hasher.Hash(domain.Plaintext(req.Password))
+	if err != nil {
+		return err
+	}
+
+	user, err := c.rcuc.ResetPassword(ctx, req.Token, password)
+	if err != nil {
+		return c.recoveryError(err)
+	}
+
+	if err := c.auc.RevokeAllSessions(ctx, *user); err != nil {
+		return err
+	}
+
+	if err := c.ltuc.Unlock(ctx, *user); err != nil {
+		return err
+	}
+
+	c.logger.Info("password reset by email", slog.Int64("user", user.ID))
+
+	return nil
+}
+
+// SendEmailVerification resend the verification mail to the email of the user
+func (c *AccountController) SendEmailVerification(ctx context.Context, id int64) error {
+	user, err := c.findUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := c.rcuc.SendEmailVerification(ctx, *user); err != nil {
+		return c.recoveryError(err)
+	}
+
+	return nil
+}
+
+type AccountVerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+func (r AccountVerifyEmailRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Token, validation.Required.Error("token is required")),
+	)
+}
+
+// VerifyEmail verify the email with the token in the verification mail
+func (c *AccountController) VerifyEmail(ctx context.Context, req AccountVerifyEmailRequest) (*domain.UserProfile, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.rcuc.VerifyEmail(ctx, req.Token)
+	if err != nil {
+		return nil, c.recoveryError(err)
+	}
+
+	return user.ToProfile(), nil
+}
+
+// sendEmailVerification failure does not fail the request, the user can ask for the mail again
+func (c *AccountController) sendEmailVerification(ctx context.Context, user domain.User) {
+	if err := c.rcuc.SendEmailVerification(ctx, user); err != nil {
+		c.logger.Error("send email verification error", slog.Int64("user", user.ID), slog.Any("error", err))
+	}
+}
+
+// recoveryError convert the error of the account recovery use case to the business error
+func (c *AccountController) recoveryError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrAccountActionTokenInvalid):
+		return berr.ErrBadCall.WithMsg("token is invalid or expired").WithError(err)
+	case errors.Is(err, usecase.ErrEmailNotSet):
+		return berr.ErrBadCall.WithMsg("email is not set").WithError(err)
+	case errors.Is(err, usecase.ErrEmailAlreadyVerified):
+		return berr.ErrBadCall.WithMsg("email is already verified").WithError(err)
+	}
+	return err
+}
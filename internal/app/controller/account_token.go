@@ -2,11 +2,6 @@ package controller
 
 import (
 	"context"
-	"time"
-
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
-	"github.com/pkg/errors"
 
 	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
@@ -16,68 +11,76 @@ import (
 )
 
 type AccountTokenController struct {
-	uc   usecase.AccountUseCaseInterface
-	repo repository.UserRepositoryInterface
+	tokenService *service.AccountTokenService
+	auc          usecase.AccountUseCaseInterface
+	repo         repository.UserRepositoryInterface
 }
 
 func NewAccountTokenController(
-	uc usecase.AccountUseCaseInterface,
+	tokenService *service.AccountTokenService,
+	auc usecase.AccountUseCaseInterface,
 	repo repository.UserRepositoryInterface,
 ) *AccountTokenController {
 	return &AccountTokenController{
-		uc:   uc,
-		repo: repo,
+		tokenService: tokenService,
+		auc:          auc,
+		repo:         repo,
 	}
 }
 
+// ValidateToken the impersonation token is bound to the session of the actor,
+// the profile of the impersonated user carries the profile of the actor
 func (c *AccountTokenController) ValidateToken(ctx context.Context, token string) (*domain.UserProfile, error) {
-	claims, err := service.ParseAccountTokenUnverified(token)
+	claims, err := c.tokenService.ValidateToken(token)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := c.repo.FindOne(ctx, claims.Data.UserID)
+	owner := claims.Data.UserID
+	if claims.Data.ActorID != 0 {
+		owner = claims.Data.ActorID
+	}
+
+	actor, err := c.repo.FindOne(ctx, owner)
 	if repository.IsNotFound(err) {
 		return nil, berr.ErrInvalidAuthorized.WithError(err)
 	} else if err != nil {
 		return nil, err
 	}
 
-	tokenService := service.NewAccountTokenService(user.Salt)
-	_, err = tokenService.ValidateToken(token)
-	if err != nil {
+	err = c.auc.TouchSession(ctx, *actor, claims.Data.FamilyID)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrInvalidAuthorized.WithMsg("session has been revoked").WithError(err)
+	} else if err != nil {
 		return nil, err
 	}
 
-	return user.ToProfile(), nil
-}
-
-func (c *AccountTokenController) RefreshToken(ctx context.Context, userProfile domain.UserProfile, token string) (string, error) {
-	claims, err := service.ParseAccountTokenUnverified(token)
-	if err != nil {
-		return "", err
+	if claims.Data.ActorID == 0 {
+		return withTenant(actor.ToProfile(), claims.Data.TenantID), nil
 	}
 
-	expireDuration := claims.ExpiresAt.Sub(time.Now())
-	if expireDuration <= 0 {
-		return "", berr.ErrInvalidAuthorized.WithError(errors.WithStack(jwt.ErrTokenExpired))
-	}
-	if expireDuration > domain.AccountTokenRefreshDuration {
-		return token, nil
+	user, err := c.repo.FindOne(ctx, claims.Data.UserID)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrInvalidAuthorized.WithMsg("impersonated user not exist").WithError(err)
+	} else if err != nil {
+		return nil, err
 	}
 
-	user := domain.User{
-		ID:       userProfile.ID,
-		Username: userProfile.Username,
-		Nickname: userProfile.Nickname,
-		Phone:    userProfile.Phone,
-		Salt:     uuid.New().String(),
-	}
+	profile := user.ToProfile()
+	profile.Actor = withTenant(actor.ToProfile(), claims.Data.TenantID)
 
-	token, err = c.uc.Login(ctx, user)
-	if err != nil {
-		return "", err
+	return withTenant(profile, claims.Data.TenantID), nil
+}
+
+// withTenant the user acts in the tenant of the token, or its own tenant if the token carries no tenant
+func withTenant(profile *domain.UserProfile, tenant int64) *domain.UserProfile {
+	if tenant != 0 {
+		profile.TenantID = tenant
 	}
+	return profile
+}
 
-	return token, nil
+// JWKS returns the public keys that verify the access token
+func (c *AccountTokenController) JWKS() []service.JWK {
+	return c.tokenService.JWKS()
 }
@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"context"
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+)
+
+var totpCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+type AccountLoginTOTPRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+	Code           string `json:"code"`
+	RecoveryCode   string `json:"recoveryCode"`
+}
+
+func (r AccountLoginTOTPRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ChallengeToken, validation.Required.Error("challenge token is required")),
+		validation.Field(&r.Code,
+			validation.When(r.RecoveryCode == "", validation.Required.Error("code or recovery code is required")),
+			validation.Match(totpCodePattern).Error("code must be 6 digits"),
+		),
+	)
+}
+
+// LoginTOTP complete the login by verifying the second factor of the challenge,
+// the failures are counted by the login throttle as well as the password failures
+func (c *AccountController) LoginTOTP(ctx context.Context, req AccountLoginTOTPRequest) (*AccountLoginResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	challenge, err := c.tfuc.FindChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	user, err := c.userRepo.FindOne(ctx, challenge.UserID)
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	if err := c.ltuc.Check(ctx, user.Username, challenge.Client.IP); err != nil {
+		return nil, c.loginThrottledError(err)
+	}
+
+	verified, _, recoveryCodes, err := c.tfuc.VerifyChallenge(ctx, req.ChallengeToken, req.Code, req.RecoveryCode)
+	if errors.Is(err, usecase.ErrTOTPInvalidCode) || errors.Is(err, usecase.ErrLoginChallengeAttemptsExceeded) {
+		if err := c.loginFailed(ctx, user.Username, challenge.Client.IP, user.ID); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	if err := c.ltuc.Succeed(ctx, user.Username); err != nil {
+		return nil, err
+	}
+
+	token, err := c.auc.Login(ctx, *verified, challenge.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountLoginResponse{
+		User:          verified.ToProfile(),
+		Token:         token,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+type AccountLoginTOTPEnrollRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+}
+
+func (r AccountLoginTOTPEnrollRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ChallengeToken, validation.Required.Error("challenge token is required")),
+	)
+}
+
+// LoginTOTPEnroll enroll TOTP during the login, when it is required by the roles of the user
+func (c *AccountController) LoginTOTPEnroll(ctx context.Context, req AccountLoginTOTPEnrollRequest) (*domain.TOTPEnrollment, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	ret, err := c.tfuc.EnrollChallenge(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	return ret, nil
+}
+
+func (c *AccountController) EnrollTOTP(ctx context.Context, id int64) (*domain.TOTPEnrollment, error) {
+	user, err := c.findUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := c.tfuc.Enroll(ctx, *user)
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	return ret, nil
+}
+
+type AccountTOTPRequest struct {
+	ID           int64  `json:"id"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+}
+
+func (r AccountTOTPRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ID, validation.Required.Error("id is required")),
+		validation.Field(&r.Code,
+			validation.When(r.RecoveryCode == "", validation.Required.Error("code or recovery code is required")),
+			validation.Match(totpCodePattern).Error("code must be 6 digits"),
+		),
+	)
+}
+
+// ActivateTOTP returns the recovery codes, which are shown only once
+func (c *AccountController) ActivateTOTP(ctx context.Context, req AccountTOTPRequest) ([]string, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.findUser(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := c.tfuc.Activate(ctx, *user, req.Code)
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	return codes, nil
+}
+
+func (c *AccountController) DisableTOTP(ctx context.Context, req AccountTOTPRequest) error {
+	if err := req.Validate(); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.findUser(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.tfuc.Disable(ctx, *user, req.Code, req.RecoveryCode); err != nil {
+		return c.twoFactorError(err)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes returns the new recovery codes, which are shown only once
+func (c *AccountController) RegenerateRecoveryCodes(ctx context.Context, req AccountTOTPRequest) ([]string, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.findUser(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := c.tfuc.RegenerateRecoveryCodes(ctx, *user, req.Code)
+	if err != nil {
+		return nil, c.twoFactorError(err)
+	}
+
+	return codes, nil
+}
+
+func (c *AccountController) findUser(ctx context.Context, id int64) (*domain.User, error) {
+	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.userRepo.FindOne(ctx, id)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// twoFactorError convert the error of the two-factor use case to the business error
+func (c *AccountController) twoFactorError(err error) error {
+	switch {
+	case repository.IsNotFound(err):
+		return berr.ErrInvalidAuthorized.WithMsg("login challenge is invalid or expired").WithError(err)
+	case errors.Is(err, usecase.ErrLoginChallengeAttemptsExceeded):
+		return berr.ErrInvalidAuthorized.WithMsg("too many attempts, please login again").WithError(err)
+	case errors.Is(err, usecase.ErrTOTPInvalidCode):
+		return berr.ErrBadCall.WithMsg("code is incorrect").WithError(err)
+	case errors.Is(err, usecase.ErrTOTPAlreadyEnabled):
+		return berr.ErrBadCall.WithMsg("TOTP is already enabled").WithError(err)
+	case errors.Is(err, usecase.ErrTOTPNotEnabled):
+		return berr.ErrBadCall.WithMsg("TOTP is not enabled").WithError(err)
+	case errors.Is(err, usecase.ErrTOTPNotEnrolled):
+		return berr.ErrBadCall.WithMsg("TOTP is not enrolled").WithError(err)
+	case errors.Is(err, usecase.ErrTOTPRequired):
+		return berr.ErrAccessDenied.WithMsg("TOTP is required by the roles").WithError(err)
+	}
+	return err
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+)
+
+type APIKeyController struct {
+	logger   *slog.Logger
+	uc       usecase.APIKeyUseCaseInterface
+	repo     repository.APIKeyRepositoryInterface
+	userRepo repository.UserRepositoryInterface
+}
+
+func NewAPIKeyController(
+	logger *slog.Logger,
+	uc usecase.APIKeyUseCaseInterface,
+	repo repository.APIKeyRepositoryInterface,
+	userRepo repository.UserRepositoryInterface,
+) *APIKeyController {
+	return &APIKeyController{
+		logger:   logger,
+		uc:       uc,
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+// ValidateToken validate the API key, the profile of the service account is restricted to the scopes of the API key
+func (c *APIKeyController) ValidateToken(ctx context.Context, token string) (*domain.UserProfile, error) {
+	user, key, err := c.uc.Authenticate(ctx, token)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrAPIKeyExpired):
+			return nil, berr.ErrInvalidAuthorized.WithMsg("API key has expired").WithError(err)
+		case errors.Is(err, usecase.ErrAPIKeyInvalid), errors.Is(err, usecase.ErrNotServiceAccount):
+			return nil, berr.ErrInvalidAuthorized.WithMsg("invalid API key").WithError(err)
+		}
+		return nil, err
+	}
+
+	profile := user.ToProfile()
+	profile.Scopes = key.Scopes
+
+	return profile, nil
+}
+
+type APIKeyCreateRequest struct {
+	UserID    int64    `json:"userID"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt int64    `json:"expiresAt"`
+}
+
+func (r APIKeyCreateRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required.Error("user id is required")),
+		validation.Field(&r.Name,
+			validation.Required.Error("name is required"),
+			validation.Length(1, 64).Error("name must be 1 ~ 64 characters"),
+		),
+		validation.Field(&r.Scopes,
+			validation.Length(0, 64).Error("scopes must be at most 64 items"),
+			validation.Each(validation.Required.Error("scope can not be empty")),
+		),
+		validation.Field(&r.ExpiresAt,
+			validation.Min(time.Now().Unix()+1).Error("expiration time must be in the future"),
+		),
+	)
+}
+
+type APIKeyCreateResponse struct {
+	*domain.APIKey
+	Key string `json:"key"`
+}
+
+// Create issue an API key to the service account, the plaintext key can not be retrieved afterward
+func (c *APIKeyController) Create(ctx context.Context, req APIKeyCreateRequest) (*APIKeyCreateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.findUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	e, key, err := c.uc.Create(ctx, *user, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrNotServiceAccount):
+			return nil, berr.ErrBadCall.WithMsg("the user is not a service account").WithError(err)
+		case errors.Is(err, usecase.ErrUnknownAPIKeyScope):
+			return nil, berr.ErrBadCall.WithMsg(err.Error()).WithError(err)
+		}
+		return nil, err
+	}
+
+	c.logger.Info("API key created", slog.Int64("user", user.ID), slog.String("prefix", e.Prefix))
+
+	return &APIKeyCreateResponse{APIKey: e, Key: key}, nil
+}
+
+type APIKeyListRequest struct {
+	UserID int64
+}
+
+func (r APIKeyListRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.UserID, validation.Required.Error("user id is required")),
+	)
+}
+
+func (c *APIKeyController) List(ctx context.Context, req APIKeyListRequest) ([]*domain.APIKey, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	if _, err := c.findUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	return c.uc.List(ctx, req.UserID)
+}
+
+func (c *APIKeyController) Delete(ctx context.Context, id int64) error {
+	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	key, err := c.repo.FindOne(ctx, id)
+	if repository.IsNotFound(err) {
+		return berr.ErrResourceNotFound.WithError(err)
+	} else if err != nil {
+		return err
+	}
+	// the key of the service account in another tenant is not exposed
+	if _, err := c.findUser(ctx, key.UserID); err != nil {
+		return err
+	}
+
+	if err := c.uc.Delete(ctx, *key); err != nil {
+		return err
+	}
+
+	c.logger.Info("API key deleted", slog.Int64("user", key.UserID), slog.String("prefix", key.Prefix))
+
+	return nil
+}
+
+// findUser returns the service account within the tenant that the request acts in
+func (c *APIKeyController) findUser(ctx context.Context, id int64) (*domain.User, error) {
+	user, err := c.userRepo.FindOne(ctx, id)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithError(errors.New("user not exist in the tenant"))
+	}
+
+	return user, nil
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+	icasbin "go-scaffold/internal/pkg/casbin"
+	"go-scaffold/pkg/lru"
+)
+
+type AuthzController struct {
+	uc             usecase.AuthzUseCaseInterface
+	userRepo       repository.UserRepositoryInterface
+	permissionRepo repository.PermissionRepositoryInterface
+	decisions      *icasbin.DecisionCache
+}
+
+func NewAuthzController(
+	uc usecase.AuthzUseCaseInterface,
+	userRepo repository.UserRepositoryInterface,
+	permissionRepo repository.PermissionRepositoryInterface,
+	decisions *icasbin.DecisionCache,
+) *AuthzController {
+	return &AuthzController{
+		uc:             uc,
+		userRepo:       userRepo,
+		permissionRepo: permissionRepo,
+		decisions:      decisions,
+	}
+}
+
+type AuthzExplainRequest struct {
+	User       int64  // 用户 id
+	Permission string // 权限标识
+}
+
+func (r AuthzExplainRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.User, validation.Required.Error("user is required")),
+		validation.Field(&r.Permission,
+			validation.Required.Error("permission is required"),
+			validation.Length(1, 128).Error("permission must be 1 ~ 128 characters"),
+		),
+	)
+}
+
+// Explain explain why the user is granted or denied the permission within the tenant that the request acts in
+func (c *AuthzController) Explain(ctx context.Context, req AuthzExplainRequest) (*domain.PermissionExplanation, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.userRepo.FindOne(ctx, req.User)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(errors.New("user not exist in the tenant"))
+	}
+
+	return c.uc.Explain(ctx, *user, req.Permission)
+}
+
+// CacheStats returns the statistics of the caches of the authorization path of this replica
+func (c *AuthzController) CacheStats(context.Context) *domain.AuthzCacheStats {
+	return &domain.AuthzCacheStats{
+		Permissions: newCacheStats(c.permissionRepo.KeyCacheStats()),
+		Decisions:   newCacheStats(c.decisions.Stats()),
+	}
+}
+
+func newCacheStats(s lru.Stats) domain.CacheStats {
+	return domain.CacheStats{
+		Size:     s.Size,
+		Capacity: s.Capacity,
+		Hits:     s.Hits,
+		Misses:   s.Misses,
+		HitRatio: s.HitRatio(),
+	}
+}
@@ -6,10 +6,16 @@ var ProviderSet = wire.NewSet(
 	NewGreetController,
 	NewProducerController,
 	NewAccountTokenController,
+	NewAPIKeyController,
+	NewImpersonationController,
 	NewAccountPermissionController,
+	NewTenantController,
+	NewDataScopeController,
+	NewAuthzController,
 	NewAccountController,
 	NewUserController,
 	NewRoleController,
 	NewPermissionController,
+	NewRBACController,
 	NewProductController,
 )
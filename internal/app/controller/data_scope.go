@@ -0,0 +1,23 @@
+package controller
+
+import (
+	"context"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/usecase"
+)
+
+type DataScopeController struct {
+	uc usecase.DataScopeUseCaseInterface
+}
+
+func NewDataScopeController(uc usecase.DataScopeUseCaseInterface) *DataScopeController {
+	return &DataScopeController{
+		uc: uc,
+	}
+}
+
+// ResolveDataScope returns the rows that the user may see
+func (c *DataScopeController) ResolveDataScope(ctx context.Context, user domain.UserProfile) (*domain.DataFilter, error) {
+	return c.uc.Resolve(ctx, user)
+}
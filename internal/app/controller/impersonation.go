@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+)
+
+// defaultAuditLogLimit the number of the latest audit logs listed by default
+const defaultAuditLogLimit = 100
+
+type ImpersonationController struct {
+	logger   *slog.Logger
+	uc       usecase.ImpersonationUseCaseInterface
+	userRepo repository.UserRepositoryInterface
+}
+
+func NewImpersonationController(
+	logger *slog.Logger,
+	uc usecase.ImpersonationUseCaseInterface,
+	userRepo repository.UserRepositoryInterface,
+) *ImpersonationController {
+	return &ImpersonationController{
+		logger:   logger,
+		uc:       uc,
+		userRepo: userRepo,
+	}
+}
+
+type ImpersonateRequest struct {
+	ActorID    int64
+	ActorToken string // the access token that the actor is authenticated with
+	UserID     int64
+	Reason     string
+	Client     domain.SessionClient
+}
+
+func (r ImpersonateRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ActorID, validation.Required.Error("actor id is required")),
+		validation.Field(&r.UserID, validation.Required.Error("user id is required")),
+		validation.Field(&r.Reason,
+			validation.Required.Error("reason is required"),
+			validation.RuneLength(1, 255).Error("reason must be 1 ~ 255 characters"),
+		),
+	)
+}
+
+type ImpersonateResponse struct {
+	User  *domain.UserProfile  `json:"user"`
+	Token *domain.AccountToken `json:"token"`
+}
+
+// Impersonate issue the impersonation token of the user to the actor
+func (c *ImpersonationController) Impersonate(ctx context.Context, req ImpersonateRequest) (*ImpersonateResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	actor, err := c.userRepo.FindOne(ctx, req.ActorID)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrInvalidAuthorized.WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	user, err := c.userRepo.FindOne(ctx, req.UserID)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(errors.New("user not exist in the tenant"))
+	}
+
+	token, err := c.uc.Impersonate(ctx, *actor, req.ActorToken, *user, req.Reason, req.Client)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrImpersonationSessionRequired),
+			errors.Is(err, usecase.ErrAlreadyImpersonating),
+			errors.Is(err, usecase.ErrImpersonateSelf),
+			errors.Is(err, usecase.ErrImpersonateServiceAccount):
+			return nil, berr.ErrBadCall.WithMsg(errors.Cause(err).Error()).WithError(err)
+		}
+		return nil, err
+	}
+
+	c.logger.WarnContext(ctx, "user impersonated",
+		slog.Int64("impersonator", actor.ID),
+		slog.Int64("user", user.ID),
+		slog.String("reason", req.Reason),
+	)
+
+	return &ImpersonateResponse{User: user.ToProfile(), Token: token}, nil
+}
+
+// Record persist the audit log of the request made under impersonation,
+// the failure is logged so that the request is not affected
+func (c *ImpersonationController) Record(ctx context.Context, log domain.AuditLog) {
+	if err := c.uc.Record(ctx, log); err != nil {
+		c.logger.ErrorContext(ctx, "record audit log error",
+			slog.Int64("impersonator", log.ActorID),
+			slog.Int64("user", log.UserID),
+			slog.String("method", log.Method),
+			slog.String("path", log.Path),
+			slog.Any("error", err),
+		)
+	}
+}
+
+type AuditLogListRequest struct {
+	ActorID int64 `json:"actorID"`
+	UserID  int64 `json:"userID"`
+	Limit   int   `json:"limit"`
+}
+
+func (r AuditLogListRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Limit, validation.Min(0), validation.Max(1000).Error("limit must be at most 1000")),
+	)
+}
+
+// ListAuditLogs list the latest audit logs within the tenant that the request acts in
+func (c *ImpersonationController) ListAuditLogs(ctx context.Context, req AuditLogListRequest) ([]*domain.AuditLog, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultAuditLogLimit
+	}
+
+	return c.uc.ListAuditLogs(ctx, repository.AuditLogFindListParam{
+		TenantID: domain.TenantFromContext(ctx),
+		ActorID:  req.ActorID,
+		UserID:   req.UserID,
+		Limit:    limit,
+	})
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	berr "go-scaffold/internal/errors"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// PageRequest the pagination of the list, the offset is ignored if the cursor is set
+type PageRequest struct {
+	Offset int
+	Limit  int    // default: 20, at most 100
+	Cursor string // the cursor returned with the previous page
+	Sort   string // default: updatedAt
+	Order  string // asc or desc, default: desc
+}
+
+// validate the fields that the list can be sorted by are whitelisted
+func (r PageRequest) validate(sortFields []string) error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Offset, validation.Min(0).Error("offset must not be negative")),
+		validation.Field(&r.Limit,
+			validation.Min(0).Error("limit must not be negative"),
+			validation.Max(maxPageLimit).Error(fmt.Sprintf("limit must be at most %d", maxPageLimit)),
+		),
+		validation.Field(&r.Cursor, validation.Length(0, 512).Error("cursor must be at most 512 characters")),
+		validation.Field(&r.Sort,
+			validation.In(lo.ToAnySlice(sortFields)...).Error("sort must be one of "+strings.Join(sortFields, ", ")),
+		),
+		validation.Field(&r.Order,
+			validation.In(lo.ToAnySlice(lo.Map(domain.SortOrders, func(item domain.SortOrder, index int) string {
+				return string(item)
+			}))...).Error("order must be one of asc, desc"),
+		),
+	)
+}
+
+func (r PageRequest) toPagination() domain.Pagination {
+	limit := r.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+
+	return domain.Pagination{
+		Offset: r.Offset,
+		Limit:  limit,
+		Cursor: r.Cursor,
+		Sort:   r.Sort,
+		Order:  domain.SortOrder(r.Order),
+	}
+}
+
+// pageError the cursor that can not be decoded is the error of the request
+func pageError(err error) error {
+	if errors.Is(err, domain.ErrInvalidCursor) || errors.Is(err, domain.ErrInvalidSortField) {
+		return berr.ErrValidateError.WithMsg(err.Error()).WithError(err)
+	}
+	return err
+}
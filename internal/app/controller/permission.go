@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/pkg/errors"
@@ -75,7 +76,7 @@ func (c *PermissionController) Create(ctx context.Context, req PermissionCreateR
 		return berr.ErrBadCall.WithMsg("permission key already exist").WithError(errors.New("key already exist"))
 	}
 
-	return c.uc.Create(ctx, req.toEntity())
+	return permissionParentError(c.uc.Create(ctx, req.toEntity()))
 }
 
 type PermissionUpdateRequest struct {
@@ -124,7 +125,7 @@ func (c *PermissionController) Update(ctx context.Context, req PermissionUpdateR
 		return berr.ErrBadCall.WithMsg("permission key already exist").WithError(errors.New("key already exist"))
 	}
 
-	return c.uc.Update(ctx, req.toEntity())
+	return permissionParentError(c.uc.Update(ctx, req.toEntity()))
 }
 
 func (c *PermissionController) Delete(ctx context.Context, id int64) error {
@@ -167,9 +168,87 @@ func (c *PermissionController) Detail(ctx context.Context, id int64) (*domain.Pe
 
 type PermissionListRequest struct {
 	Keyword string
+	PageRequest
 }
 
-func (c *PermissionController) List(ctx context.Context, req PermissionListRequest) ([]*domain.Permission, error) {
-	param := usecase.PermissionListParam{Keyword: req.Keyword}
-	return c.uc.List(ctx, param)
+func (r PermissionListRequest) Validate() error {
+	return r.PageRequest.validate(domain.PermissionSortFields)
+}
+
+func (c *PermissionController) List(ctx context.Context, req PermissionListRequest) (*domain.Page[*domain.Permission], error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	param := usecase.PermissionListParam{
+		Keyword: req.Keyword,
+		Page:    req.toPagination(),
+	}
+
+	page, err := c.uc.List(ctx, param)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return page, nil
+}
+
+// Tree returns the top level permissions with their descendants
+func (c *PermissionController) Tree(ctx context.Context) ([]*domain.PermissionNode, error) {
+	return c.uc.Tree(ctx)
+}
+
+type PermissionMoveRequest struct {
+	ID       int64 `json:"id"`
+	ParentID int64 `json:"parentID"` // 0 moves the permission to the top level
+}
+
+func (r PermissionMoveRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ID, validation.Required.Error("id is required")),
+		validation.Field(&r.ParentID, validation.Min(int64(0)).Error("parent id must not be negative")),
+	)
+}
+
+// Move move the permission along with its descendants under the parent
+func (c *PermissionController) Move(ctx context.Context, req PermissionMoveRequest) error {
+	if err := req.Validate(); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	permission, err := c.repo.FindOne(ctx, req.ID)
+	if repository.IsNotFound(err) {
+		return berr.ErrResourceNotFound.WithError(err)
+	} else if err != nil {
+		return err
+	}
+
+	return permissionParentError(c.uc.Move(ctx, *permission, req.ParentID))
+}
+
+// Sync synchronize the permissions declared by the routes, it is used by the command line
+func (c *PermissionController) Sync(ctx context.Context, definitions []domain.PermissionDefinition, dryRun bool) (*domain.PermissionSyncResult, error) {
+	for _, d := range definitions {
+		attr := PermissionAttr{Key: d.Key, Name: d.Name, Desc: d.Desc}
+		if err := attr.Validate(); err != nil {
+			return nil, berr.ErrValidateError.WithMsg(fmt.Sprintf("permission %q: %s", d.Key, err)).WithError(errors.WithStack(err))
+		}
+	}
+
+	result, err := c.uc.Sync(ctx, definitions, dryRun)
+	if err != nil {
+		return nil, permissionParentError(err)
+	}
+
+	return result, nil
+}
+
+func permissionParentError(err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrPermissionParentNotFound):
+		return berr.ErrBadCall.WithMsg("parent permission not exist").WithError(err)
+	case errors.Is(err, domain.ErrPermissionCycle):
+		return berr.ErrBadCall.WithMsg("parent cannot be self or its descendant").WithError(err)
+	}
+	return err
 }
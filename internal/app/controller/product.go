@@ -134,9 +134,27 @@ func (c *ProductController) Detail(ctx context.Context, id int64) (*domain.Produ
 
 type ProductListRequest struct {
 	Keyword string
+	PageRequest
 }
 
-func (c *ProductController) List(ctx context.Context, req ProductListRequest) ([]*domain.Product, error) {
-	param := usecase.ProductListParam{Keyword: req.Keyword}
-	return c.uc.List(ctx, param)
+func (r ProductListRequest) Validate() error {
+	return r.PageRequest.validate(domain.ProductSortFields)
+}
+
+func (c *ProductController) List(ctx context.Context, req ProductListRequest) (*domain.Page[*domain.Product], error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	param := usecase.ProductListParam{
+		Keyword: req.Keyword,
+		Page:    req.toPagination(),
+	}
+
+	page, err := c.uc.List(ctx, param)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return page, nil
 }
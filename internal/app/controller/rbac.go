@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+	icasbin "go-scaffold/internal/pkg/casbin"
+)
+
+type RBACController struct {
+	uc           usecase.RBACUseCaseInterface
+	permissionUC usecase.PermissionUseCaseInterface
+	tenantRepo   repository.TenantRepositoryInterface
+	transaction  repository.TransactionInterface
+}
+
+func NewRBACController(
+	uc usecase.RBACUseCaseInterface,
+	permissionUC usecase.PermissionUseCaseInterface,
+	tenantRepo repository.TenantRepositoryInterface,
+	transaction repository.TransactionInterface,
+) *RBACController {
+	return &RBACController{
+		uc:           uc,
+		permissionUC: permissionUC,
+		tenantRepo:   tenantRepo,
+		transaction:  transaction,
+	}
+}
+
+// Export export the roles of the tenant, it is used by the command line
+func (c *RBACController) Export(ctx context.Context, tenant int64) (*domain.RBACSnapshot, error) {
+	if err := c.validateTenant(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	return c.uc.Export(ctx, tenant)
+}
+
+type RBACImportRequest struct {
+	Tenant   int64
+	Snapshot domain.RBACSnapshot
+	DryRun   bool
+}
+
+func (r RBACImportRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Tenant, validation.Required.Error("tenant is required")),
+		validation.Field(&r.Snapshot, validation.By(func(any) error {
+			for _, d := range r.Snapshot.Permissions {
+				attr := PermissionAttr{Key: d.Key, Name: d.Name, Desc: d.Desc}
+				if err := attr.Validate(); err != nil {
+					return errors.Errorf("permission %q: %s", d.Key, err)
+				}
+			}
+
+			names := make(map[string]struct{}, len(r.Snapshot.Roles))
+			for _, role := range r.Snapshot.Roles {
+				if _, ok := names[role.Name]; ok {
+					return errors.Errorf("role %q is duplicated", role.Name)
+				}
+				names[role.Name] = struct{}{}
+
+				if err := validateRBACRole(role); err != nil {
+					return errors.Errorf("role %q: %s", role.Name, err)
+				}
+			}
+			return nil
+		})),
+	)
+}
+
+func validateRBACRole(role domain.RBACRole) error {
+	attr := RoleAttr{
+		Name:                 role.Name,
+		DataScope:            string(role.DataScope),
+		DataScopeDepartments: role.DataScopeDepartments,
+	}
+	if err := attr.Validate(); err != nil {
+		return err
+	}
+	if role.DataScope == "" {
+		return errors.New("data scope is required")
+	}
+
+	if lo.Contains(role.Parents, role.Name) {
+		return errors.New("role cannot inherit itself")
+	}
+	if len(lo.Uniq(role.Parents)) != len(role.Parents) {
+		return errors.New("parents are duplicated")
+	}
+
+	granted := make(map[string]struct{}, len(role.Grants))
+	for _, g := range role.Grants {
+		if g.Permission == "" {
+			return errors.New("permission of the grant is required")
+		}
+		if _, ok := granted[g.Permission]; ok {
+			return errors.Errorf("permission %q is granted more than once", g.Permission)
+		}
+		granted[g.Permission] = struct{}{}
+
+		if g.Condition == "" {
+			continue
+		}
+		if len(g.Condition) > 255 {
+			return errors.Errorf("the condition of the permission %q must be at most 255 characters", g.Permission)
+		}
+		if _, err := icasbin.ParseCondition(g.Condition); err != nil {
+			return errors.Errorf("the condition of the permission %q is invalid: %s", g.Permission, err)
+		}
+	}
+
+	return nil
+}
+
+// Import create the permissions and the roles of the snapshot that do not exist, and replace the data scopes,
+// grants and parents of the roles, it is used by the command line
+func (c *RBACController) Import(ctx context.Context, req RBACImportRequest) (*domain.RBACImportResult, error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithMsg(err.Error()).WithError(errors.WithStack(err))
+	}
+
+	if err := c.validateTenant(ctx, req.Tenant); err != nil {
+		return nil, err
+	}
+
+	var (
+		synced *domain.PermissionSyncResult
+		result *domain.RBACImportResult
+	)
+	apply := func(ctx context.Context) (err error) {
+		if synced, err = c.permissionUC.Sync(ctx, req.Snapshot.Permissions, req.DryRun); err != nil {
+			return err
+		}
+		result, err = c.uc.Import(ctx, req.Tenant, req.Snapshot, req.DryRun)
+		return err
+	}
+
+	// the permissions and the roles are imported in one transaction, the dry run makes no change
+	var err error
+	if req.DryRun {
+		err = apply(ctx)
+	} else {
+		err = c.transaction.Transaction(ctx, apply)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrRBACRoleNotFound) ||
+			errors.Is(err, domain.ErrRBACPermissionNotFound) ||
+			errors.Is(err, domain.ErrRoleInheritanceCycle) {
+			// the message is prefixed with the name of the role or the key of the permission
+			return nil, berr.ErrBadCall.WithMsg(err.Error()).WithError(err)
+		}
+		return nil, permissionParentError(err)
+	}
+	result.CreatedPermissions = synced.Created
+	result.UpdatedPermissions = synced.Updated
+	result.MisplacedPermissions = synced.Misplaced
+
+	return result, nil
+}
+
+func (c *RBACController) validateTenant(ctx context.Context, tenant int64) error {
+	exist, err := c.tenantRepo.Exist(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return berr.ErrResourceNotFound.WithMsg("tenant not exist")
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"go-scaffold/internal/app/repository"
 	"go-scaffold/internal/app/usecase"
 	berr "go-scaffold/internal/errors"
+	icasbin "go-scaffold/internal/pkg/casbin"
 )
 
 type RoleController struct {
@@ -33,7 +34,9 @@ func NewRoleController(
 }
 
 type RoleAttr struct {
-	Name string `json:"name"`
+	Name                 string  `json:"name"`
+	DataScope            string  `json:"dataScope"`            // optional, default: all
+	DataScopeDepartments []int64 `json:"dataScopeDepartments"` // required if the data scope is custom
 }
 
 func (r RoleAttr) Validate() error {
@@ -42,16 +45,38 @@ func (r RoleAttr) Validate() error {
 			validation.Required.Error("name is required"),
 			validation.Length(1, 32).Error("name must be 1 ~ 32 characters"),
 		),
+		validation.Field(&r.DataScope,
+			validation.In(lo.ToAnySlice(lo.Map(domain.DataScopes, func(item domain.DataScope, index int) string {
+				return string(item)
+			}))...).Error("data scope must be one of all, own, department, custom"),
+		),
+		validation.Field(&r.DataScopeDepartments,
+			validation.When(r.DataScope == string(domain.DataScopeCustom),
+				validation.Required.Error("departments are required for the custom data scope"),
+			).Else(
+				validation.Empty.Error("departments are only allowed for the custom data scope"),
+			),
+		),
 	)
 }
 
+func (r RoleAttr) dataScope() domain.DataScope {
+	if r.DataScope == "" {
+		return domain.DataScopeAll
+	}
+	return domain.DataScope(r.DataScope)
+}
+
 type RoleCreateRequest struct {
 	RoleAttr
 }
 
-func (r RoleCreateRequest) toEntity() domain.Role {
+func (r RoleCreateRequest) toEntity(tenant int64) domain.Role {
 	return domain.Role{
-		Name: r.Name,
+		TenantID:             tenant,
+		Name:                 r.Name,
+		DataScope:            r.dataScope(),
+		DataScopeDepartments: r.DataScopeDepartments,
 	}
 }
 
@@ -60,7 +85,9 @@ func (c *RoleController) Create(ctx context.Context, req RoleCreateRequest) erro
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	exist, err := c.roleRepo.NameExist(ctx, req.Name)
+	tenant := domain.TenantFromContext(ctx)
+
+	exist, err := c.roleRepo.NameExist(ctx, tenant, req.Name)
 	if err != nil {
 		return err
 	}
@@ -68,7 +95,7 @@ func (c *RoleController) Create(ctx context.Context, req RoleCreateRequest) erro
 		return berr.ErrBadCall.WithMsg("role name already exist").WithError(errors.New("name already exist"))
 	}
 
-	return c.uc.Create(ctx, req.toEntity())
+	return c.uc.Create(ctx, req.toEntity(tenant))
 }
 
 type RoleUpdateRequest struct {
@@ -78,8 +105,10 @@ type RoleUpdateRequest struct {
 
 func (r RoleUpdateRequest) toEntity() domain.Role {
 	return domain.Role{
-		ID:   r.ID,
-		Name: r.Name,
+		ID:                   r.ID,
+		Name:                 r.Name,
+		DataScope:            r.dataScope(),
+		DataScopeDepartments: r.DataScopeDepartments,
 	}
 }
 
@@ -95,14 +124,12 @@ func (c *RoleController) Update(ctx context.Context, req RoleUpdateRequest) erro
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	_, err := c.roleRepo.FindOne(ctx, req.ID)
-	if repository.IsNotFound(err) {
-		return berr.ErrResourceNotFound.WithError(err)
-	} else if err != nil {
+	role, err := c.findRole(ctx, req.ID)
+	if err != nil {
 		return err
 	}
 
-	exist, err := c.roleRepo.NameExistExcludeID(ctx, req.Name, req.ID)
+	exist, err := c.roleRepo.NameExistExcludeID(ctx, role.TenantID, req.Name, req.ID)
 	if err != nil {
 		return err
 	}
@@ -118,10 +145,8 @@ func (c *RoleController) Delete(ctx context.Context, id int64) error {
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	role, err := c.roleRepo.FindOne(ctx, id)
-	if repository.IsNotFound(err) {
-		return berr.ErrResourceNotFound.WithError(err)
-	} else if err != nil {
+	role, err := c.findRole(ctx, id)
+	if err != nil {
 		return err
 	}
 
@@ -139,28 +164,70 @@ func (c *RoleController) Detail(ctx context.Context, id int64) (*domain.Role, er
 	} else if err != nil {
 		return nil, err
 	}
+	if role.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithError(errors.New("role not exist in the tenant"))
+	}
 
 	return role, nil
 }
 
 type RoleListRequest struct {
 	Keyword string
+	PageRequest
 }
 
-func (c *RoleController) List(ctx context.Context, req RoleListRequest) ([]*domain.Role, error) {
-	param := usecase.RoleListParam{Keyword: req.Keyword}
-	return c.uc.List(ctx, param)
+func (r RoleListRequest) Validate() error {
+	return r.PageRequest.validate(domain.RoleSortFields)
+}
+
+func (c *RoleController) List(ctx context.Context, req RoleListRequest) (*domain.Page[*domain.Role], error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	param := usecase.RoleListParam{
+		TenantID: domain.TenantFromContext(ctx),
+		Keyword:  req.Keyword,
+		Page:     req.toPagination(),
+	}
+
+	page, err := c.uc.List(ctx, param)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return page, nil
 }
 
 type RoleGrantPermissionsRequest struct {
 	Role        int64
 	Permissions []int64
+	// Conditions the condition expressions of the permissions keyed by the permission id,
+	// the permissions without the conditions are unconditional
+	Conditions map[int64]string
+	Cascade    bool // grant the descendants of the permissions as well
 }
 
 func (r RoleGrantPermissionsRequest) Validate() error {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.Role, validation.Required.Error("role is required")),
 		validation.Field(&r.Permissions, validation.Required.Error("no permissions that will be granted")),
+		validation.Field(&r.Conditions,
+			validation.By(func(any) error {
+				for permission, condition := range r.Conditions {
+					if !lo.Contains(r.Permissions, permission) {
+						return errors.Errorf("the permission %d of the condition is not granted", permission)
+					}
+					if len(condition) > 255 {
+						return errors.Errorf("the condition of the permission %d must be at most 255 characters", permission)
+					}
+					if _, err := icasbin.ParseCondition(condition); err != nil {
+						return errors.Errorf("the condition of the permission %d is invalid: %s", permission, err)
+					}
+				}
+				return nil
+			}),
+		),
 	)
 }
 
@@ -169,21 +236,115 @@ func (c *RoleController) GrantPermissions(ctx context.Context, req RoleGrantPerm
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
+	if _, err := c.findRole(ctx, req.Role); err != nil {
+		return err
+	}
+
 	if err := c.validatePermissionsExist(ctx, req.Permissions); err != nil {
 		return err
 	}
 
-	return c.uc.GrantPermissions(ctx, req.Role, req.Permissions)
+	return c.uc.GrantPermissions(ctx, req.Role, req.Permissions, req.Conditions, req.Cascade)
 }
 
-func (c *RoleController) GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error) {
+func (c *RoleController) GetPermissions(ctx context.Context, id int64) ([]*domain.RolePermission, error) {
 	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
 		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
+	if _, err := c.findRole(ctx, id); err != nil {
+		return nil, err
+	}
+
 	return c.uc.GetPermissions(ctx, id)
 }
 
+type RoleSetParentsRequest struct {
+	Role    int64
+	Parents []int64 // the parent roles, empty to remove all the parents
+}
+
+func (r RoleSetParentsRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Role, validation.Required.Error("role is required")),
+	)
+}
+
+func (c *RoleController) SetParents(ctx context.Context, req RoleSetParentsRequest) error {
+	if err := req.Validate(); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	role, err := c.findRole(ctx, req.Role)
+	if err != nil {
+		return err
+	}
+
+	if err := c.validateRolesExist(ctx, role.TenantID, req.Parents); err != nil {
+		return err
+	}
+
+	if err := c.uc.SetParents(ctx, req.Role, req.Parents); err != nil {
+		if errors.Is(err, domain.ErrRoleInheritanceCycle) {
+			return berr.ErrBadCall.WithMsg("the role can not inherit itself or its descendants").WithError(err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *RoleController) GetParents(ctx context.Context, id int64) ([]*domain.Role, error) {
+	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	if _, err := c.findRole(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return c.uc.GetParents(ctx, id)
+}
+
+// findRole returns the role of the tenant that the request acts in,
+// the roles of the other tenants are treated as not found
+func (c *RoleController) findRole(ctx context.Context, id int64) (*domain.Role, error) {
+	role, err := c.roleRepo.FindOne(ctx, id)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+	if role.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithError(errors.New("role not exist in the tenant"))
+	}
+	return role, nil
+}
+
+// validateRolesExist the roles must exist in the tenant
+func (c *RoleController) validateRolesExist(ctx context.Context, tenant int64, roles []int64) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	list, err := c.roleRepo.FindList(ctx, roles)
+	if err != nil {
+		return err
+	}
+	list = lo.Filter(list, func(item *domain.Role, index int) bool {
+		return item.TenantID == tenant
+	})
+	roleList := lo.Map(list, func(item *domain.Role, index int) int64 {
+		return item.ID
+	})
+
+	diffs, _ := lo.Difference(roles, roleList)
+	if len(diffs) > 0 {
+		return berr.ErrBadCall.WithMsg(fmt.Sprintf("roles %v not exist", diffs)).WithError(errors.New("role not exist"))
+	}
+	return nil
+}
+
 func (c *RoleController) validatePermissionsExist(ctx context.Context, permissions []int64) error {
 	list, err := c.permissionRepo.FindList(ctx, permissions)
 	if err != nil {
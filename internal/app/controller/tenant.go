@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/usecase"
+	berr "go-scaffold/internal/errors"
+)
+
+type TenantController struct {
+	uc usecase.TenantUseCaseInterface
+}
+
+func NewTenantController(uc usecase.TenantUseCaseInterface) *TenantController {
+	return &TenantController{
+		uc: uc,
+	}
+}
+
+// ResolveTenant ensure the user can act in the tenant that the request specifies
+func (c *TenantController) ResolveTenant(ctx context.Context, user domain.UserProfile, tenant int64) error {
+	if err := c.uc.Resolve(ctx, user, tenant); err != nil {
+		if errors.Is(err, usecase.ErrTenantNotFound) || errors.Is(err, usecase.ErrTenantAccessDenied) {
+			return berr.ErrAccessDenied.WithMsg("access to the tenant is denied").WithError(err)
+		}
+		return err
+	}
+	return nil
+}
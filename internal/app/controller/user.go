@@ -3,6 +3,8 @@ package controller
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/pkg/errors"
@@ -16,41 +18,60 @@ import (
 )
 
 type UserController struct {
+	logger   *slog.Logger
+	hasher   domain.PasswordHasher
 	uc       usecase.UserUseCaseInterface
+	auc      usecase.AccountUseCaseInterface
+	ltuc     usecase.LoginThrottleUseCaseInterface
 	userRepo repository.UserRepositoryInterface
 	roleRepo repository.RoleRepositoryInterface
 }
 
 func NewUserController(
+	logger *slog.Logger,
+	hasher domain.PasswordHasher,
 	uc usecase.UserUseCaseInterface,
+	auc usecase.AccountUseCaseInterface,
+	ltuc usecase.LoginThrottleUseCaseInterface,
 	userRepo repository.UserRepositoryInterface,
 	roleRepo repository.RoleRepositoryInterface,
 ) *UserController {
 	return &UserController{
+		logger:   logger,
+		hasher:   hasher,
 		uc:       uc,
+		auc:      auc,
+		ltuc:     ltuc,
 		userRepo: userRepo,
 		roleRepo: roleRepo,
 	}
 }
 
 type UserAttr struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Nickname string `json:"nickname"`
-	Phone    string `json:"phone"`
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	Nickname     string `json:"nickname"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`        // optional
+	DepartmentID int64  `json:"departmentID"` // optional
 }
 
 func (r UserAttr) Validate() error {
+	return r.validate(true)
+}
+
+// validate the password is validated only if it is set when it is not required
+func (r UserAttr) validate(passwordRequired bool) error {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.Username,
 			validation.Required.Error("username is required"),
 			validation.Length(8, 16).Error("username must be 8 ~ 16 characters"),
 		),
-		validation.Field(&r.Password,
+		validation.Field(&r.Password, validation.When(passwordRequired || r.Password != "",
 			validation.Required.Error("password is required"),
 			validation.Length(8, 18).Error("password must be 8 ~ 18 characters"),
 			validation.By(validator.PasswordComplexity),
-		),
+		)),
 		validation.Field(&r.Nickname,
 			validation.Required.Error("nickname is required"),
 			validation.Length(8, 16).Error("nickname must be 8 ~ 16 characters"),
@@ -59,19 +80,54 @@ func (r UserAttr) Validate() error {
 			validation.Required.Error("phone is required"),
 			validation.By(validator.IsPhoneNumber),
 		),
+		validation.Field(&r.Email,
+			validation.Length(0, 255).Error("email must be at most 255 characters"),
+			validation.By(validator.IsEmail),
+		),
+		validation.Field(&r.DepartmentID, validation.Min(int64(0)).Error("department id must not be negative")),
 	)
 }
 
 type UserCreateRequest struct {
 	UserAttr
+	// ServiceAccount the service account authenticates with the API keys only,
+	// so the password and the phone are not required
+	ServiceAccount bool `json:"serviceAccount"`
 }
 
-func (r UserCreateRequest) toEntity() domain.User {
+func (r UserCreateRequest) Validate() error {
+	if !r.ServiceAccount {
+		return r.UserAttr.Validate()
+	}
+
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Username,
+			validation.Required.Error("username is required"),
+			validation.Length(8, 16).Error("username must be 8 ~ 16 characters"),
+		),
+		validation.Field(&r.Password, validation.Empty.Error("service account can not have a password")),
+		validation.Field(&r.Nickname,
+			validation.Required.Error("nickname is required"),
+			validation.Length(8, 16).Error("nickname must be 8 ~ 16 characters"),
+		),
+		validation.Field(&r.Phone, validation.When(r.Phone != "", validation.By(validator.IsPhoneNumber))),
+		validation.Field(&r.Email,
+			validation.Length(0, 255).Error("email must be at most 255 characters"),
+			validation.By(validator.IsEmail),
+		),
+		validation.Field(&r.DepartmentID, validation.Min(int64(0)).Error("department id must not be negative")),
+	)
+}
+
+func (r UserCreateRequest) toEntity(password domain.Password) domain.User {
 	return domain.User{
-		Username: r.Username,
-		Password: domain.Plaintext(r.Password).Encrypt(),
-		Nickname: r.Nickname,
-		Phone:    r.Phone,
+		Username:       r.Username,
+		Password:       password,
+		Nickname:       r.Nickname,
+		Phone:          r.Phone,
+		Email:          r.Email,
+		ServiceAccount: r.ServiceAccount,
+		DepartmentID:   r.DepartmentID,
 	}
 }
 
@@ -88,7 +144,22 @@ func (c *UserController) Create(ctx context.Context, req UserCreateRequest) erro
 		return berr.ErrBadCall.WithMsg("username already exist").WithError(errors.New("username already exist"))
 	}
 
-	_, err = c.uc.Create(ctx, req.toEntity())
+	if err := checkEmail(ctx, c.userRepo, req.Email, 0); err != nil {
+		return err
+	}
+
+	var password domain.Password
+	if !req.ServiceAccount {
+		password, err = c.hasher.Hash(domain.Plaintext(req.Password))
+		if err != nil {
+			return err
+		}
+	}
+
+	user := req.toEntity(password)
+	user.TenantID = domain.TenantFromContext(ctx)
+
+	_, err = c.uc.Create(ctx, user)
 	return err
 }
 
@@ -97,35 +168,46 @@ type UserUpdateRequest struct {
 	UserAttr
 }
 
-func (r UserUpdateRequest) toEntity() domain.User {
+func (r UserUpdateRequest) toEntity(password domain.Password) domain.User {
 	return domain.User{
-		ID:       r.ID,
-		Username: r.Username,
-		Password: domain.Plaintext(r.Password).Encrypt(),
-		Nickname: r.Nickname,
-		Phone:    r.Phone,
+		ID:           r.ID,
+		Username:     r.Username,
+		Password:     password,
+		Nickname:     r.Nickname,
+		Phone:        r.Phone,
+		DepartmentID: r.DepartmentID,
 	}
 }
 
 func (r UserUpdateRequest) Validate() error {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.ID, validation.Required.Error("id is required")),
-		validation.Field(&r.UserAttr),
 	)
 }
 
+// validateAttr the attributes of the service account are validated as they are created,
+// the password of the other users is kept if it is empty
+func (r UserUpdateRequest) validateAttr(serviceAccount bool) error {
+	if serviceAccount {
+		return UserCreateRequest{UserAttr: r.UserAttr, ServiceAccount: true}.Validate()
+	}
+	return r.UserAttr.validate(false)
+}
+
 func (c *UserController) Update(ctx context.Context, req UserUpdateRequest) error {
 	if err := req.Validate(); err != nil {
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	_, err := c.userRepo.FindOne(ctx, req.ID)
-	if repository.IsNotFound(err) {
-		return berr.ErrResourceNotFound.WithError(err)
-	} else if err != nil {
+	old, err := c.findUser(ctx, req.ID)
+	if err != nil {
 		return err
 	}
 
+	if err := req.validateAttr(old.ServiceAccount); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
 	exist, err := c.userRepo.UsernameExistExcludeID(ctx, req.Username, req.ID)
 	if err != nil {
 		return err
@@ -134,8 +216,48 @@ func (c *UserController) Update(ctx context.Context, req UserUpdateRequest) erro
 		return berr.ErrBadCall.WithMsg("User name already exist").WithError(errors.New("name already exist"))
 	}
 
-	_, err = c.uc.Update(ctx, req.toEntity())
-	return err
+	if err := checkEmail(ctx, c.userRepo, req.Email, req.ID); err != nil {
+		return err
+	}
+
+	password := old.Password
+	if req.Password != "" {
+		password, err = c.hasher.Hash(domain.Plaintext(req.Password))
+		if err != nil {
+			return err
+		}
+	}
+
+	user := req.toEntity(password)
+	user.Email, user.EmailVerifiedAt = old.Email, old.EmailVerifiedAt
+	user.ChangeEmail(req.Email)
+
+	if _, err = c.uc.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// the sessions on all the devices are revoked by the change of the password
+	if req.Password != "" {
+		return c.auc.RevokeAllSessions(ctx, user)
+	}
+
+	return nil
+}
+
+// checkEmail the email is unique among the users, the excludeID is 0 if no user is excluded
+func checkEmail(ctx context.Context, userRepo repository.UserRepositoryInterface, email string, excludeID int64) error {
+	if email == "" {
+		return nil
+	}
+
+	exist, err := userRepo.EmailExistExcludeID(ctx, email, excludeID)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return berr.ErrBadCall.WithMsg("email already exist").WithError(errors.New("email already exist"))
+	}
+	return nil
 }
 
 func (c *UserController) Delete(ctx context.Context, id int64) error {
@@ -143,14 +265,12 @@ func (c *UserController) Delete(ctx context.Context, id int64) error {
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	role, err := c.userRepo.FindOne(ctx, id)
-	if repository.IsNotFound(err) {
-		return berr.ErrResourceNotFound.WithError(err)
-	} else if err != nil {
+	user, err := c.findUser(ctx, id)
+	if err != nil {
 		return err
 	}
 
-	return c.uc.Delete(ctx, *role)
+	return c.uc.Delete(ctx, *user)
 }
 
 func (c *UserController) Detail(ctx context.Context, id int64) (*domain.User, error) {
@@ -164,56 +284,194 @@ func (c *UserController) Detail(ctx context.Context, id int64) (*domain.User, er
 	} else if err != nil {
 		return nil, err
 	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithError(errors.New("user not exist in the tenant"))
+	}
 
 	return user, nil
 }
 
+// Unlock lift the login lockout of the user
+func (c *UserController) Unlock(ctx context.Context, id int64) error {
+	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
+		return berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.findUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := c.ltuc.Unlock(ctx, *user); err != nil {
+		return err
+	}
+
+	c.logger.Info("login lockout lifted", slog.Int64("user", user.ID), slog.String("username", user.Username))
+
+	return nil
+}
+
 type UserListRequest struct {
 	Keyword string
+	PageRequest
+}
+
+func (r UserListRequest) Validate() error {
+	return r.PageRequest.validate(domain.UserSortFields)
 }
 
-func (c *UserController) List(ctx context.Context, req UserListRequest) ([]*domain.User, error) {
-	param := usecase.UserListParam{Keyword: req.Keyword}
-	return c.uc.List(ctx, param)
+func (c *UserController) List(ctx context.Context, req UserListRequest) (*domain.Page[*domain.User], error) {
+	if err := req.Validate(); err != nil {
+		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	param := usecase.UserListParam{
+		TenantID: domain.TenantFromContext(ctx),
+		Keyword:  req.Keyword,
+		Page:     req.toPagination(),
+	}
+
+	page, err := c.uc.List(ctx, param)
+	if err != nil {
+		return nil, pageError(err)
+	}
+
+	return page, nil
+}
+
+// UserRoleGrant the role granted within the validity window
+type UserRoleGrant struct {
+	Role      int64
+	StartsAt  int64 // in effect immediately if it is 0
+	ExpiresAt int64 // never expires if it is 0
+}
+
+func (r UserRoleGrant) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.Role, validation.Required.Error("role is required")),
+		validation.Field(&r.ExpiresAt,
+			validation.Min(time.Now().Unix()+1).Error("expiration time must be in the future"),
+			validation.Min(r.StartsAt+1).Error("expiration time must be after the start time"),
+		),
+	)
 }
 
 type UserAssignRoleRequest struct {
-	User  int64
-	Roles []int64
+	User   int64
+	Roles  []int64 // the roles granted without the validity windows
+	Grants []UserRoleGrant
 }
 
 func (r UserAssignRoleRequest) Validate() error {
 	return validation.ValidateStruct(&r,
 		validation.Field(&r.User, validation.Required.Error("user is required")),
-		validation.Field(&r.Roles, validation.Required.Error("no roles that will be assigned")),
+		validation.Field(&r.Roles,
+			validation.When(len(r.Grants) == 0, validation.Required.Error("no roles that will be assigned")),
+		),
+		validation.Field(&r.Grants,
+			validation.By(func(any) error {
+				roles := r.roles()
+				if len(lo.Uniq(roles)) != len(roles) {
+					return errors.New("the role can only be granted once")
+				}
+				return nil
+			}),
+		),
 	)
 }
 
+// roles all the roles that will be assigned
+func (r UserAssignRoleRequest) roles() []int64 {
+	return append(lo.Map(r.Grants, func(item UserRoleGrant, index int) int64 {
+		return item.Role
+	}), r.Roles...)
+}
+
 func (c *UserController) AssignRoles(ctx context.Context, req UserAssignRoleRequest) error {
 	if err := req.Validate(); err != nil {
 		return berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	if err := c.validateRolesExist(ctx, req.Roles); err != nil {
+	// the user of the other tenant becomes a member of the tenant by the roles
+	tenant := domain.TenantFromContext(ctx)
+	if err := c.validateRolesExist(ctx, tenant, req.roles()); err != nil {
 		return err
 	}
 
-	return c.uc.AssignRoles(ctx, req.User, req.Roles)
+	grants := lo.Map(req.Roles, func(item int64, index int) domain.RoleGrant {
+		return domain.RoleGrant{RoleID: item}
+	})
+	for _, item := range req.Grants {
+		grants = append(grants, domain.RoleGrant{
+			RoleID:    item.Role,
+			StartsAt:  item.StartsAt,
+			ExpiresAt: item.ExpiresAt,
+		})
+	}
+
+	return c.uc.AssignRoles(ctx, tenant, req.User, grants)
 }
 
-func (c *UserController) GetRoles(ctx context.Context, id int64) ([]*domain.Role, error) {
+func (c *UserController) GetRoles(ctx context.Context, id int64) ([]*domain.RoleGrant, error) {
 	if err := validation.Validate(id, validation.Required.Error("id is required")); err != nil {
 		return nil, berr.ErrValidateError.WithError(errors.WithStack(err))
 	}
 
-	return c.uc.GetRoles(ctx, id)
+	return c.uc.GetRoles(ctx, domain.TenantFromContext(ctx), id)
 }
 
-func (c *UserController) validateRolesExist(ctx context.Context, roles []int64) error {
+// GrantSuperuser grant the super admin role to the user, it is used by the command line
+func (c *UserController) GrantSuperuser(ctx context.Context, username string) (*domain.User, int64, error) {
+	if err := validation.Validate(username, validation.Required.Error("username is required")); err != nil {
+		return nil, 0, berr.ErrValidateError.WithError(errors.WithStack(err))
+	}
+
+	user, err := c.userRepo.FindOneByUsername(ctx, username)
+	if repository.IsNotFound(err) {
+		return nil, 0, berr.ErrResourceNotFound.WithMsg("user not exist").WithError(err)
+	} else if err != nil {
+		return nil, 0, err
+	}
+	if user.ServiceAccount {
+		return nil, 0, berr.ErrBadCall.WithMsg("the service account can not be a super admin")
+	}
+
+	role, err := c.uc.GrantSuperuser(ctx, *user)
+	if errors.Is(err, usecase.ErrNoSuperAdminRole) {
+		return nil, 0, berr.ErrBadCall.WithMsg("no super admin role is configured in casbin.superAdmin.roles").WithError(err)
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	c.logger.Warn("super admin role granted", slog.Int64("user", user.ID), slog.Int64("role", role))
+
+	return user, role, nil
+}
+
+// findUser returns the user of the tenant that the request acts in,
+// the users of the other tenants are treated as not found
+func (c *UserController) findUser(ctx context.Context, id int64) (*domain.User, error) {
+	user, err := c.userRepo.FindOne(ctx, id)
+	if repository.IsNotFound(err) {
+		return nil, berr.ErrResourceNotFound.WithError(err)
+	} else if err != nil {
+		return nil, err
+	}
+	if user.TenantID != domain.TenantFromContext(ctx) {
+		return nil, berr.ErrResourceNotFound.WithError(errors.New("user not exist in the tenant"))
+	}
+	return user, nil
+}
+
+// validateRolesExist the roles must exist in the tenant
+func (c *UserController) validateRolesExist(ctx context.Context, tenant int64, roles []int64) error {
 	list, err := c.roleRepo.FindList(ctx, roles)
 	if err != nil {
 		return err
 	}
+	list = lo.Filter(list, func(item *domain.Role, index int) bool {
+		return item.TenantID == tenant
+	})
 	roleList := lo.Map(list, func(item *domain.Role, index int) int64 {
 		return item.ID
 	})
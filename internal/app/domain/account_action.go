@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountActionPurpose the purpose that the account action token is issued for
+type AccountActionPurpose string
+
+func (p AccountActionPurpose) String() string {
+	return string(p)
+}
+
+const (
+	PasswordResetPurpose     AccountActionPurpose = "password_reset"
+	EmailVerificationPurpose AccountActionPurpose = "email_verification"
+)
+
+const (
+	PasswordResetTokenExpireDuration     = time.Minute * 30
+	EmailVerificationTokenExpireDuration = time.Hour * 24
+)
+
+// AccountAction the action that is confirmed by the token sent to the email of the user,
+// such as resetting the password and verifying the email
+//
+// the token is signed, and the ID is recorded on the server side so that the token can only be used once,
+// only the latest token of the same purpose is valid
+type AccountAction struct {
+	ID      string               `json:"id"`
+	Purpose AccountActionPurpose `json:"purpose"`
+	UserID  int64                `json:"userID"`
+	// Email the email that the token is sent to, the token is invalidated if the email is changed
+	Email     string    `json:"email"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// NewAccountAction returns *AccountAction
+func NewAccountAction(purpose AccountActionPurpose, userID int64, email string, expire time.Duration) *AccountAction {
+	return &AccountAction{
+		ID:        uuid.New().String(),
+		Purpose:   purpose,
+		UserID:    userID,
+		Email:     email,
+		ExpiresAt: time.Now().Add(expire),
+	}
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// APIKeyPrefix the API key is formatted as "gsk_<prefix>_<secret>",
+// so that it can be recognized by the secret scanners, and looked up by the prefix
+const APIKeyPrefix = "gsk"
+
+const APIKeyTouchInterval = time.Minute
+
+// ErrMalformedAPIKey the API key is not in the format of "gsk_<prefix>_<secret>"
+var ErrMalformedAPIKey = errors.New("malformed API key")
+
+// APIKey the credential of the service account
+type APIKey struct {
+	ID     int64  `json:"id"`
+	UserID int64  `json:"userID"`
+	Name   string `json:"name"`
+	Prefix string `json:"prefix"`
+	Hash   string `json:"-"` // sha256 digest of the key, the key is never stored
+	// Scopes the permission keys that the API key is restricted to, empty if unrestricted,
+	// the API key can never exceed the permissions of the service account
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  int64    `json:"expiresAt"`  // unix timestamp, 0 if never expires
+	LastUsedAt int64    `json:"lastUsedAt"` // unix timestamp
+	CreatedAt  int64    `json:"createdAt"`  // unix timestamp
+}
+
+// NewAPIKey generate a random API key, the plaintext key is only returned here
+func NewAPIKey(userID int64, name string, scopes []string, expiresAt int64) (*APIKey, string, error) {
+	prefix := make([]byte, 6)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", errors.WithStack(err)
+	}
+
+	e := &APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    hex.EncodeToString(prefix),
+		Scopes:    lo.Uniq(scopes),
+		ExpiresAt: expiresAt,
+	}
+	key := APIKeyPrefix + "_" + e.Prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	e.Hash = APIKeyDigest(key)
+
+	return e, key, nil
+}
+
+// ParseAPIKeyPrefix returns the prefix that identifies the API key
+func ParseAPIKeyPrefix(key string) (string, error) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != APIKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", errors.WithStack(ErrMalformedAPIKey)
+	}
+	return parts[1], nil
+}
+
+// APIKeyDigest the API key has enough entropy, a plain sha256 digest is sufficient
+func APIKeyDigest(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify reports whether the plaintext key matches the digest
+func (k APIKey) Verify(key string) bool {
+	return subtle.ConstantTimeCompare([]byte(k.Hash), []byte(APIKeyDigest(key))) == 1
+}
+
+// IsExpired reports whether the API key has expired at the time
+func (k APIKey) IsExpired(t time.Time) bool {
+	return k.ExpiresAt > 0 && t.Unix() >= k.ExpiresAt
+}
+
+// NeedsTouch the last used time is updated at most once per interval
+func (k APIKey) NeedsTouch(t time.Time) bool {
+	return t.Sub(time.Unix(k.LastUsedAt, 0)) >= APIKeyTouchInterval
+}
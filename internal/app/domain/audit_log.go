@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// ImpersonationTokenExpireDuration the impersonation token is not refreshable,
+// the actor impersonates again after it expires
+const ImpersonationTokenExpireDuration = time.Minute * 15
+
+// AuditAction the kind of the audited action
+type AuditAction string
+
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+const (
+	// AuditActionImpersonate the actor starts impersonating the user
+	AuditActionImpersonate AuditAction = "impersonate"
+	// AuditActionImpersonatedRequest the request made by the actor under impersonation
+	AuditActionImpersonatedRequest AuditAction = "impersonated_request"
+)
+
+// AuditLog the record of the action that is made on behalf of the user
+type AuditLog struct {
+	ID        int64       `json:"id"`
+	TenantID  int64       `json:"tenantID"` // the tenant that the actor acts in
+	ActorID   int64       `json:"actorID"`  // the real user
+	UserID    int64       `json:"userID"`   // the impersonated user
+	Action    AuditAction `json:"action"`
+	Method    string      `json:"method"`
+	Path      string      `json:"path"`
+	Status    int         `json:"status"`
+	IP        string      `json:"ip"`
+	UserAgent string      `json:"userAgent"`
+	Detail    string      `json:"detail"`    // e.g. the reason of the impersonation
+	CreatedAt int64       `json:"createdAt"` // unix timestamp
+}
@@ -0,0 +1,34 @@
+package domain
+
+// PermissionExplanation the explanation of the decision on the permission of the user within the tenant
+type PermissionExplanation struct {
+	Allowed bool `json:"allowed"`
+	// SuperAdmin the permission is granted by isSuperAdmin rather than the policies
+	SuperAdmin bool `json:"superAdmin"`
+	// PermissionExist the permission that does not exist is always denied
+	PermissionExist bool        `json:"permissionExist"`
+	Permission      *Permission `json:"permission"`
+	// Policies the policy lines of the permission, granted to the user or the roles that the user inherits
+	Policies [][]string `json:"policies"`
+	// Conditions the condition lines of the policies, the policy is only in effect when its conditions are met,
+	// they are evaluated against the environment of the request of the explanation
+	Conditions [][]string `json:"conditions"`
+	// RolePath the subjects from the user to the subject of the policy line that grants the permission,
+	// e.g. user_1, role_2, role_3
+	RolePath []string `json:"rolePath"`
+}
+
+// CacheStats the statistics of the in-process cache, the capacity is 0 if the cache is disabled
+type CacheStats struct {
+	Size     int     `json:"size"`
+	Capacity int     `json:"capacity"`
+	Hits     uint64  `json:"hits"`
+	Misses   uint64  `json:"misses"`
+	HitRatio float64 `json:"hitRatio"`
+}
+
+// AuthzCacheStats the statistics of the caches of the authorization path
+type AuthzCacheStats struct {
+	Permissions CacheStats `json:"permissions"` // the permissions found by the keys
+	Decisions   CacheStats `json:"decisions"`   // the enforcement decisions
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	LoginChallengeExpireDuration = time.Minute * 5
+	LoginChallengeMaxAttempts    = 5
+)
+
+// LoginChallenge the login that waits for the second factor
+//
+// it is issued when the password is verified,
+// and exchanged for the token pair when the TOTP code or a recovery code is verified
+type LoginChallenge struct {
+	Token  string        `json:"-"`
+	UserID int64         `json:"userID"`
+	Client SessionClient `json:"client"`
+	// EnrollRequired the user is required to enroll TOTP before completing the login
+	EnrollRequired bool      `json:"enrollRequired"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// NewLoginChallenge generate a random challenge token
+func NewLoginChallenge(userID int64, client SessionClient, enrollRequired bool, expire time.Duration) (*LoginChallenge, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &LoginChallenge{
+		Token:          base64.RawURLEncoding.EncodeToString(b),
+		UserID:         userID,
+		Client:         client,
+		EnrollRequired: enrollRequired,
+		ExpiresAt:      time.Now().Add(expire),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+)
+
+// DataScope the rows of the data that the role may see
+type DataScope string
+
+const (
+	DataScopeAll        DataScope = "all"        // all the rows
+	DataScopeOwn        DataScope = "own"        // the rows owned by the user
+	DataScopeDepartment DataScope = "department" // the rows of the department that the user belongs to
+	DataScopeCustom     DataScope = "custom"     // the rows of the departments that the role specifies
+)
+
+// DataScopes all the data scopes
+var DataScopes = []DataScope{DataScopeAll, DataScopeOwn, DataScopeDepartment, DataScopeCustom}
+
+// DataFilter the rows that the user may see, merged from the data scopes of the roles of the user
+type DataFilter struct {
+	UserID       int64   // the user that the rows are owned by
+	DepartmentID int64   // the department that the user belongs to
+	All          bool    // all the rows are visible, the other restrictions are ignored
+	Own          bool    // the rows owned by the user are visible
+	Departments  []int64 // the rows of the departments are visible
+}
+
+// NewDataFilter merge the data scopes of the roles, the roles widen the visible rows of each other,
+// the user may only see its own rows if it has no roles
+func NewDataFilter(user *User, roles []*Role) DataFilter {
+	filter := DataFilter{
+		UserID:       user.ID,
+		DepartmentID: user.DepartmentID,
+		Own:          len(roles) == 0,
+	}
+
+	for _, role := range roles {
+		switch role.DataScope {
+		case DataScopeAll:
+			filter.All = true
+		case DataScopeOwn:
+			filter.Own = true
+		case DataScopeDepartment:
+			if user.DepartmentID != 0 {
+				filter.Departments = append(filter.Departments, user.DepartmentID)
+			}
+		case DataScopeCustom:
+			filter.Departments = append(filter.Departments, role.DataScopeDepartments...)
+		}
+	}
+	filter.Departments = lo.Uniq(filter.Departments)
+
+	return filter
+}
+
+type dataFilterContextKey struct{}
+
+// NewDataFilterContext returns a new context that carries the rows that the request may see
+func NewDataFilterContext(ctx context.Context, filter DataFilter) context.Context {
+	return context.WithValue(ctx, dataFilterContextKey{}, filter)
+}
+
+// DataFilterFromContext returns the rows that the request may see,
+// false is returned if the context carries no filter, e.g. the commands, then all the rows are visible
+func DataFilterFromContext(ctx context.Context) (DataFilter, bool) {
+	filter, ok := ctx.Value(dataFilterContextKey{}).(DataFilter)
+	return filter, ok
+}
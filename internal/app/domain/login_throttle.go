@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+)
+
+// LoginThrottlePolicy the policy against brute-force login
+type LoginThrottlePolicy struct {
+	FreeAttempts    int64
+	MaxAttempts     int64
+	MaxIPAttempts   int64
+	MaxDelay        time.Duration
+	Window          time.Duration
+	LockoutDuration time.Duration
+}
+
+// Delay returns the delay before the next attempt after the failures,
+// which doubles with every failure beyond the free attempts
+func (p LoginThrottlePolicy) Delay(failures int64) time.Duration {
+	if failures < p.FreeAttempts {
+		return 0
+	}
+
+	delay := time.Second
+	for i := p.FreeAttempts; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, p.MaxDelay)
+}
+
+// LoginAttempts the failed login attempts of a username or an IP
+type LoginAttempts struct {
+	Failures     int64     `json:"failures"`
+	LastFailedAt time.Time `json:"lastFailedAt"`
+	LockedUntil  time.Time `json:"lockedUntil"`
+}
+
+// IsLocked reports whether it is locked out at the time
+func (a LoginAttempts) IsLocked(t time.Time) bool {
+	return t.Before(a.LockedUntil)
+}
+
+// RetryAfter returns the time to wait before the next attempt
+func (a LoginAttempts) RetryAfter(policy LoginThrottlePolicy, t time.Time) time.Duration {
+	if a.IsLocked(t) {
+		return a.LockedUntil.Sub(t)
+	}
+	if a.Failures == 0 {
+		return 0
+	}
+	return max(a.LastFailedAt.Add(policy.Delay(a.Failures)).Sub(t), 0)
+}
+
+// LoginAttemptSubject the username or IP that the attempts are counted by
+type LoginAttemptSubject string
+
+// UsernameLoginAttemptSubject the username is case-sensitive, as it is stored
+func UsernameLoginAttemptSubject(username string) LoginAttemptSubject {
+	return LoginAttemptSubject("username:" + username)
+}
+
+func IPLoginAttemptSubject(ip string) LoginAttemptSubject {
+	return LoginAttemptSubject("ip:" + ip)
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OIDCAuthorizationExpireDuration the time that the user has to sign in at the identity provider
+const OIDCAuthorizationExpireDuration = time.Minute * 10
+
+// OIDCAuthorization the pending authorization request of the OpenID Connect login,
+// it is recorded by the state and consumed by the callback
+type OIDCAuthorization struct {
+	State    string `json:"state"`
+	Provider string `json:"provider"`
+	// Nonce binds the ID token to the authorization request, see OpenID Connect Core 1.0
+	Nonce string `json:"nonce"`
+	// CodeVerifier the PKCE code verifier, see RFC 7636
+	CodeVerifier string `json:"codeVerifier"`
+	// BindingDigest the digest of the binding, the binding itself is only held by the browser that starts the login
+	BindingDigest string    `json:"bindingDigest"`
+	Binding       string    `json:"-"`
+	ExpiresAt     time.Time `json:"expiresAt"`
+}
+
+// NewOIDCAuthorization generate the random state, nonce, code verifier and binding
+func NewOIDCAuthorization(provider string) (*OIDCAuthorization, error) {
+	values := make([]string, 4)
+	for i := range values {
+		b := make([]byte, 32)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		values[i] = base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	return &OIDCAuthorization{
+		State:         values[0],
+		Provider:      provider,
+		Nonce:         values[1],
+		CodeVerifier:  values[2],
+		BindingDigest: digestOIDCBinding(values[3]),
+		Binding:       values[3],
+		ExpiresAt:     time.Now().Add(OIDCAuthorizationExpireDuration),
+	}, nil
+}
+
+// Bound reports whether the callback comes from the browser that starts the login
+func (a OIDCAuthorization) Bound(binding string) bool {
+	if binding == "" || a.BindingDigest == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(digestOIDCBinding(binding)), []byte(a.BindingDigest)) == 1
+}
+
+func digestOIDCBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// CodeChallenge the S256 code challenge of the code verifier
+func (a OIDCAuthorization) CodeChallenge() string {
+	sum := sha256.Sum256([]byte(a.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// OIDCIdentity the claims of the verified ID token
+type OIDCIdentity struct {
+	Provider      string
+	Subject       string
+	Username      string
+	Name          string
+	Email         string
+	EmailVerified bool
+	// Groups the values of the role claim
+	Groups []string
+}
+
+// UserIdentity links the user to the subject at the identity provider
+type UserIdentity struct {
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"userID"`
+	Provider  string `json:"provider"`
+	Subject   string `json:"subject"`
+	CreatedAt int64  `json:"createdAt"`
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrInvalidCursor the cursor is malformed, or it is issued for another sorting
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidSortField the list can not be sorted by the field
+	ErrInvalidSortField = errors.New("invalid sort field")
+)
+
+// SortOrder the order of the sort field
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// SortOrders all the sort orders
+var SortOrders = []SortOrder{SortOrderAsc, SortOrderDesc}
+
+// the fields that the lists can be sorted by, the rows of the same value are sorted by the id
+const (
+	SortFieldID        = "id"
+	SortFieldCreatedAt = "createdAt"
+	SortFieldUpdatedAt = "updatedAt" // the default sort field
+)
+
+// Pagination the page of the list, the rows after the cursor are returned if it is set, otherwise the rows after the offset
+type Pagination struct {
+	Offset int
+	Limit  int    // all the rows if it is 0
+	Cursor string // the opaque cursor returned with the previous page
+	Sort   string // default: updatedAt
+	Order  SortOrder
+}
+
+// Page the page of the list
+type Page[T any] struct {
+	Items []T `json:"items"`
+	// Total the number of all the rows that match the filter, regardless of the pagination
+	Total int `json:"total"`
+	// NextCursor the cursor of the rows after the page, empty if it is the last page
+	NextCursor string `json:"nextCursor"`
+}
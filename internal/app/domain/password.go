@@ -0,0 +1,276 @@
+package domain
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	// ErrUnsupportedPasswordHash the password hash is not produced by a supported algorithm
+	ErrUnsupportedPasswordHash = errors.New("unsupported password hash")
+
+	// ErrMalformedPasswordHash the password hash can not be parsed
+	ErrMalformedPasswordHash = errors.New("malformed password hash")
+)
+
+const (
+	PasswordAlgorithmBcrypt   = "bcrypt"
+	PasswordAlgorithmArgon2id = "argon2id"
+	PasswordAlgorithmMD5      = "md5"
+)
+
+var legacyPasswordPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// Plaintext the password entered by the user
+type Plaintext string
+
+// Password the hashed password, in PHC string format
+type Password string
+
+// Algorithm returns the algorithm that produced the password hash
+func (p Password) Algorithm() string {
+	if p.IsLegacy() {
+		return PasswordAlgorithmMD5
+	}
+
+	id, _, _ := strings.Cut(strings.TrimPrefix(string(p), "$"), "$")
+	switch id {
+	case "2a", "2b", "2y":
+		return PasswordAlgorithmBcrypt
+	case "argon2id":
+		return PasswordAlgorithmArgon2id
+	}
+	return ""
+}
+
+// IsLegacy reports whether the password is an unsalted md5 hash
+func (p Password) IsLegacy() bool {
+	return legacyPasswordPattern.MatchString(string(p))
+}
+
+// PasswordHasher hash and verify the password
+type PasswordHasher interface {
+	// Hash returns the hash of plaintext
+	Hash(plaintext Plaintext) (Password, error)
+
+	// Verify reports whether the plaintext matches the password hash
+	Verify(password Password, plaintext Plaintext) (bool, error)
+
+	// NeedsRehash reports whether the password hash should be upgraded
+	// because it was produced by another algorithm or weaker parameters
+	NeedsRehash(password Password) bool
+}
+
+var (
+	_ PasswordHasher = (*BcryptPasswordHasher)(nil)
+	_ PasswordHasher = (*Argon2idPasswordHasher)(nil)
+	_ PasswordHasher = (*DelegatingPasswordHasher)(nil)
+)
+
+// BcryptPasswordHasher bcrypt password hasher
+type BcryptPasswordHasher struct {
+	Cost int
+}
+
+// NewBcryptPasswordHasher returns *BcryptPasswordHasher
+//
+// if cost is out of range, bcrypt.DefaultCost is used
+func NewBcryptPasswordHasher(cost int) *BcryptPasswordHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptPasswordHasher{Cost: cost}
+}
+
+func (h *BcryptPasswordHasher) Hash(plaintext Plaintext) (Password, error) {
+	b, err := bcrypt.GenerateFromPassword([]byte(plaintext), h.Cost)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return Password(b), nil
+}
+
+func (h *BcryptPasswordHasher) Verify(password Password, plaintext Plaintext) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(password), []byte(plaintext))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return true, nil
+}
+
+func (h *BcryptPasswordHasher) NeedsRehash(password Password) bool {
+	if password.Algorithm() != PasswordAlgorithmBcrypt {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(password))
+	if err != nil {
+		return true
+	}
+	return cost != h.Cost
+}
+
+// Argon2idPasswordHasher argon2id password hasher
+type Argon2idPasswordHasher struct {
+	Memory      uint32 // memory in KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// NewArgon2idPasswordHasher returns *Argon2idPasswordHasher
+//
+// zero parameters are replaced with the defaults
+func NewArgon2idPasswordHasher(memory, iterations uint32, parallelism uint8) *Argon2idPasswordHasher {
+	if memory == 0 {
+		memory = 64 * 1024
+	}
+	if iterations == 0 {
+		iterations = 3
+	}
+	if parallelism == 0 {
+		parallelism = 2
+	}
+	return &Argon2idPasswordHasher{
+		Memory:      memory,
+		Iterations:  iterations,
+		Parallelism: parallelism,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+func (h *Argon2idPasswordHasher) Hash(plaintext Plaintext) (Password, error) {
+	salt := make([]byte, h.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	key := argon2.IDKey([]byte(plaintext), salt, h.Iterations, h.Memory, h.Parallelism, h.KeyLength)
+
+	return Password(fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		h.Memory,
+		h.Iterations,
+		h.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)), nil
+}
+
+func (h *Argon2idPasswordHasher) Verify(password Password, plaintext Plaintext) (bool, error) {
+	params, salt, key, err := h.decode(password)
+	if err != nil {
+		return false, err
+	}
+
+	other := argon2.IDKey([]byte(plaintext), salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (h *Argon2idPasswordHasher) NeedsRehash(password Password) bool {
+	params, salt, key, err := h.decode(password)
+	if err != nil {
+		return true
+	}
+	return params.Memory != h.Memory ||
+		params.Iterations != h.Iterations ||
+		params.Parallelism != h.Parallelism ||
+		uint32(len(salt)) != h.SaltLength ||
+		uint32(len(key)) != h.KeyLength
+}
+
+// decode parse the PHC string, like: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func (h *Argon2idPasswordHasher) decode(password Password) (params *Argon2idPasswordHasher, salt, key []byte, err error) {
+	parts := strings.Split(string(password), "$")
+	if len(parts) != 6 || parts[1] != PasswordAlgorithmArgon2id {
+		return nil, nil, nil, errors.WithStack(ErrMalformedPasswordHash)
+	}
+
+	var version int
+	if _, err = fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, nil, nil, errors.Wrap(ErrMalformedPasswordHash, err.Error())
+	}
+	if version != argon2.Version {
+		return nil, nil, nil, errors.Wrapf(ErrUnsupportedPasswordHash, "argon2 version %d", version)
+	}
+
+	params = new(Argon2idPasswordHasher)
+	if _, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return nil, nil, nil, errors.Wrap(ErrMalformedPasswordHash, err.Error())
+	}
+
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, nil, nil, errors.Wrap(ErrMalformedPasswordHash, err.Error())
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, nil, nil, errors.Wrap(ErrMalformedPasswordHash, err.Error())
+	}
+
+	return params, salt, key, nil
+}
+
+// DelegatingPasswordHasher hashes new passwords with the current hasher,
+// and verifies passwords produced by any supported algorithm,
+// including the legacy unsalted md5 hash
+type DelegatingPasswordHasher struct {
+	current   PasswordHasher
+	algorithm string
+	hashers   map[string]PasswordHasher
+}
+
+// NewDelegatingPasswordHasher returns *DelegatingPasswordHasher
+func NewDelegatingPasswordHasher(algorithm string, current PasswordHasher) *DelegatingPasswordHasher {
+	hashers := map[string]PasswordHasher{
+		PasswordAlgorithmBcrypt:   NewBcryptPasswordHasher(bcrypt.DefaultCost),
+		PasswordAlgorithmArgon2id: NewArgon2idPasswordHasher(0, 0, 0),
+	}
+	hashers[algorithm] = current
+
+	return &DelegatingPasswordHasher{
+		current:   current,
+		algorithm: algorithm,
+		hashers:   hashers,
+	}
+}
+
+func (h *DelegatingPasswordHasher) Hash(plaintext Plaintext) (Password, error) {
+	return h.current.Hash(plaintext)
+}
+
+func (h *DelegatingPasswordHasher) Verify(password Password, plaintext Plaintext) (bool, error) {
+	algorithm := password.Algorithm()
+
+	if algorithm == PasswordAlgorithmMD5 {
+		sum := md5.Sum([]byte(plaintext))
+		return subtle.ConstantTimeCompare([]byte(password), []byte(hex.EncodeToString(sum[:]))) == 1, nil
+	}
+
+	hasher, ok := h.hashers[algorithm]
+	if !ok {
+		return false, errors.WithStack(ErrUnsupportedPasswordHash)
+	}
+
+	return hasher.Verify(password, plaintext)
+}
+
+func (h *DelegatingPasswordHasher) NeedsRehash(password Password) bool {
+	if password.Algorithm() != h.algorithm {
+		return true
+	}
+	return h.current.NeedsRehash(password)
+}
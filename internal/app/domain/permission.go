@@ -1,5 +1,13 @@
 package domain
 
+import "github.com/pkg/errors"
+
+// ErrPermissionCycle the parent of the permission is the permission itself, or one of its descendants
+var ErrPermissionCycle = errors.New("permission parent would create a cycle")
+
+// PermissionSortFields the fields that the permissions can be sorted by
+var PermissionSortFields = []string{SortFieldID, "key", "name", SortFieldCreatedAt, SortFieldUpdatedAt}
+
 type Permission struct {
 	ID       int64  `json:"id"`
 	Key      string `json:"key"`
@@ -7,3 +15,128 @@ type Permission struct {
 	Desc     string `json:"desc"`
 	ParentID int64  `json:"parentID"`
 }
+
+// IsRoot reports whether the permission is at the top level of the tree
+func (p Permission) IsRoot() bool {
+	return p.ParentID == 0
+}
+
+// PermissionNode the permission with its children
+type PermissionNode struct {
+	*Permission
+	Children []*PermissionNode `json:"children"`
+}
+
+// PermissionTree the permissions organized by the parent id
+type PermissionTree struct {
+	nodes map[int64]*PermissionNode
+	roots []*PermissionNode
+}
+
+// NewPermissionTree build the tree from the flat list, the order of the list is kept among the siblings,
+// the permission whose parent does not exist, or whose ancestors form a cycle, is placed at the top level
+func NewPermissionTree(list []*Permission) *PermissionTree {
+	t := &PermissionTree{
+		nodes: make(map[int64]*PermissionNode, len(list)),
+	}
+
+	for _, p := range list {
+		t.nodes[p.ID] = &PermissionNode{Permission: p, Children: make([]*PermissionNode, 0)}
+	}
+
+	for _, p := range list {
+		node := t.nodes[p.ID]
+		parent, ok := t.nodes[p.ParentID]
+		if p.IsRoot() || !ok || t.isDescendant(p.ParentID, p.ID) {
+			t.roots = append(t.roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	return t
+}
+
+// Roots returns the top level nodes
+func (t *PermissionTree) Roots() []*PermissionNode {
+	return t.roots
+}
+
+// Exist reports whether the permission is in the tree
+func (t *PermissionTree) Exist(id int64) bool {
+	_, ok := t.nodes[id]
+	return ok
+}
+
+// Descendants returns the ids of all the descendants of the permission, the permission itself is excluded
+func (t *PermissionTree) Descendants(id int64) []int64 {
+	node, ok := t.nodes[id]
+	if !ok {
+		return nil
+	}
+
+	ids := make([]int64, 0)
+	stack := append([]*PermissionNode(nil), node.Children...)
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		ids = append(ids, n.ID)
+		stack = append(stack, n.Children...)
+	}
+
+	return ids
+}
+
+// ValidateParent ensure the permission can be moved under the parent without creating a cycle,
+// the new permission is validated with the id 0
+func (t *PermissionTree) ValidateParent(id, parentID int64) error {
+	if parentID == 0 {
+		return nil
+	}
+	if id != 0 && (id == parentID || t.isDescendant(parentID, id)) {
+		return errors.WithStack(ErrPermissionCycle)
+	}
+	return nil
+}
+
+// isDescendant reports whether the permission is under the ancestor, following the parent ids
+func (t *PermissionTree) isDescendant(id, ancestor int64) bool {
+	seen := make(map[int64]struct{})
+	for n, ok := t.nodes[id]; ok && !n.IsRoot(); n, ok = t.nodes[n.ParentID] {
+		if n.ParentID == ancestor {
+			return true
+		}
+		if _, ok := seen[n.ID]; ok {
+			return false
+		}
+		seen[n.ID] = struct{}{}
+	}
+	return false
+}
+
+// PermissionDefinition the permission declared by the routes, it is synchronized into the permissions
+type PermissionDefinition struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`   // the key is used if it is empty on the creation, and the name is kept on the update
+	Desc   string `json:"desc"`   // the description is kept on the update if it is empty
+	Parent string `json:"parent"` // the key of the parent permission, empty at the top level, it is set on the creation only
+}
+
+// MisplacedPermission the existing permission placed under another parent than its definition
+type MisplacedPermission struct {
+	Permission *Permission `json:"permission"`
+	Parent     string      `json:"parent"` // the key of the parent of the definition, empty at the top level
+}
+
+// PermissionSyncResult the result of the synchronization of the permission definitions
+type PermissionSyncResult struct {
+	Created []*Permission `json:"created"`
+	// Updated the existing permissions whose names or descriptions are changed by the definitions
+	Updated []*Permission `json:"updated"`
+	// Misplaced the existing permissions placed under other parents than the definitions, they are reported
+	// rather than moved back, since the parent that the permission is moved under wins over the definition
+	Misplaced []*MisplacedPermission `json:"misplaced"`
+	// Stale the permissions that are neither declared nor the parent of any permission,
+	// they are reported rather than deleted, since they may be granted to the roles
+	Stale []*Permission `json:"stale"`
+}
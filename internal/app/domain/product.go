@@ -1,8 +1,14 @@
 package domain
 
+// ProductSortFields the fields that the products can be sorted by
+var ProductSortFields = []string{SortFieldID, "name", "price", SortFieldCreatedAt, SortFieldUpdatedAt}
+
 type Product struct {
-	ID    int64  `json:"id"`
-	Name  string `json:"name"`
-	Desc  string `json:"desc"`
-	Price int    `json:"price"`
+	ID           int64  `json:"id"`
+	TenantID     int64  `json:"tenantID"`
+	Name         string `json:"name"`
+	Desc         string `json:"desc"`
+	Price        int    `json:"price"`
+	OwnerID      int64  `json:"ownerID"`      // the user that creates the product
+	DepartmentID int64  `json:"departmentID"` // the department of the owner
 }
@@ -0,0 +1,62 @@
+package domain
+
+import "github.com/pkg/errors"
+
+var (
+	// ErrRBACRoleNotFound the parent role is neither in the snapshot nor in the tenant
+	ErrRBACRoleNotFound = errors.New("role not found")
+	// ErrRBACPermissionNotFound the permission is neither in the snapshot nor in the catalogue
+	ErrRBACPermissionNotFound = errors.New("permission not found")
+)
+
+// RBACSnapshot the portable RBAC setup of a tenant, the roles and the permissions are referenced
+// by their names and keys rather than the ids, so that it can be moved among the deployments
+type RBACSnapshot struct {
+	Permissions []PermissionDefinition `json:"permissions"` // the permissions that the grants refer to
+	Roles       []RBACRole             `json:"roles"`
+}
+
+// RBACRole the role of the snapshot
+type RBACRole struct {
+	Name                 string      `json:"name"`
+	DataScope            DataScope   `json:"dataScope"`
+	DataScopeDepartments []int64     `json:"dataScopeDepartments"`
+	Parents              []string    `json:"parents"` // the names of the parent roles
+	Grants               []RBACGrant `json:"grants"`
+}
+
+// RBACGrant the permission granted to the role of the snapshot
+type RBACGrant struct {
+	Permission string `json:"permission"` // the permission key
+	Condition  string `json:"condition"`  // empty if unconditional
+}
+
+// RBACAction the change that the import makes on the roles
+type RBACAction string
+
+const (
+	RBACActionCreateRole   RBACAction = "create role"
+	RBACActionUpdateRole   RBACAction = "update data scope"
+	RBACActionGrant        RBACAction = "grant permission"
+	RBACActionRevoke       RBACAction = "revoke permission"
+	RBACActionCondition    RBACAction = "change condition"
+	RBACActionAddParent    RBACAction = "add parent"
+	RBACActionRemoveParent RBACAction = "remove parent"
+)
+
+// RBACChange the difference between the snapshot and the tenant
+type RBACChange struct {
+	Action RBACAction `json:"action"`
+	Role   string     `json:"role"`
+	Target string     `json:"target"` // the permission key or the name of the parent role
+	Detail string     `json:"detail"` // the data scope or the condition after the change
+}
+
+// RBACImportResult the changes of the import, the roles out of the snapshot are kept as they are
+type RBACImportResult struct {
+	CreatedPermissions   []*Permission          `json:"createdPermissions"`
+	UpdatedPermissions   []*Permission          `json:"updatedPermissions"`
+	MisplacedPermissions []*MisplacedPermission `json:"misplacedPermissions"` // kept under their parents
+	Changes              []RBACChange           `json:"changes"`
+	Kept                 []string               `json:"kept"` // the names of the roles that are not in the snapshot
+}
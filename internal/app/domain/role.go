@@ -1,6 +1,74 @@
 package domain
 
+import "github.com/pkg/errors"
+
+// ErrRoleInheritanceCycle the role would inherit itself
+var ErrRoleInheritanceCycle = errors.New("role inheritance would create a cycle")
+
+// RoleSortFields the fields that the roles can be sorted by
+var RoleSortFields = []string{SortFieldID, "name", SortFieldCreatedAt, SortFieldUpdatedAt}
+
 type Role struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID                   int64     `json:"id"`
+	TenantID             int64     `json:"tenantID"`
+	Name                 string    `json:"name"`
+	DataScope            DataScope `json:"dataScope"`
+	DataScopeDepartments []int64   `json:"dataScopeDepartments"` // the departments of DataScopeCustom
+}
+
+// RoleHierarchy the parent roles of each role, the role inherits the permissions of its ancestors
+type RoleHierarchy map[int64][]int64
+
+// Ancestors returns the ancestors of the role, the nearer ancestors come first
+func (h RoleHierarchy) Ancestors(role int64) []int64 {
+	seen := map[int64]struct{}{role: {}}
+	ancestors := make([]int64, 0)
+
+	queue := append([]int64(nil), h[role]...)
+	for len(queue) > 0 {
+		r := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[r]; ok {
+			continue
+		}
+		seen[r] = struct{}{}
+		ancestors = append(ancestors, r)
+		queue = append(queue, h[r]...)
+	}
+
+	return ancestors
+}
+
+// ValidateParents ensure the role does not inherit itself after its parents are replaced
+func (h RoleHierarchy) ValidateParents(role int64, parents []int64) error {
+	next := make(RoleHierarchy, len(h)+1)
+	for r, ps := range h {
+		next[r] = ps
+	}
+	next[role] = parents
+
+	for _, p := range parents {
+		if p == role {
+			return errors.WithStack(ErrRoleInheritanceCycle)
+		}
+		for _, a := range next.Ancestors(p) {
+			if a == role {
+				return errors.WithStack(ErrRoleInheritanceCycle)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RolePermission the permission of the role, granted directly or inherited from an ancestor
+type RolePermission struct {
+	*Permission
+	OriginRoleID int64  `json:"originRoleID"` // the role that the permission is granted to
+	Condition    string `json:"condition"`    // the condition that the grant is only in effect when met, empty if unconditional
+}
+
+// IsInherited reports whether the permission is inherited by the role
+func (p RolePermission) IsInherited(role int64) bool {
+	return p.OriginRoleID != role
 }
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// RoleGrant the role granted to the user within the tenant, it is only in effect within the validity window,
+// the window is unbounded if both StartsAt and ExpiresAt are 0
+type RoleGrant struct {
+	RoleID    int64 `json:"roleID"`
+	StartsAt  int64 `json:"startsAt"`  // unix timestamp, 0 if in effect immediately
+	ExpiresAt int64 `json:"expiresAt"` // unix timestamp, 0 if never expires
+	Role      *Role `json:"role"`      // only set by the queries
+}
+
+// IsBounded reports whether the grant is limited by a validity window,
+// the unbounded grants are only kept in the policies
+func (g RoleGrant) IsBounded() bool {
+	return g.StartsAt > 0 || g.ExpiresAt > 0
+}
+
+// IsStarted reports whether the grant has come into effect at the time
+func (g RoleGrant) IsStarted(t time.Time) bool {
+	return t.Unix() >= g.StartsAt
+}
+
+// IsExpired reports whether the grant has lapsed at the time
+func (g RoleGrant) IsExpired(t time.Time) bool {
+	return g.ExpiresAt > 0 && t.Unix() >= g.ExpiresAt
+}
+
+// IsEffective reports whether the grant is in effect at the time
+func (g RoleGrant) IsEffective(t time.Time) bool {
+	return g.IsStarted(t) && !g.IsExpired(t)
+}
+
+// RoleGrantSweepResult the number of the grants that come into effect and lapse in a sweep
+type RoleGrantSweepResult struct {
+	Activated int
+	Revoked   int
+}
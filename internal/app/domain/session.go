@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// SessionTouchInterval the minimum interval between two updates of the last seen time
+const SessionTouchInterval = time.Minute
+
+// SessionClient the client that the session is started from
+type SessionClient struct {
+	Device    string `json:"device"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"userAgent"`
+}
+
+// Session a login on a device
+//
+// the session ID is the refresh token family ID,
+// so revoking the session revokes all the tokens issued for it
+type Session struct {
+	ID string `json:"id"`
+	SessionClient
+	UserID     int64     `json:"userID"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+// NewSession returns *Session
+func NewSession(id string, userID int64, client SessionClient, expiresAt time.Time) *Session {
+	now := time.Now()
+	return &Session{
+		ID:            id,
+		SessionClient: client,
+		UserID:        userID,
+		CreatedAt:     now,
+		LastSeenAt:    now,
+		ExpiresAt:     expiresAt,
+	}
+}
+
+// NeedsTouch reports whether the last seen time is stale
+func (s Session) NeedsTouch() bool {
+	return time.Since(s.LastSeenAt) >= SessionTouchInterval
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "context"
+
+// DefaultTenantID the tenant that the users and roles belong to before the tenants are introduced,
+// and the tenant that is used when the context carries no tenant, e.g. the commands
+const DefaultTenantID int64 = 1
+
+// Tenant the customer hosted on the deployment, the users and roles are isolated by the tenant
+type Tenant struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type tenantContextKey struct{}
+
+// NewTenantContext returns a new context that carries the tenant the request acts in
+func NewTenantContext(ctx context.Context, tenant int64) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant the request acts in, DefaultTenantID if the context carries no tenant
+func TenantFromContext(ctx context.Context) int64 {
+	if tenant, ok := ctx.Value(tenantContextKey{}).(int64); ok && tenant != 0 {
+		return tenant
+	}
+	return DefaultTenantID
+}
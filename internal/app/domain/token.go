@@ -1,8 +1,54 @@
 package domain
 
-import "time"
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+)
 
 const (
-	AccountTokenExpireDuration  = time.Hour * 24 * 30
-	AccountTokenRefreshDuration = time.Hour * 24 * 7
+	AccountAccessTokenExpireDuration  = time.Minute * 30
+	AccountRefreshTokenExpireDuration = time.Hour * 24 * 30
 )
+
+// AccountToken the access and refresh token pair issued to an account
+type AccountToken struct {
+	AccessToken           string    `json:"accessToken"`
+	AccessTokenExpiresAt  time.Time `json:"accessTokenExpiresAt"`
+	RefreshToken          string    `json:"refreshToken"`
+	RefreshTokenExpiresAt time.Time `json:"refreshTokenExpiresAt"`
+}
+
+// RefreshToken the refresh token persisted on the server side
+//
+// every refresh token belongs to a family, which is created at login
+// and shared by all the tokens obtained by rotation
+type RefreshToken struct {
+	Token     string    `json:"-"`
+	UserID    int64     `json:"userID"`
+	FamilyID  string    `json:"familyID"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	RotatedAt time.Time `json:"rotatedAt"`
+}
+
+// NewRefreshToken generate a random refresh token
+func NewRefreshToken(userID int64, familyID string, expire time.Duration) (*RefreshToken, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &RefreshToken{
+		Token:     base64.RawURLEncoding.EncodeToString(b),
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(expire),
+	}, nil
+}
+
+// IsRotated reports whether the refresh token has been exchanged
+func (t RefreshToken) IsRotated() bool {
+	return !t.RotatedAt.IsZero()
+}
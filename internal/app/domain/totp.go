@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TOTP parameters, see RFC 6238
+//
+// they are the defaults of the common authenticator apps,
+// which ignore the parameters in the otpauth URI
+const (
+	TOTPPeriod     = 30 * time.Second
+	TOTPDigits     = 6
+	TOTPSkew       = 1 // the number of periods before and after the current one that are accepted
+	TOTPSecretSize = 20
+
+	RecoveryCodeCount = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generate a random base32 encoded TOTP secret
+func NewTOTPSecret() (string, error) {
+	b := make([]byte, TOTPSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI returns the otpauth URI that is scanned by the authenticator apps
+func TOTPURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(TOTPDigits))
+	v.Set("period", fmt.Sprint(int(TOTPPeriod.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// GenerateTOTP returns the TOTP code of the secret at the time
+func GenerateTOTP(secret string, t time.Time) (string, error) {
+	return hotp(secret, uint64(t.Unix()/int64(TOTPPeriod.Seconds())))
+}
+
+// ValidateTOTP reports whether the code is valid for the secret at the time
+func ValidateTOTP(secret, code string, t time.Time) bool {
+	_, ok := MatchTOTP(secret, code, t)
+	return ok
+}
+
+// MatchTOTP returns the time step that the code is generated for if it is valid for the secret at the time,
+// the step is recorded so that the code can not be replayed
+func MatchTOTP(secret, code string, t time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	counter := t.Unix() / int64(TOTPPeriod.Seconds())
+	for i := -TOTPSkew; i <= TOTPSkew; i++ {
+		step := counter + int64(i)
+		expected, err := hotp(secret, uint64(step))
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// hotp see RFC 4226
+func hotp(secret string, counter uint64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TOTPDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%mod), nil
+}
+
+// TOTPEnrollment the secret to be added to the authenticator app
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// NewRecoveryCodes generate the recovery codes, and their digests to be stored
+func NewRecoveryCodes(n int) (codes, digests []string, err error) {
+	codes = make([]string, 0, n)
+	digests = make([]string, 0, n)
+
+	for i := 0; i < n; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+
+		s := strings.ToLower(totpEncoding.EncodeToString(b))
+		code := s[:8] + "-" + s[8:]
+
+		codes = append(codes, code)
+		digests = append(digests, RecoveryCodeDigest(code))
+	}
+
+	return codes, digests, nil
+}
+
+// RecoveryCodeDigest the recovery code has enough entropy, a plain sha256 digest is sufficient
+//
+// the dashes and spaces are ignored, and the code is case-insensitive
+func RecoveryCodeDigest(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -1,50 +1,109 @@
 package domain
 
 import (
-	"crypto/md5"
-	"encoding/hex"
+	"crypto/subtle"
 
-	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
+// UserSortFields the fields that the users can be sorted by
+var UserSortFields = []string{SortFieldID, "username", SortFieldCreatedAt, SortFieldUpdatedAt}
+
 type User struct {
-	ID       int64    `json:"id"`
-	Username string   `json:"username"`
-	Password Password `json:"password"`
-	Nickname string   `json:"nickname"`
-	Phone    string   `json:"phone"`
-	Salt     string   `json:"salt"`
+	ID                int64    `json:"id"`
+	TenantID          int64    `json:"tenantID"`     // the tenant that the user belongs to
+	DepartmentID      int64    `json:"departmentID"` // the department that the user belongs to, 0 if none
+	Username          string   `json:"username"`
+	Password          Password `json:"password"`
+	Nickname          string   `json:"nickname"`
+	Phone             string   `json:"phone"`
+	Email             string   `json:"email"`
+	EmailVerifiedAt   int64    `json:"emailVerifiedAt"`   // unix timestamp
+	ServiceAccount    bool     `json:"serviceAccount"`    // authenticated by the API keys, can not log in
+	Salt              string   `json:"salt"`              // unused, the tokens are revoked with the sessions of the user
+	TOTPSecret        string   `json:"totpSecret"`        // pending until TOTPEnabledAt is set
+	TOTPEnabledAt     int64    `json:"totpEnabledAt"`     // unix timestamp
+	TOTPRecoveryCodes []string `json:"totpRecoveryCodes"` // digests of the unused recovery codes
+	TOTPLastStep      int64    `json:"totpLastStep"`      // the time step of the last accepted code, the codes of it and before are rejected
 }
 
-func (u *User) RefreshSalt() {
-	u.Salt = uuid.New().String()
+// HasPassword the users provisioned by the identity provider have no password
+func (u *User) HasPassword() bool {
+	return u.Password != ""
 }
 
-func (u *User) ToProfile() *UserProfile {
-	return &UserProfile{
-		ID:       u.ID,
-		Username: u.Username,
-		Nickname: u.Nickname,
-		Phone:    u.Phone,
+// EmailVerified reports whether the email is verified
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt > 0 && u.Email != ""
+}
+
+// ChangeEmail the verification is cleared if the email is changed
+func (u *User) ChangeEmail(email string) {
+	if u.Email != email {
+		u.Email = email
+		u.EmailVerifiedAt = 0
 	}
 }
 
-type Plaintext string
+// TOTPEnabled reports whether the second factor is enabled
+func (u *User) TOTPEnabled() bool {
+	return u.TOTPEnabledAt > 0 && u.TOTPSecret != ""
+}
 
-func (p Plaintext) Encrypt() Password {
-	sum := md5.Sum([]byte(p))
-	return Password(hex.EncodeToString(sum[:]))
+// DisableTOTP clear the TOTP secret and recovery codes
+func (u *User) DisableTOTP() {
+	u.TOTPSecret = ""
+	u.TOTPEnabledAt = 0
+	u.TOTPRecoveryCodes = nil
+	u.TOTPLastStep = 0
 }
 
-type Password string
+// UseRecoveryCode consume the recovery code, reports whether it is valid
+func (u *User) UseRecoveryCode(code string) bool {
+	digest := RecoveryCodeDigest(code)
+	for i, d := range u.TOTPRecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(d), []byte(digest)) == 1 {
+			u.TOTPRecoveryCodes = append(u.TOTPRecoveryCodes[:i:i], u.TOTPRecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
 
-func (p Password) Verify(s Plaintext) bool {
-	return p == s.Encrypt()
+func (u *User) ToProfile() *UserProfile {
+	return &UserProfile{
+		ID:             u.ID,
+		TenantID:       u.TenantID,
+		Username:       u.Username,
+		Nickname:       u.Nickname,
+		Phone:          u.Phone,
+		Email:          u.Email,
+		EmailVerified:  u.EmailVerified(),
+		ServiceAccount: u.ServiceAccount,
+	}
 }
 
 type UserProfile struct {
-	ID       int64  `json:"id"`
-	Username string `json:"username"`
-	Nickname string `json:"nickname"`
-	Phone    string `json:"phone"`
+	ID             int64  `json:"id"`
+	TenantID       int64  `json:"tenantID"` // the tenant that the request acts in
+	Username       string `json:"username"`
+	Nickname       string `json:"nickname"`
+	Phone          string `json:"phone"`
+	Email          string `json:"email"`
+	EmailVerified  bool   `json:"emailVerified"`
+	ServiceAccount bool   `json:"serviceAccount"`
+	// Scopes the permission keys that the credential is restricted to, nil if unrestricted
+	Scopes []string `json:"-"`
+	// Actor the real user that impersonates the user, nil if the user is not impersonated
+	Actor *UserProfile `json:"-"`
+}
+
+// IsImpersonated reports whether the request is made by another user impersonating the user
+func (p UserProfile) IsImpersonated() bool {
+	return p.Actor != nil
+}
+
+// InScope reports whether the permission is within the scopes of the credential
+func (p UserProfile) InScope(permissionKey string) bool {
+	return len(p.Scopes) == 0 || lo.Contains(p.Scopes, permissionKey)
 }
@@ -15,6 +15,7 @@ import (
 var ProviderSet = wire.NewSet(
 	// cron job
 	job.NewExampleJob,
+	job.NewRoleGrantSweepJob,
 	// scheduler
 	scheduler.New,
 	// cron server
@@ -0,0 +1,35 @@
+package job
+
+import (
+	"context"
+	"log/slog"
+
+	"go-scaffold/internal/app/usecase"
+)
+
+// RoleGrantSweepJob bring the scheduled role grants into effect, and revoke the lapsed ones
+type RoleGrantSweepJob struct {
+	logger *slog.Logger
+	userUC usecase.UserUseCaseInterface
+}
+
+// NewRoleGrantSweepJob build role grant sweep job
+func NewRoleGrantSweepJob(logger *slog.Logger, userUC usecase.UserUseCaseInterface) *RoleGrantSweepJob {
+	return &RoleGrantSweepJob{
+		logger: logger,
+		userUC: userUC,
+	}
+}
+
+// Run execute job
+func (s RoleGrantSweepJob) Run() {
+	result, err := s.userUC.SweepRoleGrants(context.Background())
+	if err != nil {
+		s.logger.Error("sweep role grants error", slog.Any("error", err))
+		return
+	}
+
+	if result.Activated > 0 || result.Revoked > 0 {
+		s.logger.Info("role grants swept", slog.Int("activated", result.Activated), slog.Int("revoked", result.Revoked))
+	}
+}
@@ -9,18 +9,21 @@ import (
 
 // Scheduler job scheduler
 type Scheduler struct {
-	appConf    config.App
-	exampleJob *job.ExampleJob
+	appConf           config.App
+	exampleJob        *job.ExampleJob
+	roleGrantSweepJob *job.RoleGrantSweepJob
 }
 
 // New build job scheduler
 func New(
 	appConf config.App,
 	exampleJob *job.ExampleJob,
+	roleGrantSweepJob *job.RoleGrantSweepJob,
 ) *Scheduler {
 	return &Scheduler{
-		appConf:    appConf,
-		exampleJob: exampleJob,
+		appConf:           appConf,
+		exampleJob:        exampleJob,
+		roleGrantSweepJob: roleGrantSweepJob,
 	}
 }
 
@@ -36,6 +39,9 @@ func (s *Scheduler) Register(server *cron.Cron) error {
 	if _, err := server.AddJob("@every 1h30m10s", s.exampleJob); err != nil { // 每 1 小时 30 分 10 秒运行一次
 		return err
 	}
+	if _, err := server.AddJob("@every 1m", s.roleGrantSweepJob); err != nil { // 每分钟运行一次
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,33 @@
+package scripts
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-scaffold/internal/app/controller"
+)
+
+type AdminCmd struct {
+	controller *controller.UserController
+}
+
+func NewAdminCmd(
+	controller *controller.UserController,
+) *AdminCmd {
+	return &AdminCmd{
+		controller: controller,
+	}
+}
+
+// GrantSuperuser grant the super admin role to the user
+func (c *AdminCmd) GrantSuperuser(cmd *cobra.Command, username string) error {
+	user, role, err := c.controller.GrantSuperuser(cmd.Context(), username)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("the super admin role %d has been granted to the user %s (id: %d)\n", role, user.Username, user.ID)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package scripts
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/labstack/echo/v4"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
+	gserv "go-scaffold/internal/app/facade/server/grpc"
+	"go-scaffold/internal/app/facade/server/http/router"
+)
+
+type PermissionsCmd struct {
+	controller  *controller.PermissionController
+	handler     http.Handler
+	permissions *router.Permissions
+	grpcServer  *grpc.Server
+}
+
+func NewPermissionsCmd(
+	controller *controller.PermissionController,
+	handler http.Handler,
+	permissions *router.Permissions,
+	grpcServer *grpc.Server,
+) *PermissionsCmd {
+	return &PermissionsCmd{
+		controller:  controller,
+		handler:     handler,
+		permissions: permissions,
+		grpcServer:  grpcServer,
+	}
+}
+
+// Sync create the permissions of the registered HTTP routes and gRPC operations that do not exist,
+// update the changed ones, and report the permissions moved under other parents and the stale permissions
+func (c *PermissionsCmd) Sync(cmd *cobra.Command, dryRun bool) error {
+	e, ok := c.handler.(*echo.Echo)
+	if !ok {
+		return errors.New("the HTTP handler is not an echo instance")
+	}
+
+	definitions := c.permissions.Definitions(e.Routes())
+	definitions = append(definitions, gserv.PermissionDefinitions(c.grpcServer)...)
+
+	result, err := c.controller.Sync(cmd.Context(), definitions, dryRun)
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Println("dry run, nothing is written")
+	}
+
+	fmt.Printf("%d permissions are created\n", len(result.Created))
+	printPermissions(result.Created)
+
+	fmt.Printf("%d permissions are updated\n", len(result.Updated))
+	printPermissions(result.Updated)
+
+	fmt.Printf("%d permissions are placed under other parents than declared, they are not moved\n", len(result.Misplaced))
+	printMisplacedPermissions(result.Misplaced)
+
+	fmt.Printf("%d permissions are stale, they are not deleted\n", len(result.Stale))
+	printPermissions(result.Stale)
+
+	return nil
+}
+
+func printMisplacedPermissions(list []*domain.MisplacedPermission) {
+	if len(list) == 0 {
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "KEY", "PARENT ID", "DECLARED PARENT"})
+	table.SetRowLine(true)
+	for _, m := range list {
+		table.Append([]string{fmt.Sprintf("%d", m.Permission.ID), m.Permission.Key, fmt.Sprintf("%d", m.Permission.ParentID), m.Parent})
+	}
+	table.Render()
+}
+
+func printPermissions(list []*domain.Permission) {
+	if len(list) == 0 {
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "KEY", "NAME", "PARENT ID"})
+	table.SetRowLine(true)
+	for _, p := range list {
+		table.Append([]string{fmt.Sprintf("%d", p.ID), p.Key, p.Name, fmt.Sprintf("%d", p.ParentID)})
+	}
+	table.Render()
+}
@@ -0,0 +1,195 @@
+package scripts
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
+)
+
+// rbacFile the portable YAML of the roles, the permissions and the roles are referenced by the keys and the names
+type rbacFile struct {
+	Permissions []rbacPermission `yaml:"permissions"`
+	Roles       []rbacRole       `yaml:"roles"`
+}
+
+type rbacPermission struct {
+	Key    string `yaml:"key"`
+	Name   string `yaml:"name"`
+	Desc   string `yaml:"desc,omitempty"`
+	Parent string `yaml:"parent,omitempty"` // the key of the parent permission
+}
+
+type rbacRole struct {
+	Name                 string      `yaml:"name"`
+	DataScope            string      `yaml:"dataScope"`
+	DataScopeDepartments []int64     `yaml:"dataScopeDepartments,omitempty"`
+	Parents              []string    `yaml:"parents,omitempty"` // the names of the parent roles
+	Grants               []rbacGrant `yaml:"grants,omitempty"`
+}
+
+type rbacGrant struct {
+	Permission string `yaml:"permission"` // the permission key
+	Condition  string `yaml:"condition,omitempty"`
+}
+
+func newRBACFile(snapshot *domain.RBACSnapshot) rbacFile {
+	f := rbacFile{
+		Permissions: make([]rbacPermission, 0, len(snapshot.Permissions)),
+		Roles:       make([]rbacRole, 0, len(snapshot.Roles)),
+	}
+
+	for _, p := range snapshot.Permissions {
+		f.Permissions = append(f.Permissions, rbacPermission{
+			Key:    p.Key,
+			Name:   p.Name,
+			Desc:   p.Desc,
+			Parent: p.Parent,
+		})
+	}
+
+	for _, r := range snapshot.Roles {
+		role := rbacRole{
+			Name:                 r.Name,
+			DataScope:            string(r.DataScope),
+			DataScopeDepartments: r.DataScopeDepartments,
+			Parents:              r.Parents,
+			Grants:               make([]rbacGrant, 0, len(r.Grants)),
+		}
+		for _, g := range r.Grants {
+			role.Grants = append(role.Grants, rbacGrant{Permission: g.Permission, Condition: g.Condition})
+		}
+		f.Roles = append(f.Roles, role)
+	}
+
+	return f
+}
+
+func (f rbacFile) toEntity() domain.RBACSnapshot {
+	snapshot := domain.RBACSnapshot{
+		Permissions: make([]domain.PermissionDefinition, 0, len(f.Permissions)),
+		Roles:       make([]domain.RBACRole, 0, len(f.Roles)),
+	}
+
+	for _, p := range f.Permissions {
+		snapshot.Permissions = append(snapshot.Permissions, domain.PermissionDefinition{
+			Key:    p.Key,
+			Name:   p.Name,
+			Desc:   p.Desc,
+			Parent: p.Parent,
+		})
+	}
+
+	for _, r := range f.Roles {
+		role := domain.RBACRole{
+			Name:                 r.Name,
+			DataScope:            domain.DataScope(r.DataScope),
+			DataScopeDepartments: r.DataScopeDepartments,
+			Parents:              r.Parents,
+			Grants:               make([]domain.RBACGrant, 0, len(r.Grants)),
+		}
+		for _, g := range r.Grants {
+			role.Grants = append(role.Grants, domain.RBACGrant{Permission: g.Permission, Condition: g.Condition})
+		}
+		snapshot.Roles = append(snapshot.Roles, role)
+	}
+
+	return snapshot
+}
+
+type RBACCmd struct {
+	controller *controller.RBACController
+}
+
+func NewRBACCmd(
+	controller *controller.RBACController,
+) *RBACCmd {
+	return &RBACCmd{
+		controller: controller,
+	}
+}
+
+// Export write the roles of the tenant as YAML to the file, or to the standard output if the file is empty
+func (c *RBACCmd) Export(cmd *cobra.Command, tenant int64, file string) error {
+	snapshot, err := c.controller.Export(cmd.Context(), tenant)
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(newRBACFile(snapshot)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(encoder.Close())
+}
+
+// Import apply the roles of the YAML file to the tenant, and report the changes
+func (c *RBACCmd) Import(cmd *cobra.Command, tenant int64, file string, dryRun bool) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	f := rbacFile{}
+	if err := yaml.Unmarshal(content, &f); err != nil {
+		return errors.Wrapf(err, "parse %s", file)
+	}
+
+	result, err := c.controller.Import(cmd.Context(), controller.RBACImportRequest{
+		Tenant:   tenant,
+		Snapshot: f.toEntity(),
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Println("dry run, nothing is written")
+	}
+
+	fmt.Printf("%d permissions are created\n", len(result.CreatedPermissions))
+	printPermissions(result.CreatedPermissions)
+
+	fmt.Printf("%d permissions are updated\n", len(result.UpdatedPermissions))
+	printPermissions(result.UpdatedPermissions)
+
+	fmt.Printf("%d permissions are placed under other parents than declared, they are not moved\n", len(result.MisplacedPermissions))
+	printMisplacedPermissions(result.MisplacedPermissions)
+
+	fmt.Printf("%d changes of the roles\n", len(result.Changes))
+	if len(result.Changes) > 0 {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ROLE", "ACTION", "TARGET", "DETAIL"})
+		table.SetRowLine(true)
+		for _, change := range result.Changes {
+			table.Append([]string{change.Role, string(change.Action), change.Target, change.Detail})
+		}
+		table.Render()
+	}
+
+	if len(result.Kept) > 0 {
+		fmt.Printf("%d roles are not in the file, they are kept: %v\n", len(result.Kept), result.Kept)
+	}
+
+	return nil
+}
@@ -5,4 +5,7 @@ import "github.com/google/wire"
 var ProviderSet = wire.NewSet(
 	// scripts
 	NewExampleCmd,
+	NewAdminCmd,
+	NewPermissionsCmd,
+	NewRBACCmd,
 )
@@ -14,3 +14,6 @@ package api
 
 //go:generate kratos proto client --proto_path=../api --proto_path=../../../../../proto v1/product.proto
 //go:generate protoc-go-inject-tag -input=./v1/product.pb.go
+
+//go:generate kratos proto client --proto_path=../api --proto_path=../../../../../proto v1/account.proto
+//go:generate protoc-go-inject-tag -input=./v1/account.pb.go
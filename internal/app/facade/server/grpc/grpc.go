@@ -1,18 +1,22 @@
 package grpc
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/metadata"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/google/wire"
 
+	"go-scaffold/internal/app/controller"
 	v1api "go-scaffold/internal/app/facade/server/grpc/api/v1"
 	v1handler "go-scaffold/internal/app/facade/server/grpc/handler/v1"
+	imiddleware "go-scaffold/internal/app/facade/server/grpc/middleware"
 	"go-scaffold/internal/app/facade/server/grpc/router"
 	"go-scaffold/internal/config"
 )
@@ -24,16 +28,45 @@ var ProviderSet = wire.NewSet(
 	wire.NewSet(wire.Bind(new(v1api.RoleServer), new(*v1handler.RoleHandler)), v1handler.NewRoleHandler),
 	wire.NewSet(wire.Bind(new(v1api.PermissionServer), new(*v1handler.PermissionHandler)), v1handler.NewPermissionHandler),
 	wire.NewSet(wire.Bind(new(v1api.ProductServer), new(*v1handler.ProductHandler)), v1handler.NewProductHandler),
+	wire.NewSet(wire.Bind(new(v1api.AccountServer), new(*v1handler.AccountHandler)), v1handler.NewAccountHandler),
 	// register
 	router.New,
 	// gRPC server
 	New,
 )
 
+// publicOperations the operations that are callable without credentials
+var publicOperations = map[string]struct{}{
+	v1api.Greet_Hello_FullMethodName: {},
+}
+
+func publicSkipper(_ context.Context, operation string) bool {
+	_, ok := publicOperations[operation]
+	return ok
+}
+
+// selfServiceOperations the operations on the account of the authenticated user,
+// they require the credentials but no permission
+var selfServiceOperations = map[string]struct{}{
+	v1api.Account_ListSessions_FullMethodName:  {},
+	v1api.Account_RevokeSession_FullMethodName: {},
+}
+
+func selfServiceSkipper(ctx context.Context, operation string) bool {
+	_, ok := selfServiceOperations[operation]
+	return ok || publicSkipper(ctx, operation)
+}
+
 // New build gRPC server
 func New(
 	gsConf config.GRPCServer,
 	router *router.Router,
+	accountTokenController *controller.AccountTokenController,
+	apiKeyController *controller.APIKeyController,
+	accountPermissionController *controller.AccountPermissionController,
+	tenantController *controller.TenantController,
+	dataScopeController *controller.DataScopeController,
+	impersonationController *controller.ImpersonationController,
 ) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
@@ -41,6 +74,27 @@ func New(
 			logging.Server(log.GetLogger()),
 			tracing.Server(),
 			metadata.Server(),
+			imiddleware.Auth(*imiddleware.NewDefaultAuthConfig().
+				WithSkipper(publicSkipper).
+				WithTokenValidator(accountTokenController).
+				WithAPIKeyValidator(apiKeyController).
+				WithTenantResolver(tenantController),
+			),
+			imiddleware.Audit(*imiddleware.NewDefaultAuditConfig().
+				WithSkipper(publicSkipper).
+				WithRecorder(impersonationController),
+			),
+			selector.Server(imiddleware.DenyImpersonation()).
+				Path(v1api.Account_RevokeSession_FullMethodName).
+				Build(),
+			imiddleware.Permission(*imiddleware.NewDefaultPermissionConfig().
+				WithSkipper(selfServiceSkipper).
+				WithValidator(accountPermissionController),
+			),
+			imiddleware.DataScope(*imiddleware.NewDefaultDataScopeConfig().
+				WithSkipper(selfServiceSkipper).
+				WithResolver(dataScopeController),
+			),
 		),
 	}
 
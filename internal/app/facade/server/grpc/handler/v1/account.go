@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+
+	"go-scaffold/internal/app/controller"
+	v1 "go-scaffold/internal/app/facade/server/grpc/api/v1"
+	"go-scaffold/internal/app/facade/server/grpc/middleware"
+	"go-scaffold/internal/app/facade/server/grpc/pkg/errors"
+	berr "go-scaffold/internal/errors"
+)
+
+type AccountHandler struct {
+	v1.UnimplementedAccountServer
+	logger            *slog.Logger
+	accountController *controller.AccountController
+}
+
+func NewAccountHandler(
+	logger *slog.Logger,
+	accountController *controller.AccountController,
+) *AccountHandler {
+	return &AccountHandler{
+		logger:            logger,
+		accountController: accountController,
+	}
+}
+
+// ListSessions the sessions of the authenticated user
+func (h *AccountHandler) ListSessions(ctx context.Context, req *v1.AccountListSessionsRequest) (*v1.AccountListSessionsResponse, error) {
+	user, ok := middleware.GetUser(ctx)
+	if !ok {
+		return nil, errors.Wrap(berr.ErrInvalidAuthorized)
+	}
+
+	r := controller.AccountSessionListRequest{
+		UserID: user.ID,
+		Token:  middleware.GetToken(ctx),
+	}
+
+	ret, err := h.accountController.ListSessions(ctx, r)
+	if err != nil {
+		h.logger.Error("call AccountController.ListSessions method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	items := make([]*v1.AccountSessionInfo, 0, len(ret.Items))
+
+	for _, item := range ret.Items {
+		items = append(items, &v1.AccountSessionInfo{
+			Id:         item.ID,
+			Device:     item.Device,
+			Ip:         item.IP,
+			UserAgent:  item.UserAgent,
+			CreatedAt:  item.CreatedAt.Unix(),
+			LastSeenAt: item.LastSeenAt.Unix(),
+			ExpiresAt:  item.ExpiresAt.Unix(),
+			Current:    item.ID == ret.Current,
+		})
+	}
+
+	return &v1.AccountListSessionsResponse{Items: items}, nil
+}
+
+// RevokeSession revoke the session of the authenticated user
+func (h *AccountHandler) RevokeSession(ctx context.Context, req *v1.AccountRevokeSessionRequest) (*v1.AccountRevokeSessionResponse, error) {
+	user, ok := middleware.GetUser(ctx)
+	if !ok {
+		return nil, errors.Wrap(berr.ErrInvalidAuthorized)
+	}
+
+	r := controller.AccountSessionRevokeRequest{
+		UserID: user.ID,
+		ID:     req.Id,
+	}
+
+	if err := h.accountController.RevokeSession(ctx, r); err != nil {
+		h.logger.Error("call AccountController.RevokeSession method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	return &v1.AccountRevokeSessionResponse{}, nil
+}
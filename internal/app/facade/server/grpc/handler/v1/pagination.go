@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
+	v1 "go-scaffold/internal/app/facade/server/grpc/api/v1"
+)
+
+func toPageRequest(req *v1.PageRequest) controller.PageRequest {
+	return controller.PageRequest{
+		Offset: int(req.GetOffset()),
+		Limit:  int(req.GetLimit()),
+		Cursor: req.GetCursor(),
+		Sort:   req.GetSort(),
+		Order:  req.GetOrder(),
+	}
+}
+
+func toPageInfo[T any](page *domain.Page[T]) *v1.PageInfo {
+	return &v1.PageInfo{
+		Total:      int64(page.Total),
+		NextCursor: page.NextCursor,
+	}
+}
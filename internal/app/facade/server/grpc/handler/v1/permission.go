@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
 	v1 "go-scaffold/internal/app/facade/server/grpc/api/v1"
 	"go-scaffold/internal/app/facade/server/grpc/pkg/errors"
 )
@@ -28,18 +29,19 @@ func NewPermissionHandler(
 // List 权限列表
 func (h *PermissionHandler) List(ctx context.Context, req *v1.PermissionListRequest) (*v1.PermissionListResponse, error) {
 	r := controller.PermissionListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: toPageRequest(req.Page),
 	}
 
-	list, err := h.permissionController.List(ctx, r)
+	page, err := h.permissionController.List(ctx, r)
 	if err != nil {
 		h.logger.Error("call PermissionController.List method error", slog.Any("error", err))
 		return nil, errors.Wrap(err)
 	}
 
-	items := make([]*v1.PermissionInfo, 0, len(list))
+	items := make([]*v1.PermissionInfo, 0, len(page.Items))
 
-	for _, item := range list {
+	for _, item := range page.Items {
 		items = append(items, &v1.PermissionInfo{
 			Id:       item.ID,
 			Key:      item.Key,
@@ -49,7 +51,10 @@ func (h *PermissionHandler) List(ctx context.Context, req *v1.PermissionListRequ
 		})
 	}
 
-	return &v1.PermissionListResponse{Items: items}, nil
+	return &v1.PermissionListResponse{
+		Items: items,
+		Page:  toPageInfo(page),
+	}, nil
 }
 
 // Create 权限创建
@@ -117,3 +122,44 @@ func (h *PermissionHandler) Delete(ctx context.Context, req *v1.PermissionDelete
 
 	return &v1.PermissionDeleteResponse{}, nil
 }
+
+// Tree 权限树
+func (h *PermissionHandler) Tree(ctx context.Context, req *v1.PermissionTreeRequest) (*v1.PermissionTreeResponse, error) {
+	ret, err := h.permissionController.Tree(ctx)
+	if err != nil {
+		h.logger.Error("call PermissionController.Tree method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	return &v1.PermissionTreeResponse{Items: newPermissionTree(ret)}, nil
+}
+
+func newPermissionTree(nodes []*domain.PermissionNode) []*v1.PermissionTreeNode {
+	tree := make([]*v1.PermissionTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		tree = append(tree, &v1.PermissionTreeNode{
+			Id:       node.ID,
+			Key:      node.Key,
+			Name:     node.Name,
+			Desc:     node.Desc,
+			ParentID: node.ParentID,
+			Children: newPermissionTree(node.Children),
+		})
+	}
+	return tree
+}
+
+// Move 权限移动
+func (h *PermissionHandler) Move(ctx context.Context, req *v1.PermissionMoveRequest) (*v1.PermissionMoveResponse, error) {
+	r := controller.PermissionMoveRequest{
+		ID:       req.Id,
+		ParentID: req.ParentID,
+	}
+
+	if err := h.permissionController.Move(ctx, r); err != nil {
+		h.logger.Error("call PermissionController.Move method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	return &v1.PermissionMoveResponse{}, nil
+}
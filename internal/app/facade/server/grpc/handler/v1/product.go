@@ -28,18 +28,19 @@ func NewProductHandler(
 // List 产品列表
 func (h *ProductHandler) List(ctx context.Context, req *v1.ProductListRequest) (*v1.ProductListResponse, error) {
 	r := controller.ProductListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: toPageRequest(req.Page),
 	}
 
-	list, err := h.productController.List(ctx, r)
+	page, err := h.productController.List(ctx, r)
 	if err != nil {
 		h.logger.Error("call ProductController.List method error", slog.Any("error", err))
 		return nil, errors.Wrap(err)
 	}
 
-	items := make([]*v1.ProductInfo, 0, len(list))
+	items := make([]*v1.ProductInfo, 0, len(page.Items))
 
-	for _, item := range list {
+	for _, item := range page.Items {
 		items = append(items, &v1.ProductInfo{
 			Id:    item.ID,
 			Name:  item.Name,
@@ -48,7 +49,10 @@ func (h *ProductHandler) List(ctx context.Context, req *v1.ProductListRequest) (
 		})
 	}
 
-	return &v1.ProductListResponse{Items: items}, nil
+	return &v1.ProductListResponse{
+		Items: items,
+		Page:  toPageInfo(page),
+	}, nil
 }
 
 // Create 产品创建
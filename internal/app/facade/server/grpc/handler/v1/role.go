@@ -27,31 +27,39 @@ func NewRoleHandler(
 
 func (h *RoleHandler) List(ctx context.Context, req *v1.RoleListRequest) (*v1.RoleListResponse, error) {
 	r := controller.RoleListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: toPageRequest(req.Page),
 	}
 
-	list, err := h.roleController.List(ctx, r)
+	page, err := h.roleController.List(ctx, r)
 	if err != nil {
 		h.logger.Error("call RoleController.List method error", slog.Any("error", err))
 		return nil, errors.Wrap(err)
 	}
 
-	items := make([]*v1.RoleInfo, 0, len(list))
+	items := make([]*v1.RoleInfo, 0, len(page.Items))
 
-	for _, item := range list {
+	for _, item := range page.Items {
 		items = append(items, &v1.RoleInfo{
-			Id:   item.ID,
-			Name: item.Name,
+			Id:                   item.ID,
+			Name:                 item.Name,
+			DataScope:            string(item.DataScope),
+			DataScopeDepartments: item.DataScopeDepartments,
 		})
 	}
 
-	return &v1.RoleListResponse{Items: items}, nil
+	return &v1.RoleListResponse{
+		Items: items,
+		Page:  toPageInfo(page),
+	}, nil
 }
 
 func (h *RoleHandler) Create(ctx context.Context, req *v1.RoleCreateRequest) (*v1.RoleCreateResponse, error) {
 	r := controller.RoleCreateRequest{
 		RoleAttr: controller.RoleAttr{
-			Name: req.Name,
+			Name:                 req.Name,
+			DataScope:            req.DataScope,
+			DataScopeDepartments: req.DataScopeDepartments,
 		},
 	}
 
@@ -67,7 +75,9 @@ func (h *RoleHandler) Update(ctx context.Context, req *v1.RoleUpdateRequest) (*v
 	r := controller.RoleUpdateRequest{
 		ID: req.Id,
 		RoleAttr: controller.RoleAttr{
-			Name: req.Name,
+			Name:                 req.Name,
+			DataScope:            req.DataScope,
+			DataScopeDepartments: req.DataScopeDepartments,
 		},
 	}
 
@@ -87,8 +97,10 @@ func (h *RoleHandler) Detail(ctx context.Context, req *v1.RoleDetailRequest) (*v
 	}
 
 	return &v1.RoleInfo{
-		Id:   ret.ID,
-		Name: ret.Name,
+		Id:                   ret.ID,
+		Name:                 ret.Name,
+		DataScope:            string(ret.DataScope),
+		DataScopeDepartments: ret.DataScopeDepartments,
 	}, nil
 }
 
@@ -105,6 +117,8 @@ func (h *RoleHandler) GrantPermissions(ctx context.Context, req *v1.RoleGrantPer
 	r := controller.RoleGrantPermissionsRequest{
 		Role:        req.Role,
 		Permissions: req.Permissions,
+		Conditions:  req.Conditions,
+		Cascade:     req.Cascade,
 	}
 
 	if err := h.roleController.GrantPermissions(ctx, r); err != nil {
@@ -122,17 +136,55 @@ func (h *RoleHandler) GetPermissions(ctx context.Context, req *v1.RoleGetPermiss
 		return nil, errors.Wrap(err)
 	}
 
-	items := make([]*v1.PermissionInfo, 0, len(list))
+	items := make([]*v1.RolePermissionInfo, 0, len(list))
 
 	for _, item := range list {
-		items = append(items, &v1.PermissionInfo{
-			Id:       item.ID,
-			Key:      item.Key,
-			Name:     item.Name,
-			Desc:     item.Desc,
-			ParentID: item.ParentID,
+		items = append(items, &v1.RolePermissionInfo{
+			Id:           item.ID,
+			Key:          item.Key,
+			Name:         item.Name,
+			Desc:         item.Desc,
+			ParentID:     item.ParentID,
+			OriginRoleID: item.OriginRoleID,
+			Inherited:    item.IsInherited(req.Id),
+			Condition:    item.Condition,
 		})
 	}
 
 	return &v1.RoleGetPermissionsResponse{Items: items}, nil
 }
+
+func (h *RoleHandler) SetParents(ctx context.Context, req *v1.RoleSetParentsRequest) (*v1.RoleSetParentsResponse, error) {
+	r := controller.RoleSetParentsRequest{
+		Role:    req.Role,
+		Parents: req.Parents,
+	}
+
+	if err := h.roleController.SetParents(ctx, r); err != nil {
+		h.logger.Error("call RoleController.SetParents method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	return &v1.RoleSetParentsResponse{}, nil
+}
+
+func (h *RoleHandler) GetParents(ctx context.Context, req *v1.RoleGetParentsRequest) (*v1.RoleGetParentsResponse, error) {
+	list, err := h.roleController.GetParents(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("call RoleController.GetParents method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	items := make([]*v1.RoleInfo, 0, len(list))
+
+	for _, item := range list {
+		items = append(items, &v1.RoleInfo{
+			Id:                   item.ID,
+			Name:                 item.Name,
+			DataScope:            string(item.DataScope),
+			DataScopeDepartments: item.DataScopeDepartments,
+		})
+	}
+
+	return &v1.RoleGetParentsResponse{Items: items}, nil
+}
@@ -27,37 +27,48 @@ func NewUserHandler(
 
 func (h *UserHandler) List(ctx context.Context, req *v1.UserListRequest) (*v1.UserListResponse, error) {
 	r := controller.UserListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: toPageRequest(req.Page),
 	}
 
-	list, err := h.userController.List(ctx, r)
+	page, err := h.userController.List(ctx, r)
 	if err != nil {
 		h.logger.Error("call UserController.List method error", slog.Any("error", err))
 		return nil, errors.Wrap(err)
 	}
 
-	items := make([]*v1.UserInfo, 0, len(list))
+	items := make([]*v1.UserInfo, 0, len(page.Items))
 
-	for _, item := range list {
+	for _, item := range page.Items {
 		items = append(items, &v1.UserInfo{
-			Id:       item.ID,
-			Username: item.Username,
-			Nickname: item.Nickname,
-			Phone:    item.Phone,
+			Id:             item.ID,
+			Username:       item.Username,
+			Nickname:       item.Nickname,
+			Phone:          item.Phone,
+			Email:          item.Email,
+			EmailVerified:  item.EmailVerified(),
+			ServiceAccount: item.ServiceAccount,
+			DepartmentId:   item.DepartmentID,
 		})
 	}
 
-	return &v1.UserListResponse{Items: items}, nil
+	return &v1.UserListResponse{
+		Items: items,
+		Page:  toPageInfo(page),
+	}, nil
 }
 
 func (h *UserHandler) Create(ctx context.Context, req *v1.UserCreateRequest) (*v1.UserCreateResponse, error) {
 	r := controller.UserCreateRequest{
 		UserAttr: controller.UserAttr{
-			Username: req.Username,
-			Password: req.Password,
-			Nickname: req.Nickname,
-			Phone:    req.Phone,
+			Username:     req.Username,
+			Password:     req.Password,
+			Nickname:     req.Nickname,
+			Phone:        req.Phone,
+			Email:        req.Email,
+			DepartmentID: req.DepartmentId,
 		},
+		ServiceAccount: req.ServiceAccount,
 	}
 
 	if err := h.userController.Create(ctx, r); err != nil {
@@ -72,10 +83,12 @@ func (h *UserHandler) Update(ctx context.Context, req *v1.UserUpdateRequest) (*v
 	r := controller.UserUpdateRequest{
 		ID: req.Id,
 		UserAttr: controller.UserAttr{
-			Username: req.Username,
-			Password: req.Password,
-			Nickname: req.Nickname,
-			Phone:    req.Phone,
+			Username:     req.Username,
+			Password:     req.Password,
+			Nickname:     req.Nickname,
+			Phone:        req.Phone,
+			Email:        req.Email,
+			DepartmentID: req.DepartmentId,
 		},
 	}
 
@@ -95,10 +108,14 @@ func (h *UserHandler) Detail(ctx context.Context, req *v1.UserDetailRequest) (*v
 	}
 
 	return &v1.UserInfo{
-		Id:       ret.ID,
-		Username: ret.Username,
-		Nickname: ret.Nickname,
-		Phone:    ret.Phone,
+		Id:             ret.ID,
+		Username:       ret.Username,
+		Nickname:       ret.Nickname,
+		Phone:          ret.Phone,
+		Email:          ret.Email,
+		EmailVerified:  ret.EmailVerified(),
+		ServiceAccount: ret.ServiceAccount,
+		DepartmentId:   ret.DepartmentID,
 	}, nil
 }
 
@@ -113,8 +130,16 @@ func (h *UserHandler) Delete(ctx context.Context, req *v1.UserDeleteRequest) (*v
 
 func (h *UserHandler) AssignRoles(ctx context.Context, req *v1.UserAssignRolesRequest) (*v1.UserAssignRolesResponse, error) {
 	r := controller.UserAssignRoleRequest{
-		User:  req.User,
-		Roles: req.Roles,
+		User:   req.User,
+		Roles:  req.Roles,
+		Grants: make([]controller.UserRoleGrant, 0, len(req.Grants)),
+	}
+	for _, item := range req.Grants {
+		r.Grants = append(r.Grants, controller.UserRoleGrant{
+			Role:      item.Role,
+			StartsAt:  item.StartsAt,
+			ExpiresAt: item.ExpiresAt,
+		})
 	}
 
 	if err := h.userController.AssignRoles(ctx, r); err != nil {
@@ -132,14 +157,29 @@ func (h *UserHandler) GetRoles(ctx context.Context, req *v1.UserGetRolesRequest)
 		return nil, errors.Wrap(err)
 	}
 
-	items := make([]*v1.RoleInfo, 0, len(list))
+	items := make([]*v1.UserRoleInfo, 0, len(list))
 
 	for _, item := range list {
-		items = append(items, &v1.RoleInfo{
-			Id:   item.ID,
-			Name: item.Name,
+		items = append(items, &v1.UserRoleInfo{
+			Role: &v1.RoleInfo{
+				Id:                   item.Role.ID,
+				Name:                 item.Role.Name,
+				DataScope:            string(item.Role.DataScope),
+				DataScopeDepartments: item.Role.DataScopeDepartments,
+			},
+			StartsAt:  item.StartsAt,
+			ExpiresAt: item.ExpiresAt,
 		})
 	}
 
 	return &v1.UserGetRolesResponse{Items: items}, nil
 }
+
+func (h *UserHandler) Unlock(ctx context.Context, req *v1.UserUnlockRequest) (*v1.UserUnlockResponse, error) {
+	if err := h.userController.Unlock(ctx, req.Id); err != nil {
+		h.logger.Error("call UserController.Unlock method error", slog.Any("error", err))
+		return nil, errors.Wrap(err)
+	}
+
+	return &v1.UserUnlockResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	kerr "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"go-scaffold/internal/app/domain"
+)
+
+type AuditRecorder interface {
+	// Record persist the audit log, the failure is handled by the recorder
+	// so that the request is not affected
+	Record(ctx context.Context, log domain.AuditLog)
+}
+
+type AuditConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper Skipper
+
+	// AuditRecorder handle the record of the audit log
+	AuditRecorder AuditRecorder
+}
+
+func (c *AuditConfig) WithSkipper(skipper Skipper) *AuditConfig {
+	c.Skipper = skipper
+	return c
+}
+
+func (c *AuditConfig) WithRecorder(recorder AuditRecorder) *AuditConfig {
+	c.AuditRecorder = recorder
+	return c
+}
+
+func NewDefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Skipper: DefaultSkipper,
+	}
+}
+
+// Audit record every call made under impersonation into the audit log,
+// the status is the http status code that the error of the call is mapped to.
+// It must be used after the Auth middleware
+func Audit(config AuditConfig) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || config.Skipper(ctx, tr.Operation()) || config.AuditRecorder == nil {
+				return handler(ctx, req)
+			}
+
+			user, ok := GetUser(ctx)
+			if !ok || !user.IsImpersonated() {
+				return handler(ctx, req)
+			}
+
+			reply, err := handler(ctx, req)
+
+			status := http.StatusOK
+			if err != nil {
+				status = int(kerr.FromError(err).Code)
+			}
+
+			config.AuditRecorder.Record(context.WithoutCancel(ctx), domain.AuditLog{
+				TenantID:  domain.TenantFromContext(ctx),
+				ActorID:   user.Actor.ID,
+				UserID:    user.ID,
+				Action:    domain.AuditActionImpersonatedRequest,
+				Method:    string(tr.Kind()),
+				Path:      tr.Operation(),
+				Status:    status,
+				IP:        peerIP(ctx),
+				UserAgent: tr.RequestHeader().Get("user-agent"),
+			})
+
+			return reply, err
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	gerr "go-scaffold/internal/app/facade/server/grpc/pkg/errors"
+	berr "go-scaffold/internal/errors"
+)
+
+const (
+	defaultTokenHeaderKey         = "authorization"
+	defaultTokenHeaderValuePrefix = "Bearer "
+	defaultAPIKeyHeaderKey        = "x-api-key"
+	defaultTenantHeaderKey        = "x-tenant-id"
+)
+
+// Skipper defines a function to skip middleware by the full gRPC operation name,
+// e.g. "/internal.app.adapter.grpc.api.v1.user.User/Create"
+type Skipper func(ctx context.Context, operation string) bool
+
+// DefaultSkipper returns false which processes the middleware
+func DefaultSkipper(context.Context, string) bool {
+	return false
+}
+
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, token string) (*domain.UserProfile, error)
+}
+
+type TenantResolver interface {
+	ResolveTenant(ctx context.Context, user domain.UserProfile, tenant int64) error
+}
+
+type AuthConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper Skipper
+
+	// HeaderKey key that get the token from metadata
+	// if not specified，default: "authorization"
+	HeaderKey string
+
+	// HeaderValuePrefix  header value prefix of token
+	// if not specified，default: "Bearer "
+	HeaderValuePrefix string
+
+	// TokenValidator handle the validate of token
+	TokenValidator TokenValidator
+
+	// APIKeyHeaderKey key that get the API key from metadata
+	// if not specified，default: "x-api-key"
+	APIKeyHeaderKey string
+
+	// APIKeyValidator handle the validate of API key,
+	// the API key takes precedence over the token if both are present
+	APIKeyValidator TokenValidator
+
+	// TenantHeaderKey key that get the tenant that the call acts in from metadata,
+	// the tenant of the credential is used if the metadata is absent
+	// if not specified，default: "x-tenant-id"
+	TenantHeaderKey string
+
+	// TenantResolver ensure the user can act in the tenant of the metadata,
+	// the metadata is ignored if it is not specified
+	TenantResolver TenantResolver
+}
+
+func (c *AuthConfig) WithSkipper(skipper Skipper) *AuthConfig {
+	c.Skipper = skipper
+	return c
+}
+
+func (c *AuthConfig) WithHeaderKey(key string) *AuthConfig {
+	c.HeaderKey = key
+	return c
+}
+
+func (c *AuthConfig) WithHeaderValuePrefix(prefix string) *AuthConfig {
+	c.HeaderValuePrefix = prefix
+	return c
+}
+
+func (c *AuthConfig) WithTokenValidator(handler TokenValidator) *AuthConfig {
+	c.TokenValidator = handler
+	return c
+}
+
+func (c *AuthConfig) WithAPIKeyHeaderKey(key string) *AuthConfig {
+	c.APIKeyHeaderKey = key
+	return c
+}
+
+func (c *AuthConfig) WithAPIKeyValidator(handler TokenValidator) *AuthConfig {
+	c.APIKeyValidator = handler
+	return c
+}
+
+func (c *AuthConfig) WithTenantHeaderKey(key string) *AuthConfig {
+	c.TenantHeaderKey = key
+	return c
+}
+
+func (c *AuthConfig) WithTenantResolver(handler TenantResolver) *AuthConfig {
+	c.TenantResolver = handler
+	return c
+}
+
+func NewDefaultAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		Skipper:           DefaultSkipper,
+		HeaderKey:         defaultTokenHeaderKey,
+		HeaderValuePrefix: defaultTokenHeaderValuePrefix,
+		APIKeyHeaderKey:   defaultAPIKeyHeaderKey,
+		TenantHeaderKey:   defaultTenantHeaderKey,
+	}
+}
+
+// Auth validate the bearer token or the API key in the metadata,
+// the authenticated user is stored in the context, see GetUser
+func Auth(config AuthConfig) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, gerr.Wrap(berr.ErrInvalidAuthorized)
+			}
+
+			if config.Skipper(ctx, tr.Operation()) {
+				return handler(ctx, req)
+			}
+
+			if config.APIKeyValidator != nil {
+				if key := tr.RequestHeader().Get(config.APIKeyHeaderKey); key != "" {
+					return authAPIKey(ctx, config, key, tr.Operation(), handler, req)
+				}
+			}
+
+			token := tr.RequestHeader().Get(config.HeaderKey)
+			if token == "" {
+				return nil, gerr.Wrap(berr.ErrInvalidAuthorized)
+			}
+
+			if config.HeaderValuePrefix != "" {
+				token = strings.TrimPrefix(token, config.HeaderValuePrefix)
+			}
+
+			if config.TokenValidator == nil {
+				return handler(ctx, req)
+			}
+
+			user, err := config.TokenValidator.ValidateToken(ctx, token)
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, gerr.Wrap(err)
+			} else if err != nil {
+				return nil, gerr.Wrap(berr.ErrInvalidAuthorized)
+			}
+
+			if err := resolveTenant(ctx, config, tr, user); err != nil {
+				return nil, err
+			}
+
+			return handler(newContext(ctx, *user, token), req)
+		}
+	}
+}
+
+// authAPIKey the call must be within the scopes of the API key besides the permissions of the service account
+func authAPIKey(ctx context.Context, config AuthConfig, key, operation string, handler middleware.Handler, req any) (any, error) {
+	user, err := config.APIKeyValidator.ValidateToken(ctx, key)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, gerr.Wrap(err)
+	} else if err != nil {
+		return nil, gerr.Wrap(berr.ErrInvalidAuthorized)
+	}
+
+	if !user.InScope(operation) {
+		return nil, gerr.Wrap(berr.ErrAccessDenied)
+	}
+
+	tr, _ := transport.FromServerContext(ctx)
+	if err := resolveTenant(ctx, config, tr, user); err != nil {
+		return nil, err
+	}
+
+	return handler(newContext(ctx, *user, ""), req)
+}
+
+// resolveTenant the user acts in the tenant of the metadata if it is present, otherwise the tenant of the credential,
+// the tenant can not be switched under impersonation
+func resolveTenant(ctx context.Context, config AuthConfig, tr transport.Transporter, user *domain.UserProfile) error {
+	header := tr.RequestHeader().Get(config.TenantHeaderKey)
+	if config.TenantResolver == nil || header == "" {
+		return nil
+	}
+
+	tenant, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || tenant <= 0 {
+		return gerr.Wrap(berr.ErrBadCall.WithMsg("malformed tenant"))
+	}
+
+	if tenant == user.TenantID {
+		return nil
+	}
+
+	if user.IsImpersonated() {
+		return gerr.Wrap(berr.ErrAccessDenied.WithMsg("the tenant can not be switched under impersonation"))
+	}
+
+	if err := config.TenantResolver.ResolveTenant(ctx, *user, tenant); err != nil {
+		return gerr.Wrap(err)
+	}
+
+	user.TenantID = tenant
+
+	return nil
+}
+
+// newContext carries the authenticated user and the tenant that the call acts in
+func newContext(ctx context.Context, user domain.UserProfile, token string) context.Context {
+	return NewContext(domain.NewTenantContext(ctx, user.TenantID), user, token)
+}
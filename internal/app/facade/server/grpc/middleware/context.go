@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+
+	"go-scaffold/internal/app/domain"
+)
+
+type userContextKey struct{}
+
+type userContext struct {
+	user  domain.UserProfile
+	token string
+}
+
+// NewContext returns a new context that carries the authenticated user
+func NewContext(ctx context.Context, user domain.UserProfile, token string) context.Context {
+	return context.WithValue(ctx, userContextKey{}, userContext{user: user, token: token})
+}
+
+// GetUser get user profile from context,
+// it is the impersonated user under impersonation
+func GetUser(ctx context.Context) (domain.UserProfile, bool) {
+	u, ok := ctx.Value(userContextKey{}).(userContext)
+	return u.user, ok
+}
+
+// GetActor get the profile of the real user that makes the call,
+// it is the same as GetUser unless under impersonation
+func GetActor(ctx context.Context) (domain.UserProfile, bool) {
+	user, ok := GetUser(ctx)
+	if ok && user.Actor != nil {
+		return *user.Actor, true
+	}
+	return user, ok
+}
+
+// GetToken get the token that the user is authenticated with
+func GetToken(ctx context.Context) string {
+	u, _ := ctx.Value(userContextKey{}).(userContext)
+	return u.token
+}
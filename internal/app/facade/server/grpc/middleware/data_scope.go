@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"go-scaffold/internal/app/domain"
+	gerr "go-scaffold/internal/app/facade/server/grpc/pkg/errors"
+	berr "go-scaffold/internal/errors"
+)
+
+type DataScopeResolver interface {
+	ResolveDataScope(ctx context.Context, user domain.UserProfile) (*domain.DataFilter, error)
+}
+
+type DataScopeConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper Skipper
+
+	// DataScopeResolver handle the resolve of the data scope
+	DataScopeResolver DataScopeResolver
+}
+
+func (c *DataScopeConfig) WithSkipper(skipper Skipper) *DataScopeConfig {
+	c.Skipper = skipper
+	return c
+}
+
+func (c *DataScopeConfig) WithResolver(resolver DataScopeResolver) *DataScopeConfig {
+	c.DataScopeResolver = resolver
+	return c
+}
+
+func NewDefaultDataScopeConfig() *DataScopeConfig {
+	return &DataScopeConfig{
+		Skipper: DefaultSkipper,
+	}
+}
+
+// DataScope store the rows that the user may see in the context,
+// the repositories apply it to the queries, it must be used after the Auth middleware,
+// the data scope of the impersonated user applies under impersonation
+func DataScope(config DataScopeConfig) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, gerr.Wrap(berr.ErrInvalidAuthorized)
+			}
+
+			if config.Skipper(ctx, tr.Operation()) || config.DataScopeResolver == nil {
+				return handler(ctx, req)
+			}
+
+			user, ok := GetUser(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			filter, err := config.DataScopeResolver.ResolveDataScope(ctx, user)
+			if err != nil {
+				return nil, gerr.Wrap(err)
+			}
+
+			return handler(domain.NewDataFilterContext(ctx, *filter), req)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+
+	gerr "go-scaffold/internal/app/facade/server/grpc/pkg/errors"
+	berr "go-scaffold/internal/errors"
+)
+
+// DenyImpersonation reject the call made under impersonation,
+// it protects the credentials and the sessions of the impersonated user.
+// It must be used after the Auth middleware, usually with the selector of the operations
+func DenyImpersonation() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			if user, ok := GetUser(ctx); ok && user.IsImpersonated() {
+				return nil, gerr.Wrap(berr.ErrAccessDenied)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
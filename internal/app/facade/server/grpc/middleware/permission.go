@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/peer"
+
+	gerr "go-scaffold/internal/app/facade/server/grpc/pkg/errors"
+	berr "go-scaffold/internal/errors"
+	icasbin "go-scaffold/internal/pkg/casbin"
+)
+
+type PermissionValidator interface {
+	ValidatePermission(ctx context.Context, user int64, permissionKey string) (bool, error)
+}
+
+type PermissionConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper Skipper
+
+	// PermissionValidator handle the validate of permission
+	PermissionValidator PermissionValidator
+}
+
+func (c *PermissionConfig) WithSkipper(skipper Skipper) *PermissionConfig {
+	c.Skipper = skipper
+	return c
+}
+
+func (c *PermissionConfig) WithValidator(handler PermissionValidator) *PermissionConfig {
+	c.PermissionValidator = handler
+	return c
+}
+
+func NewDefaultPermissionConfig() *PermissionConfig {
+	return &PermissionConfig{
+		Skipper: DefaultSkipper,
+	}
+}
+
+// Permission check the full gRPC operation name as the permission key,
+// it must be used after the Auth middleware
+func Permission(config PermissionConfig) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, gerr.Wrap(berr.ErrAccessDenied)
+			}
+
+			if config.Skipper(ctx, tr.Operation()) {
+				return handler(ctx, req)
+			}
+
+			if config.PermissionValidator != nil {
+				user, ok := GetUser(ctx)
+				if !ok {
+					return nil, gerr.Wrap(berr.ErrInvalidAuthorized)
+				}
+
+				ctx := icasbin.WithEnvironment(ctx, environment(ctx, tr))
+
+				users := []int64{user.ID}
+				// the impersonation never grants the actor more than its own permissions
+				if user.IsImpersonated() {
+					users = append(users, user.Actor.ID)
+				}
+
+				for _, id := range users {
+					result, err := config.PermissionValidator.ValidatePermission(ctx, id, tr.Operation())
+					if err != nil {
+						return nil, gerr.Wrap(err)
+					}
+					if !result {
+						return nil, gerr.Wrap(berr.ErrAccessDenied)
+					}
+				}
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// environment the attributes of the request that the conditions of the permissions are evaluated against,
+// the keys of the metadata are lowercase already
+func environment(ctx context.Context, tr transport.Transporter) icasbin.Environment {
+	attributes := map[string]string{
+		"operation": tr.Operation(),
+	}
+	for _, key := range tr.RequestHeader().Keys() {
+		attributes["header."+key] = tr.RequestHeader().Get(key)
+	}
+
+	return icasbin.Environment{
+		IP:         peerIP(ctx),
+		Time:       time.Now(),
+		Attributes: attributes,
+	}
+}
+
+// peerIP the ip of the client that the connection is made from
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return ip
+}
@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+
+	"go-scaffold/internal/app/domain"
+	v1api "go-scaffold/internal/app/facade/server/grpc/api/v1"
+)
+
+// permissionParents the permissions that the operations of the services are placed under,
+// they are the same as the ones of the HTTP routes, the services out of it are not synchronized,
+// e.g. the health and reflection services registered by kratos
+var permissionParents = map[string]string{
+	v1api.User_ServiceDesc.ServiceName:       "/users",
+	v1api.Account_ServiceDesc.ServiceName:    "/users",
+	v1api.Role_ServiceDesc.ServiceName:       "/roles",
+	v1api.Permission_ServiceDesc.ServiceName: "/permissions",
+	v1api.Product_ServiceDesc.ServiceName:    "/products",
+}
+
+// PermissionDefinitions returns the permissions of the operations registered on the server,
+// the public and self-service operations are not required any permission.
+// The names are not declared, so that the ones given by the migrations are kept
+func PermissionDefinitions(srv *grpc.Server) []domain.PermissionDefinition {
+	services := srv.GetServiceInfo()
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		if _, ok := permissionParents[name]; ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	list := make([]domain.PermissionDefinition, 0)
+	for _, name := range names {
+		for _, method := range services[name].Methods {
+			operation := "/" + name + "/" + method.Name
+			if _, ok := publicOperations[operation]; ok {
+				continue
+			}
+			if _, ok := selfServiceOperations[operation]; ok {
+				continue
+			}
+
+			list = append(list, domain.PermissionDefinition{
+				Key:    operation,
+				Parent: permissionParents[name],
+			})
+		}
+	}
+
+	return list
+}
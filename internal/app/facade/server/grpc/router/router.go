@@ -13,6 +13,7 @@ type Router struct {
 	roleServer       v1api.RoleServer
 	permissionServer v1api.PermissionServer
 	productServer    v1api.ProductServer
+	accountServer    v1api.AccountServer
 }
 
 // New 构造注册器
@@ -22,6 +23,7 @@ func New(
 	roleServer v1api.RoleServer,
 	permissionServer v1api.PermissionServer,
 	productServer v1api.ProductServer,
+	accountServer v1api.AccountServer,
 ) *Router {
 	return &Router{
 		greetServer:      greetServer,
@@ -29,6 +31,7 @@ func New(
 		roleServer:       roleServer,
 		permissionServer: permissionServer,
 		productServer:    productServer,
+		accountServer:    accountServer,
 	}
 }
 
@@ -39,4 +42,5 @@ func (r *Router) Register(server *grpc.Server) {
 	v1api.RegisterRoleServer(server, r.roleServer)
 	v1api.RegisterPermissionServer(server, r.permissionServer)
 	v1api.RegisterProductServer(server, r.productServer)
+	v1api.RegisterAccountServer(server, r.accountServer)
 }
@@ -22,6 +22,70 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/v1/account/email/verification": {
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "重新发送邮箱验证邮件，之前发送的验证邮件失效",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "账号"
+                ],
+                "summary": "发送邮箱验证邮件",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/account/permissions": {
             "get": {
                 "security": [
@@ -251,35 +315,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/greet": {
+        "/v1/account/sessions": {
             "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "示例接口",
+                "description": "获取账号在各个设备上的登录会话",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "示例"
-                ],
-                "summary": "示例接口",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "string",
-                        "default": "Tom",
-                        "description": "名称",
-                        "name": "name",
-                        "in": "query",
-                        "required": true
-                    }
+                    "账号"
                 ],
+                "summary": "获取账号会话列表",
                 "responses": {
                     "200": {
                         "description": "成功响应",
@@ -292,7 +345,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/v1.GreetHelloResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.AccountSessionInfo"
+                                            }
                                         }
                                     }
                                 }
@@ -338,16 +394,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/login": {
-            "post": {
+        "/v1/account/sessions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "登录",
+                "description": "注销指定设备上的登录会话，该会话的令牌将立即失效",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
@@ -355,36 +411,21 @@ const docTemplate = `{
                 "tags": [
                     "账号"
                 ],
-                "summary": "登录",
+                "summary": "注销账号会话",
                 "parameters": [
                     {
-                        "format": "string",
-                        "description": "请求体",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.AccountLoginRequest"
-                        }
+                        "type": "string",
+                        "description": "会话 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/example.Success"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/v1.AccountLoginResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/example.Success"
                         }
                     },
                     "400": {
@@ -426,14 +467,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/logout": {
-            "delete": {
+        "/v1/account/totp": {
+            "post": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "登出",
+                "description": "生成 TOTP 密钥，验证码激活后生效",
                 "consumes": [
                     "text/plain"
                 ],
@@ -443,12 +484,24 @@ const docTemplate = `{
                 "tags": [
                     "账号"
                 ],
-                "summary": "登出",
+                "summary": "绑定 TOTP",
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountTOTPEnrollmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -488,16 +541,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/permission": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "权限更新",
+                "description": "使用 TOTP 验证码或恢复码关闭 TOTP，角色要求二次验证时不可关闭",
                 "consumes": [
                     "application/json"
                 ],
@@ -505,18 +556,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "权限"
+                    "账号"
                 ],
-                "summary": "权限更新",
+                "summary": "关闭 TOTP",
                 "parameters": [
                     {
                         "format": "string",
-                        "description": "权限信息",
+                        "description": "请求体",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.PermissionUpdateRequest"
+                            "$ref": "#/definitions/v1.AccountTOTPRequest"
                         }
                     }
                 ],
@@ -564,14 +615,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/account/totp/activate": {
             "post": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "权限创建",
+                "description": "验证 TOTP 验证码并激活，返回的恢复码仅展示一次",
                 "consumes": [
                     "application/json"
                 ],
@@ -579,18 +632,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "权限"
+                    "账号"
                 ],
-                "summary": "权限创建",
+                "summary": "激活 TOTP",
                 "parameters": [
                     {
                         "format": "string",
-                        "description": "权限信息",
+                        "description": "请求体",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.PermissionCreateRequest"
+                            "$ref": "#/definitions/v1.AccountTOTPRequest"
                         }
                     }
                 ],
@@ -598,7 +651,22 @@ const docTemplate = `{
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -640,33 +708,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/permission/{id}": {
-            "get": {
+        "/v1/account/totp/recovery-codes": {
+            "post": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "权限详情",
+                "description": "验证 TOTP 验证码后重新生成恢复码，原有恢复码失效，返回的恢复码仅展示一次",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "权限"
+                    "账号"
                 ],
-                "summary": "权限详情",
+                "summary": "重新生成恢复码",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "format": "uint",
-                        "description": "权限 id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountTOTPRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -681,7 +750,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/v1.PermissionDetailResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
                                         }
                                     }
                                 }
@@ -725,40 +797,55 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/api-key": {
+            "post": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "权限删除",
+                "description": "为服务账号签发 API 密钥，密钥仅在创建时返回一次",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "权限"
+                    "API 密钥"
                 ],
-                "summary": "权限删除",
+                "summary": "API 密钥新增",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "format": "uint",
-                        "description": "权限 id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "string",
+                        "description": "密钥信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.APIKeyCreateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.APIKeyCreateResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -800,53 +887,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/permissions": {
-            "get": {
+        "/v1/api-key/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "权限列表",
+                "description": "API 密钥删除，删除后立即失效",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "权限"
+                    "API 密钥"
                 ],
-                "summary": "权限列表",
+                "summary": "API 密钥删除",
                 "parameters": [
                     {
-                        "type": "string",
-                        "format": "string",
-                        "description": "查询字符串",
-                        "name": "keyword",
-                        "in": "query"
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "密钥 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/example.Success"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/v1.PermissionInfo"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/example.Success"
                         }
                     },
                     "400": {
@@ -888,41 +962,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/producer/example": {
-            "post": {
+        "/v1/api-keys": {
+            "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "示例接口",
+                "description": "服务账号的 API 密钥列表",
                 "consumes": [
-                    "application/json"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "示例"
+                    "API 密钥"
                 ],
-                "summary": "示例接口",
+                "summary": "API 密钥列表",
                 "parameters": [
                     {
-                        "format": "string",
-                        "description": "生产者消息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ProducerExampleRequest"
-                        }
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "服务账号 id",
+                        "name": "userID",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.APIKeyInfo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -964,41 +1052,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/product": {
-            "put": {
+        "/v1/audit-logs": {
+            "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "产品更新",
+                "description": "最近的审计日志，按时间倒序排列",
                 "consumes": [
-                    "application/json"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "产品"
+                    "审计日志"
                 ],
-                "summary": "产品更新",
+                "summary": "审计日志列表",
                 "parameters": [
                     {
-                        "format": "string",
-                        "description": "产品信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ProductUpdateRequest"
-                        }
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "操作人 id",
+                        "name": "actorID",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "被模拟的用户 id",
+                        "name": "userID",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "数量，默认 100，最大 1000",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.AuditLogInfo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1038,41 +1153,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/authz/cache": {
+            "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "产品创建",
+                "description": "当前实例的权限标识查询缓存与授权决策缓存的命中统计",
                 "consumes": [
-                    "application/json"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "产品"
-                ],
-                "summary": "产品创建",
-                "parameters": [
-                    {
-                        "format": "string",
-                        "description": "产品信息",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.ProductCreateRequest"
-                        }
-                    }
+                    "权限"
                 ],
+                "summary": "授权缓存统计",
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AuthzCacheStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1093,12 +1210,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/example.PermissionDenied"
                         }
                     },
-                    "404": {
-                        "description": "资源不存在",
-                        "schema": {
-                            "$ref": "#/definitions/example.ResourceNotFound"
-                        }
-                    },
                     "429": {
                         "description": "请求过于频繁",
                         "schema": {
@@ -1114,32 +1225,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/product/{id}": {
+        "/v1/authz/explain": {
             "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "产品详情",
+                "description": "解释用户在当前租户内被允许或拒绝访问权限的原因",
                 "consumes": [
-                    "text/plain"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "产品"
+                    "权限"
                 ],
-                "summary": "产品详情",
+                "summary": "解释授权决策",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "format": "uint",
-                        "description": "产品 id",
-                        "name": "id",
-                        "in": "path",
+                        "format": "int64",
+                        "description": "用户 id",
+                        "name": "user",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "权限标识",
+                        "name": "permission",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -1155,7 +1273,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/v1.ProductDetailResponse"
+                                            "$ref": "#/definitions/v1.AuthzExplainResponse"
                                         }
                                     }
                                 }
@@ -1199,40 +1317,50 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "产品删除",
+            }
+        },
+        "/v1/email/verify": {
+            "post": {
+                "description": "使用验证邮件中的 token 验证邮箱，token 仅可使用一次",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "产品"
+                    "账号"
                 ],
-                "summary": "产品删除",
+                "summary": "验证邮箱",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "format": "uint",
-                        "description": "产品 id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountVerifyEmailRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountVerifyEmailResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1274,14 +1402,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/products": {
+        "/v1/greet": {
             "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "产品列表",
+                "description": "示例接口",
                 "consumes": [
                     "application/x-www-form-urlencoded"
                 ],
@@ -1289,16 +1417,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "产品"
+                    "示例"
                 ],
-                "summary": "产品列表",
+                "summary": "示例接口",
                 "parameters": [
                     {
                         "type": "string",
                         "format": "string",
-                        "description": "查询字符串",
-                        "name": "keyword",
-                        "in": "query"
+                        "default": "Tom",
+                        "description": "名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1313,10 +1443,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/v1.ProductInfo"
-                                            }
+                                            "$ref": "#/definitions/v1.GreetHelloResponse"
                                         }
                                     }
                                 }
@@ -1362,14 +1489,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/register": {
+        "/v1/login": {
             "post": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "注册",
+                "description": "登录，需要二次验证时返回 challenge，使用 /v1/login/totp 完成登录",
                 "consumes": [
                     "application/json"
                 ],
@@ -1379,7 +1506,7 @@ const docTemplate = `{
                 "tags": [
                     "账号"
                 ],
-                "summary": "注册",
+                "summary": "登录",
                 "parameters": [
                     {
                         "format": "string",
@@ -1388,7 +1515,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.AccountRegisterRequest"
+                            "$ref": "#/definitions/v1.AccountLoginRequest"
                         }
                     }
                 ],
@@ -1404,7 +1531,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/v1.AccountRegisterResponse"
+                                            "$ref": "#/definitions/v1.AccountLoginResponse"
                                         }
                                     }
                                 }
@@ -1450,14 +1577,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/role": {
-            "put": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "角色更新",
+        "/v1/login/totp": {
+            "post": {
+                "description": "使用 TOTP 验证码或恢复码完成登录，需要绑定 TOTP 时验证码将同时激活 TOTP 并返回恢复码",
                 "consumes": [
                     "application/json"
                 ],
@@ -1465,18 +1587,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
+                    "账号"
                 ],
-                "summary": "角色更新",
+                "summary": "二次验证登录",
                 "parameters": [
                     {
                         "format": "string",
-                        "description": "权限信息",
+                        "description": "请求体",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.RoleUpdateRequest"
+                            "$ref": "#/definitions/v1.AccountLoginTOTPRequest"
                         }
                     }
                 ],
@@ -1484,15 +1606,27 @@ const docTemplate = `{
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
-                        }
-                    },
-                    "400": {
-                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
-                        "schema": {
-                            "$ref": "#/definitions/example.ClientError"
-                        }
-                    },
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountLoginResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
                     "401": {
                         "description": "登陆失效",
                         "schema": {
@@ -1524,14 +1658,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/login/totp/enroll": {
             "post": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "角色创建",
+                "description": "角色要求二次验证但尚未绑定 TOTP 时，使用登录返回的 challenge 绑定 TOTP",
                 "consumes": [
                     "application/json"
                 ],
@@ -1539,18 +1670,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
+                    "账号"
                 ],
-                "summary": "角色创建",
+                "summary": "登录时绑定 TOTP",
                 "parameters": [
                     {
                         "format": "string",
-                        "description": "权限信息",
+                        "description": "请求体",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.RoleCreateRequest"
+                            "$ref": "#/definitions/v1.AccountLoginTOTPEnrollRequest"
                         }
                     }
                 ],
@@ -1558,7 +1689,19 @@ const docTemplate = `{
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountTOTPEnrollmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1600,36 +1743,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/role/permissions": {
-            "get": {
+        "/v1/logout": {
+            "delete": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "获取角色权限",
+                "description": "登出",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
-                ],
-                "summary": "获取角色权限",
-                "parameters": [
-                    {
-                        "format": "string",
-                        "description": "请求体",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.RoleGetPermissionsRequest"
-                        }
-                    }
+                    "账号"
                 ],
+                "summary": "登出",
                 "responses": {
                     "200": {
                         "description": "成功响应",
@@ -1674,41 +1805,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "授权角色权限",
+            }
+        },
+        "/v1/oidc/{provider}/authorize": {
+            "get": {
+                "description": "获取身份提供方的登录地址，同时设置 HttpOnly 的 Cookie 将 state 绑定至当前浏览器，登录后身份提供方携带 code 和 state 重定向至配置的 redirectURL，再使用 /v1/oidc/{provider}/callback 完成登录",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
+                    "账号"
                 ],
-                "summary": "授权角色权限",
+                "summary": "身份提供方登录授权",
                 "parameters": [
                     {
+                        "type": "string",
                         "format": "string",
-                        "description": "请求体",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.RoleGrantPermissionsRequest"
-                        }
+                        "description": "身份提供方名称",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "$ref": "#/definitions/example.Success"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountOIDCAuthorizeResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1750,33 +1888,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/role/{id}": {
-            "get": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "角色详情",
+        "/v1/oidc/{provider}/callback": {
+            "post": {
+                "description": "使用身份提供方重定向携带的 code 和 state 登录，须由发起授权的浏览器携带授权时设置的 Cookie 请求，首次登录时自动创建用户，需要二次验证时返回 challenge，使用 /v1/login/totp 完成登录",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
+                    "账号"
                 ],
-                "summary": "角色详情",
+                "summary": "身份提供方登录",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "format": "uint",
-                        "description": "角色 id",
-                        "name": "id",
+                        "type": "string",
+                        "format": "string",
+                        "description": "身份提供方名称",
+                        "name": "provider",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountOIDCLoginRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1791,7 +1933,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/v1.RoleDetailResponse"
+                                            "$ref": "#/definitions/v1.AccountLoginResponse"
                                         }
                                     }
                                 }
@@ -1835,33 +1977,31 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "角色删除",
+            }
+        },
+        "/v1/password/forgot": {
+            "post": {
+                "description": "向已验证的邮箱发送重置密码邮件，无论邮箱是否存在均返回成功",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
+                    "账号"
                 ],
-                "summary": "角色删除",
+                "summary": "忘记密码",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "format": "uint",
-                        "description": "角色 id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountForgotPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1910,53 +2050,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/roles": {
-            "get": {
-                "security": [
-                    {
-                        "Authorization": []
-                    }
-                ],
-                "description": "角色列表",
+        "/v1/password/reset": {
+            "post": {
+                "description": "使用重置密码邮件中的 token 重置密码，token 仅可使用一次，重置后所有设备的会话失效",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "角色"
+                    "账号"
                 ],
-                "summary": "列表",
+                "summary": "重置密码",
                 "parameters": [
                     {
-                        "type": "string",
                         "format": "string",
-                        "description": "查询字符串",
-                        "name": "keyword",
-                        "in": "query"
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountResetPasswordRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/example.Success"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/v1.RoleInfo"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/example.Success"
                         }
                     },
                     "400": {
@@ -1998,14 +2121,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/trace/example": {
-            "post": {
+        "/v1/permission": {
+            "put": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "示例接口",
+                "description": "权限更新",
                 "consumes": [
                     "application/json"
                 ],
@@ -2013,9 +2136,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "示例"
+                    "权限"
+                ],
+                "summary": "权限更新",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "权限信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.PermissionUpdateRequest"
+                        }
+                    }
                 ],
-                "summary": "示例接口",
                 "responses": {
                     "200": {
                         "description": "成功响应",
@@ -2060,16 +2195,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/user": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "用户更新",
+                "description": "权限创建",
                 "consumes": [
                     "application/json"
                 ],
@@ -2077,18 +2210,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "权限"
                 ],
-                "summary": "用户更新",
+                "summary": "权限创建",
                 "parameters": [
                     {
                         "format": "string",
-                        "description": "用户信息",
+                        "description": "权限信息",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UserUpdateRequest"
+                            "$ref": "#/definitions/v1.PermissionCreateRequest"
                         }
                     }
                 ],
@@ -2136,14 +2269,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/permission/move": {
+            "put": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "用户创建",
+                "description": "将权限及其子级权限移动至新的父级权限下，父级权限不能是自身或其子级权限",
                 "consumes": [
                     "application/json"
                 ],
@@ -2151,18 +2286,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "权限"
                 ],
-                "summary": "用户创建",
+                "summary": "权限移动",
                 "parameters": [
                     {
                         "format": "string",
-                        "description": "用户信息",
+                        "description": "请求体",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.UserCreateRequest"
+                            "$ref": "#/definitions/v1.PermissionMoveRequest"
                         }
                     }
                 ],
@@ -2212,34 +2347,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user/roles": {
+        "/v1/permission/{id}": {
             "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "获取用户角色",
+                "description": "权限详情",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "权限"
                 ],
-                "summary": "获取用户角色",
+                "summary": "权限详情",
                 "parameters": [
                     {
-                        "format": "string",
-                        "description": "请求体",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.UserGetRoleRequest"
-                        }
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "权限 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2254,10 +2388,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/v1.RoleInfo"
-                                            }
+                                            "$ref": "#/definitions/v1.PermissionDetailResponse"
                                         }
                                     }
                                 }
@@ -2302,33 +2433,32 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "分配用户角色",
+                "description": "权限删除",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "权限"
                 ],
-                "summary": "分配用户角色",
+                "summary": "权限删除",
                 "parameters": [
                     {
-                        "format": "string",
-                        "description": "请求体",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.UserAssignRoleRequest"
-                        }
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "权限 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2377,33 +2507,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/user/{id}": {
+        "/v1/permissions": {
             "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "用户详情",
+                "description": "权限列表",
                 "consumes": [
-                    "text/plain"
+                    "application/x-www-form-urlencoded"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "权限"
                 ],
-                "summary": "用户详情",
+                "summary": "权限列表",
                 "parameters": [
                     {
-                        "minimum": 1,
+                        "type": "string",
+                        "format": "string",
+                        "description": "查询字符串",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
                         "type": "integer",
-                        "format": "uint",
-                        "description": "用户 id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "int",
+                        "description": "偏移量，设置游标时忽略",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "每页条数，默认 20，最多 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "游标，上一页返回的 nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序字段：id, key, name, createdAt, updatedAt，默认 updatedAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序方向：asc 升序，desc 降序，默认 desc",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2418,7 +2581,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/v1.UserDetailResponse"
+                                            "$ref": "#/definitions/v1.PermissionListResponse"
                                         }
                                     }
                                 }
@@ -2462,14 +2625,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/permissions/tree": {
+            "get": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "用户删除",
+                "description": "按父级权限组织的权限树",
                 "consumes": [
                     "text/plain"
                 ],
@@ -2477,18 +2642,98 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "权限"
                 ],
-                "summary": "用户删除",
+                "summary": "权限树",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.PermissionTreeNode"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/producer/example": {
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "示例接口",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "示例"
+                ],
+                "summary": "示例接口",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "format": "uint",
-                        "description": "权限 id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "format": "string",
+                        "description": "生产者消息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProducerExampleRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2537,53 +2782,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users": {
-            "get": {
+        "/v1/product": {
+            "put": {
                 "security": [
                     {
                         "Authorization": []
                     }
                 ],
-                "description": "用户列表",
+                "description": "产品更新",
                 "consumes": [
-                    "application/x-www-form-urlencoded"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "用户"
+                    "产品"
                 ],
-                "summary": "用户列表",
+                "summary": "产品更新",
                 "parameters": [
                     {
-                        "type": "string",
                         "format": "string",
-                        "description": "查询字符串",
-                        "name": "keyword",
-                        "in": "query"
+                        "description": "产品信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProductUpdateRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "成功响应",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/example.Success"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/v1.UserInfo"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/example.Success"
                         }
                     },
                     "400": {
@@ -2623,184 +2856,2855 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "example.ClientError": {
-            "type": "object",
-            "properties": {
-                "errMsg": {
-                    "type": "string",
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "产品创建",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "产品"
+                ],
+                "summary": "产品创建",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "产品信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ProductCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/product/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "产品详情",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "产品"
+                ],
+                "summary": "产品详情",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "产品 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.ProductDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "产品删除",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "产品"
+                ],
+                "summary": "产品删除",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "产品 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/products": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "产品列表",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "产品"
+                ],
+                "summary": "产品列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "查询字符串",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "偏移量，设置游标时忽略",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "每页条数，默认 20，最多 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "游标，上一页返回的 nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序字段：id, name, price, createdAt, updatedAt，默认 updatedAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序方向：asc 升序，desc 降序，默认 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.ProductListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/register": {
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "注册",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "账号"
+                ],
+                "summary": "注册",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountRegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountRegisterResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role": {
+            "put": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "角色更新",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "角色更新",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "权限信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RoleUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "角色创建",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "角色创建",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "权限信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RoleCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role/parents": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "获取角色直接继承的父级角色",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "获取父级角色",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "角色 id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.RoleInfo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "设置父级角色，会替换角色原有的父级角色，角色继承父级角色的所有权限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "设置父级角色",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RoleSetParentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "获取角色的有效权限，包括从父级角色继承的权限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "获取角色权限",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RoleGetPermissionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "授权角色权限，会替换角色原有的权限，cascade 为 true 时同时授予权限的所有子级权限",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "授权角色权限",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.RoleGrantPermissionsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/role/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "角色详情",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "角色详情",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "角色 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.RoleDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "角色删除",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "角色删除",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "角色 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/roles": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "角色列表",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "角色"
+                ],
+                "summary": "列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "查询字符串",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "偏移量，设置游标时忽略",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "每页条数，默认 20，最多 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "游标，上一页返回的 nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序字段：id, name, createdAt, updatedAt，默认 updatedAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序方向：asc 升序，desc 降序，默认 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.RoleListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/token/refresh": {
+            "post": {
+                "description": "使用刷新令牌换取新的令牌对，刷新令牌只能使用一次，重复使用将吊销该令牌族",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "账号"
+                ],
+                "summary": "刷新令牌",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.AccountRefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.AccountRefreshTokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/trace/example": {
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "示例接口",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "示例"
+                ],
+                "summary": "示例接口",
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user": {
+            "put": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "用户更新",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "用户更新",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "用户创建",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "用户创建",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "用户信息",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/roles": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "获取用户角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "获取用户角色",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserGetRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/v1.UserRoleInfo"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "分配用户角色",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "分配用户角色",
+                "parameters": [
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UserAssignRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "用户详情",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "用户详情",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "用户 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.UserDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "用户删除",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "用户删除",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "权限 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/{id}/impersonate": {
+            "post": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "以用户的身份访问系统，用于排查用户反馈的问题，模拟期间的所有请求都会记录在审计日志中，退出当前登录后令牌失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "模拟用户登录",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "用户 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "format": "string",
+                        "description": "请求体",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.ImpersonateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.ImpersonateResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/{id}/lockout": {
+            "delete": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "解除用户因多次登录失败导致的锁定，并清除失败次数",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "解除用户登录锁定",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "format": "uint",
+                        "description": "用户 id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "$ref": "#/definitions/example.Success"
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users": {
+            "get": {
+                "security": [
+                    {
+                        "Authorization": []
+                    }
+                ],
+                "description": "用户列表",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "用户"
+                ],
+                "summary": "用户列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "查询字符串",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "偏移量，设置游标时忽略",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int",
+                        "description": "每页条数，默认 20，最多 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "游标，上一页返回的 nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序字段：id, username, createdAt, updatedAt，默认 updatedAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "string",
+                        "description": "排序方向：asc 升序，desc 降序，默认 desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "成功响应",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/example.Success"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/v1.UserListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）",
+                        "schema": {
+                            "$ref": "#/definitions/example.ClientError"
+                        }
+                    },
+                    "401": {
+                        "description": "登陆失效",
+                        "schema": {
+                            "$ref": "#/definitions/example.Unauthorized"
+                        }
+                    },
+                    "403": {
+                        "description": "没有权限",
+                        "schema": {
+                            "$ref": "#/definitions/example.PermissionDenied"
+                        }
+                    },
+                    "404": {
+                        "description": "资源不存在",
+                        "schema": {
+                            "$ref": "#/definitions/example.ResourceNotFound"
+                        }
+                    },
+                    "429": {
+                        "description": "请求过于频繁",
+                        "schema": {
+                            "$ref": "#/definitions/example.TooManyRequest"
+                        }
+                    },
+                    "500": {
+                        "description": "服务器出错",
+                        "schema": {
+                            "$ref": "#/definitions/example.ServerError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "example.ClientError": {
+            "type": "object",
+            "properties": {
+                "errMsg": {
+                    "type": "string",
                     "example": "客户端请求错误|参数校验错误"
                 },
-                "errNo": {
-                    "description": "errNo 类型应为 int，string 仅为了表达多个错误码",
-                    "type": "string",
-                    "example": "10002|10003"
+                "errNo": {
+                    "description": "errNo 类型应为 int，string 仅为了表达多个错误码",
+                    "type": "string",
+                    "example": "10002|10003"
+                }
+            }
+        },
+        "example.PermissionDenied": {
+            "type": "object",
+            "properties": {
+                "errMsg": {
+                    "type": "string",
+                    "example": "暂无权限"
+                },
+                "errNo": {
+                    "type": "integer",
+                    "example": 10005
+                }
+            }
+        },
+        "example.ResourceNotFound": {
+            "type": "object",
+            "properties": {
+                "errMsg": {
+                    "type": "string",
+                    "example": "资源不存在"
+                },
+                "errNo": {
+                    "type": "integer",
+                    "example": 10006
+                }
+            }
+        },
+        "example.ServerError": {
+            "type": "object",
+            "properties": {
+                "errMsg": {
+                    "type": "string",
+                    "example": "服务器出错"
+                },
+                "errNo": {
+                    "type": "integer",
+                    "example": 10001
+                }
+            }
+        },
+        "example.Success": {
+            "type": "object",
+            "properties": {
+                "data": {}
+            }
+        },
+        "example.TooManyRequest": {
+            "type": "object",
+            "properties": {
+                "errMsg": {
+                    "type": "string",
+                    "example": "请求过于频繁"
+                },
+                "errNo": {
+                    "type": "integer",
+                    "example": 10007
+                }
+            }
+        },
+        "example.Unauthorized": {
+            "type": "object",
+            "properties": {
+                "errMsg": {
+                    "type": "string",
+                    "example": "未经授权"
+                },
+                "errNo": {
+                    "type": "integer",
+                    "example": 10004
+                }
+            }
+        },
+        "v1.APIKeyCreateRequest": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "description": "过期时间，为 0 时永不过期",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "密钥名称",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "可访问的权限标识，如 \"GET /api/v1/users\"，为空时不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userID": {
+                    "description": "服务账号 id",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.APIKeyCreateResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "description": "过期时间，为 0 时永不过期",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "API 密钥，仅在创建时返回一次，通过 X-API-Key 请求头使用",
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "description": "最后使用时间",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "密钥前缀，用于识别密钥",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "可访问的权限标识，为空时不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.APIKeyInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "description": "过期时间，为 0 时永不过期",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastUsedAt": {
+                    "description": "最后使用时间",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "description": "密钥前缀，用于识别密钥",
+                    "type": "string"
+                },
+                "scopes": {
+                    "description": "可访问的权限标识，为空时不限制",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AccountForgotPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "已验证的邮箱",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountLoginChallengeInfo": {
+            "type": "object",
+            "properties": {
+                "challengeToken": {
+                    "type": "string"
+                },
+                "enrollRequired": {
+                    "description": "是否需要先绑定 TOTP",
+                    "type": "boolean"
+                },
+                "expiresAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AccountLoginRequest": {
+            "type": "object",
+            "properties": {
+                "device": {
+                    "description": "设备名称，可选",
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "example.PermissionDenied": {
+        "v1.AccountLoginResponse": {
             "type": "object",
             "properties": {
-                "errMsg": {
-                    "type": "string",
-                    "example": "暂无权限"
+                "challenge": {
+                    "description": "需要二次验证时返回，此时不返回令牌",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AccountLoginChallengeInfo"
+                        }
+                    ]
                 },
-                "errNo": {
-                    "type": "integer",
-                    "example": 10005
+                "recoveryCodes": {
+                    "description": "登录时绑定 TOTP 生成的恢复码，仅返回一次",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "token": {
+                    "$ref": "#/definitions/v1.AccountTokenInfo"
+                },
+                "user": {
+                    "$ref": "#/definitions/v1.UserInfo"
                 }
             }
         },
-        "example.ResourceNotFound": {
+        "v1.AccountLoginTOTPEnrollRequest": {
             "type": "object",
             "properties": {
-                "errMsg": {
-                    "type": "string",
-                    "example": "资源不存在"
+                "challengeToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountLoginTOTPRequest": {
+            "type": "object",
+            "properties": {
+                "challengeToken": {
+                    "type": "string"
                 },
-                "errNo": {
-                    "type": "integer",
-                    "example": 10006
+                "code": {
+                    "description": "TOTP 验证码",
+                    "type": "string"
+                },
+                "recoveryCode": {
+                    "description": "恢复码，未提供验证码时使用",
+                    "type": "string"
                 }
             }
         },
-        "example.ServerError": {
+        "v1.AccountOIDCAuthorizeResponse": {
             "type": "object",
             "properties": {
-                "errMsg": {
-                    "type": "string",
-                    "example": "服务器出错"
+                "authorizationURL": {
+                    "description": "身份提供方的登录地址，前端跳转至该地址",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountOIDCLoginRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "身份提供方重定向携带的 code",
+                    "type": "string"
                 },
-                "errNo": {
-                    "type": "integer",
-                    "example": 10001
+                "device": {
+                    "description": "设备名称，可选",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "身份提供方重定向携带的 state",
+                    "type": "string"
                 }
             }
         },
-        "example.Success": {
+        "v1.AccountProfileResponse": {
             "type": "object",
             "properties": {
-                "data": {}
+                "departmentID": {
+                    "description": "所属部门 id",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "emailVerified": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "serviceAccount": {
+                    "description": "是否为服务账号",
+                    "type": "boolean"
+                },
+                "username": {
+                    "type": "string"
+                }
             }
         },
-        "example.TooManyRequest": {
+        "v1.AccountRefreshTokenRequest": {
             "type": "object",
             "properties": {
-                "errMsg": {
-                    "type": "string",
-                    "example": "请求过于频繁"
+                "device": {
+                    "description": "设备名称，可选",
+                    "type": "string"
                 },
-                "errNo": {
-                    "type": "integer",
-                    "example": 10007
+                "refreshToken": {
+                    "type": "string"
                 }
             }
         },
-        "example.Unauthorized": {
+        "v1.AccountRefreshTokenResponse": {
             "type": "object",
             "properties": {
-                "errMsg": {
-                    "type": "string",
-                    "example": "未经授权"
+                "accessToken": {
+                    "type": "string"
+                },
+                "accessTokenExpiresAt": {
+                    "type": "integer"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "refreshTokenExpiresAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AccountRegisterRequest": {
+            "type": "object",
+            "properties": {
+                "departmentID": {
+                    "description": "所属部门 id，可选",
+                    "type": "integer"
+                },
+                "device": {
+                    "description": "设备名称，可选",
+                    "type": "string"
+                },
+                "email": {
+                    "description": "邮箱，可选",
+                    "type": "string"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "serviceAccount": {
+                    "description": "是否为服务账号，服务账号仅可通过 API 密钥访问，无需密码和手机号",
+                    "type": "boolean"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountRegisterResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "$ref": "#/definitions/v1.AccountTokenInfo"
+                },
+                "user": {
+                    "$ref": "#/definitions/v1.UserInfo"
+                }
+            }
+        },
+        "v1.AccountResetPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "新密码",
+                    "type": "string"
+                },
+                "token": {
+                    "description": "重置密码邮件中的 token",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountSessionInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "integer"
+                },
+                "current": {
+                    "description": "是否为当前会话",
+                    "type": "boolean"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "lastSeenAt": {
+                    "type": "integer"
+                },
+                "userAgent": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountTOTPEnrollmentResponse": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "description": "base32 编码的密钥",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "otpauth URI，可生成二维码供验证器扫描",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountTOTPRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "TOTP 验证码",
+                    "type": "string"
+                },
+                "recoveryCode": {
+                    "description": "恢复码，仅关闭 TOTP 时可用",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountTokenInfo": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "accessTokenExpiresAt": {
+                    "type": "integer"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "refreshTokenExpiresAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.AccountUpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "邮箱，可选，修改后会发送验证邮件",
+                    "type": "string"
+                },
+                "nickname": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountVerifyEmailRequest": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "description": "验证邮件中的 token",
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AccountVerifyEmailResponse": {
+            "type": "object",
+            "properties": {
+                "departmentID": {
+                    "description": "所属部门 id",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "emailVerified": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "nickname": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "serviceAccount": {
+                    "description": "是否为服务账号",
+                    "type": "boolean"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AuditLogInfo": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "操作：impersonate 开始模拟登录，impersonated_request 模拟期间的请求",
+                    "type": "string"
+                },
+                "actorID": {
+                    "description": "操作人 id",
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "integer"
+                },
+                "detail": {
+                    "description": "详情，如模拟登录的原因",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
                 },
-                "errNo": {
-                    "type": "integer",
-                    "example": 10004
+                "userAgent": {
+                    "type": "string"
+                },
+                "userID": {
+                    "description": "被模拟的用户 id",
+                    "type": "integer"
                 }
             }
         },
-        "v1.AccountLoginRequest": {
+        "v1.AuthzCacheStatsResponse": {
             "type": "object",
             "properties": {
-                "password": {
-                    "type": "string"
+                "decisions": {
+                    "description": "授权决策缓存",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.CacheStatsInfo"
+                        }
+                    ]
                 },
-                "username": {
-                    "type": "string"
+                "permissions": {
+                    "description": "权限标识查询缓存",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.CacheStatsInfo"
+                        }
+                    ]
                 }
             }
         },
-        "v1.AccountLoginResponse": {
+        "v1.AuthzExplainResponse": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string"
+                "allowed": {
+                    "description": "是否允许",
+                    "type": "boolean"
                 },
-                "user": {
-                    "$ref": "#/definitions/v1.UserInfo"
+                "conditions": {
+                    "description": "匹配的策略的条件，条件满足时策略才生效，格式为 [主体, 域, 权限 id, 条件]",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "permission": {
+                    "description": "权限，权限标识不存在时为 null",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PermissionInfo"
+                        }
+                    ]
+                },
+                "permissionExist": {
+                    "description": "权限标识是否存在，不存在的权限总是拒绝",
+                    "type": "boolean"
+                },
+                "policies": {
+                    "description": "匹配的策略，授予用户或其继承的角色，格式为 [主体, 域, 权限 id]",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "rolePath": {
+                    "description": "从用户到授予权限的策略主体的角色路径，如 [\"user_1\", \"role_2\", \"role_3\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "superAdmin": {
+                    "description": "是否因超级管理员而允许",
+                    "type": "boolean"
                 }
             }
         },
-        "v1.AccountProfileResponse": {
+        "v1.CacheStatsInfo": {
             "type": "object",
             "properties": {
-                "id": {
+                "capacity": {
+                    "description": "缓存容量，为 0 时缓存未启用",
                     "type": "integer"
                 },
-                "nickname": {
-                    "type": "string"
+                "hitRatio": {
+                    "description": "命中率",
+                    "type": "number"
                 },
-                "phone": {
-                    "type": "string"
+                "hits": {
+                    "description": "命中次数",
+                    "type": "integer"
                 },
-                "username": {
-                    "type": "string"
+                "misses": {
+                    "description": "未命中次数",
+                    "type": "integer"
+                },
+                "size": {
+                    "description": "缓存条目数，包含尚未淘汰的过期条目",
+                    "type": "integer"
                 }
             }
         },
-        "v1.AccountRegisterRequest": {
+        "v1.GreetHelloResponse": {
             "type": "object",
             "properties": {
-                "nickname": {
-                    "type": "string"
-                },
-                "password": {
+                "msg": {
                     "type": "string"
-                },
-                "phone": {
+                }
+            }
+        },
+        "v1.ImpersonateRequest": {
+            "type": "object",
+            "properties": {
+                "device": {
+                    "description": "设备名称，可选",
                     "type": "string"
                 },
-                "username": {
+                "reason": {
+                    "description": "模拟登录的原因，记录在审计日志中",
                     "type": "string"
                 }
             }
         },
-        "v1.AccountRegisterResponse": {
+        "v1.ImpersonateResponse": {
             "type": "object",
             "properties": {
                 "token": {
-                    "type": "string"
+                    "description": "仅返回访问令牌，不可刷新，过期后需重新模拟登录",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.AccountTokenInfo"
+                        }
+                    ]
                 },
                 "user": {
                     "$ref": "#/definitions/v1.UserInfo"
                 }
             }
         },
-        "v1.AccountUpdateProfileRequest": {
+        "v1.PermissionCreateRequest": {
             "type": "object",
             "properties": {
-                "nickname": {
+                "desc": {
                     "type": "string"
-                }
-            }
-        },
-        "v1.GreetHelloResponse": {
-            "type": "object",
-            "properties": {
-                "msg": {
+                },
+                "key": {
                     "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentID": {
+                    "type": "integer"
                 }
             }
         },
-        "v1.PermissionCreateRequest": {
+        "v1.PermissionDetailResponse": {
             "type": "object",
             "properties": {
                 "desc": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "integer"
+                },
                 "key": {
                     "type": "string"
                 },
@@ -2812,7 +5716,7 @@ const docTemplate = `{
                 }
             }
         },
-        "v1.PermissionDetailResponse": {
+        "v1.PermissionInfo": {
             "type": "object",
             "properties": {
                 "desc": {
@@ -2832,9 +5736,47 @@ const docTemplate = `{
                 }
             }
         },
-        "v1.PermissionInfo": {
+        "v1.PermissionListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PermissionInfo"
+                    }
+                },
+                "nextCursor": {
+                    "description": "下一页的游标，最后一页为空",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "总条数",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.PermissionMoveRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "parentID": {
+                    "description": "新的父级权限 id，为 0 时移动至顶级",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.PermissionTreeNode": {
             "type": "object",
             "properties": {
+                "children": {
+                    "description": "子级权限",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PermissionTreeNode"
+                    }
+                },
                 "desc": {
                     "type": "string"
                 },
@@ -2928,6 +5870,25 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.ProductListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.ProductInfo"
+                    }
+                },
+                "nextCursor": {
+                    "description": "下一页的游标，最后一页为空",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "总条数",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.ProductUpdateRequest": {
             "type": "object",
             "properties": {
@@ -2948,6 +5909,17 @@ const docTemplate = `{
         "v1.RoleCreateRequest": {
             "type": "object",
             "properties": {
+                "dataScope": {
+                    "description": "数据范围：all 全部，own 本人，department 本部门，custom 自定义部门，默认 all",
+                    "type": "string"
+                },
+                "dataScopeDepartments": {
+                    "description": "自定义数据范围的部门 id，数据范围为 custom 时必填",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "name": {
                     "type": "string"
                 }
@@ -2956,6 +5928,17 @@ const docTemplate = `{
         "v1.RoleDetailResponse": {
             "type": "object",
             "properties": {
+                "dataScope": {
+                    "description": "数据范围：all 全部，own 本人，department 本部门，custom 自定义部门",
+                    "type": "string"
+                },
+                "dataScopeDepartments": {
+                    "description": "自定义数据范围的部门 id",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -2975,6 +5958,17 @@ const docTemplate = `{
         "v1.RoleGrantPermissionsRequest": {
             "type": "object",
             "properties": {
+                "cascade": {
+                    "description": "是否同时授予权限的所有子级权限，子级权限沿用父级权限的生效条件",
+                    "type": "boolean"
+                },
+                "conditions": {
+                    "description": "权限的生效条件，键为权限 id，如 \"time=09:00-18:00 weekday=mon|tue|wed|thu|fri ip=10.0.0.0/8 attr.method=GET\"",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "permissions": {
                     "type": "array",
                     "items": {
@@ -2989,6 +5983,17 @@ const docTemplate = `{
         "v1.RoleInfo": {
             "type": "object",
             "properties": {
+                "dataScope": {
+                    "description": "数据范围：all 全部，own 本人，department 本部门，custom 自定义部门",
+                    "type": "string"
+                },
+                "dataScopeDepartments": {
+                    "description": "自定义数据范围的部门 id",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -2997,9 +6002,54 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.RoleListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.RoleInfo"
+                    }
+                },
+                "nextCursor": {
+                    "description": "下一页的游标，最后一页为空",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "总条数",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.RoleSetParentsRequest": {
+            "type": "object",
+            "properties": {
+                "parents": {
+                    "description": "父级角色，为空时移除所有父级角色",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "role": {
+                    "type": "integer"
+                }
+            }
+        },
         "v1.RoleUpdateRequest": {
             "type": "object",
             "properties": {
+                "dataScope": {
+                    "description": "数据范围：all 全部，own 本人，department 本部门，custom 自定义部门，默认 all",
+                    "type": "string"
+                },
+                "dataScopeDepartments": {
+                    "description": "自定义数据范围的部门 id，数据范围为 custom 时必填",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -3011,7 +6061,15 @@ const docTemplate = `{
         "v1.UserAssignRoleRequest": {
             "type": "object",
             "properties": {
+                "grants": {
+                    "description": "限时授予的角色",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.UserRoleGrant"
+                    }
+                },
                 "roles": {
+                    "description": "永久授予的角色",
                     "type": "array",
                     "items": {
                         "type": "integer"
@@ -3025,6 +6083,14 @@ const docTemplate = `{
         "v1.UserCreateRequest": {
             "type": "object",
             "properties": {
+                "departmentID": {
+                    "description": "所属部门 id，可选",
+                    "type": "integer"
+                },
+                "email": {
+                    "description": "邮箱，可选",
+                    "type": "string"
+                },
                 "nickname": {
                     "type": "string"
                 },
@@ -3034,6 +6100,10 @@ const docTemplate = `{
                 "phone": {
                     "type": "string"
                 },
+                "serviceAccount": {
+                    "description": "是否为服务账号，服务账号仅可通过 API 密钥访问，无需密码和手机号",
+                    "type": "boolean"
+                },
                 "username": {
                     "type": "string"
                 }
@@ -3042,6 +6112,16 @@ const docTemplate = `{
         "v1.UserDetailResponse": {
             "type": "object",
             "properties": {
+                "departmentID": {
+                    "description": "所属部门 id",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "emailVerified": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -3051,6 +6131,10 @@ const docTemplate = `{
                 "phone": {
                     "type": "string"
                 },
+                "serviceAccount": {
+                    "description": "是否为服务账号",
+                    "type": "boolean"
+                },
                 "username": {
                     "type": "string"
                 }
@@ -3067,6 +6151,16 @@ const docTemplate = `{
         "v1.UserInfo": {
             "type": "object",
             "properties": {
+                "departmentID": {
+                    "description": "所属部门 id",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "emailVerified": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -3076,14 +6170,91 @@ const docTemplate = `{
                 "phone": {
                     "type": "string"
                 },
+                "serviceAccount": {
+                    "description": "是否为服务账号",
+                    "type": "boolean"
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "v1.UserListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.UserInfo"
+                    }
+                },
+                "nextCursor": {
+                    "description": "下一页的游标，最后一页为空",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "总条数",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.UserRoleGrant": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "description": "过期时间，unix 时间戳，0 为永不过期",
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "integer"
+                },
+                "startsAt": {
+                    "description": "生效时间，unix 时间戳，0 为立即生效",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.UserRoleInfo": {
+            "type": "object",
+            "properties": {
+                "dataScope": {
+                    "description": "数据范围：all 全部，own 本人，department 本部门，custom 自定义部门",
+                    "type": "string"
+                },
+                "dataScopeDepartments": {
+                    "description": "自定义数据范围的部门 id",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "expiresAt": {
+                    "description": "过期时间，0 为永不过期",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "description": "生效时间，0 为立即生效",
+                    "type": "integer"
+                }
+            }
+        },
         "v1.UserUpdateRequest": {
             "type": "object",
             "properties": {
+                "departmentID": {
+                    "description": "所属部门 id，可选",
+                    "type": "integer"
+                },
+                "email": {
+                    "description": "邮箱，可选，修改后需重新验证",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
@@ -3091,9 +6262,11 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "password": {
+                    "description": "密码，可选，为空时不修改，服务账号不能设置密码",
                     "type": "string"
                 },
                 "phone": {
+                    "description": "服务账号可选",
                     "type": "string"
                 },
                 "username": {
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/facade/server/http/middleware"
 	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
 )
@@ -18,11 +19,39 @@ func NewAccountHandler(controller *controller.AccountController) *AccountHandler
 	return &AccountHandler{controller}
 }
 
-type AccountRegisterRequest UserCreateRequest
+type AccountRegisterRequest struct {
+	UserCreateRequest
+	Device string `json:"device"` // 设备名称，可选
+}
+
+type AccountTokenInfo struct {
+	AccessToken           string `json:"accessToken"`
+	AccessTokenExpiresAt  int64  `json:"accessTokenExpiresAt"`
+	RefreshToken          string `json:"refreshToken"`
+	RefreshTokenExpiresAt int64  `json:"refreshTokenExpiresAt"`
+}
+
+// newSessionClient the device is reported by the client, the others are taken from the request
+func newSessionClient(ctx echo.Context, device string) domain.SessionClient {
+	return domain.SessionClient{
+		Device:    device,
+		IP:        ctx.RealIP(),
+		UserAgent: ctx.Request().UserAgent(),
+	}
+}
+
+func newAccountTokenInfo(token *domain.AccountToken) *AccountTokenInfo {
+	return &AccountTokenInfo{
+		AccessToken:           token.AccessToken,
+		AccessTokenExpiresAt:  token.AccessTokenExpiresAt.Unix(),
+		RefreshToken:          token.RefreshToken,
+		RefreshTokenExpiresAt: token.RefreshTokenExpiresAt.Unix(),
+	}
+}
 
 type AccountRegisterResponse struct {
-	User  *UserInfo `json:"user"`
-	Token string    `json:"token"`
+	User  *UserInfo         `json:"user"`
+	Token *AccountTokenInfo `json:"token"`
 }
 
 // Register 注册
@@ -54,7 +83,9 @@ func (h *AccountHandler) Register(ctx echo.Context) error {
 			Password: req.Password,
 			Nickname: req.Nickname,
 			Phone:    req.Phone,
+			Email:    req.Email,
 		},
+		Client: newSessionClient(ctx, req.Device),
 	}
 	ret, err := h.controller.Register(ctx.Request().Context(), r)
 	if err != nil {
@@ -63,12 +94,15 @@ func (h *AccountHandler) Register(ctx echo.Context) error {
 
 	data := AccountRegisterResponse{
 		User: &UserInfo{
-			ID:       ret.User.ID,
-			Username: ret.User.Username,
-			Nickname: ret.User.Nickname,
-			Phone:    ret.User.Phone,
+			ID:             ret.User.ID,
+			Username:       ret.User.Username,
+			Nickname:       ret.User.Nickname,
+			Phone:          ret.User.Phone,
+			Email:          ret.User.Email,
+			EmailVerified:  ret.User.EmailVerified,
+			ServiceAccount: ret.User.ServiceAccount,
 		},
-		Token: ret.Token,
+		Token: newAccountTokenInfo(ret.Token),
 	}
 
 	return ctx.JSON(http.StatusOK, data)
@@ -77,18 +111,53 @@ func (h *AccountHandler) Register(ctx echo.Context) error {
 type AccountLoginRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Device   string `json:"device"` // 设备名称，可选
+}
+
+type AccountLoginChallengeInfo struct {
+	ChallengeToken string `json:"challengeToken"`
+	ExpiresAt      int64  `json:"expiresAt"`
+	EnrollRequired bool   `json:"enrollRequired"` // 是否需要先绑定 TOTP
 }
 
 type AccountLoginResponse struct {
-	User  *UserInfo `json:"user"`
-	Token string    `json:"token"`
+	User          *UserInfo                  `json:"user,omitempty"`
+	Token         *AccountTokenInfo          `json:"token,omitempty"`
+	Challenge     *AccountLoginChallengeInfo `json:"challenge,omitempty"`     // 需要二次验证时返回，此时不返回令牌
+	RecoveryCodes []string                   `json:"recoveryCodes,omitempty"` // 登录时绑定 TOTP 生成的恢复码，仅返回一次
+}
+
+func newAccountLoginResponse(ret *controller.AccountLoginResponse) AccountLoginResponse {
+	if ret.Challenge != nil {
+		return AccountLoginResponse{
+			Challenge: &AccountLoginChallengeInfo{
+				ChallengeToken: ret.Challenge.Token,
+				ExpiresAt:      ret.Challenge.ExpiresAt.Unix(),
+				EnrollRequired: ret.Challenge.EnrollRequired,
+			},
+		}
+	}
+
+	return AccountLoginResponse{
+		User: &UserInfo{
+			ID:             ret.User.ID,
+			Username:       ret.User.Username,
+			Nickname:       ret.User.Nickname,
+			Phone:          ret.User.Phone,
+			Email:          ret.User.Email,
+			EmailVerified:  ret.User.EmailVerified,
+			ServiceAccount: ret.User.ServiceAccount,
+		},
+		Token:         newAccountTokenInfo(ret.Token),
+		RecoveryCodes: ret.RecoveryCodes,
+	}
 }
 
 // Login 登录
 //
 //	@Router			/v1/login [post]
 //	@Summary		登录
-//	@Description	登录
+//	@Description	登录，需要二次验证时返回 challenge，使用 /v1/login/totp 完成登录
 //	@Tags			账号
 //	@Accept			json
 //	@Produce		json
@@ -110,23 +179,55 @@ func (h *AccountHandler) Login(ctx echo.Context) error {
 	r := controller.AccountLoginRequest{
 		Username: req.Username,
 		Password: req.Password,
+		Client:   newSessionClient(ctx, req.Device),
 	}
 	ret, err := h.controller.Login(ctx.Request().Context(), r)
 	if err != nil {
 		return err
 	}
 
-	data := AccountLoginResponse{
-		User: &UserInfo{
-			ID:       ret.User.ID,
-			Username: ret.User.Username,
-			Nickname: ret.User.Nickname,
-			Phone:    ret.User.Phone,
-		},
-		Token: ret.Token,
+	return ctx.JSON(http.StatusOK, newAccountLoginResponse(ret))
+}
+
+type AccountRefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+	Device       string `json:"device"` // 设备名称，可选
+}
+
+type AccountRefreshTokenResponse = AccountTokenInfo
+
+// RefreshToken 刷新令牌
+//
+//	@Router			/v1/token/refresh [post]
+//	@Summary		刷新令牌
+//	@Description	使用刷新令牌换取新的令牌对，刷新令牌只能使用一次，重复使用将吊销该令牌族
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountRefreshTokenRequest							true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=AccountRefreshTokenResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError									"服务器出错"
+//	@Failure		400		{object}	example.ClientError									"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized								"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied							"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound							"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest								"请求过于频繁"
+func (h *AccountHandler) RefreshToken(ctx echo.Context) error {
+	req := new(AccountRefreshTokenRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
 	}
 
-	return ctx.JSON(http.StatusOK, data)
+	r := controller.AccountRefreshTokenRequest{
+		RefreshToken: req.RefreshToken,
+		Client:       newSessionClient(ctx, req.Device),
+	}
+	ret, err := h.controller.RefreshToken(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, newAccountTokenInfo(ret))
 }
 
 // Logout 登出
@@ -146,9 +247,105 @@ func (h *AccountHandler) Login(ctx echo.Context) error {
 //	@Failure		429	{object}	example.TooManyRequest		"请求过于频繁"
 //	@Security		Authorization
 func (h *AccountHandler) Logout(ctx echo.Context) error {
-	user := ctx.(*middleware.Context).GetUser()
+	c := ctx.(*middleware.Context)
 
-	if err := h.controller.Logout(ctx.Request().Context(), user.ID); err != nil {
+	if err := h.controller.Logout(ctx.Request().Context(), c.GetUser().ID, c.GetToken()); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type AccountSessionInfo struct {
+	ID         string `json:"id"`
+	Device     string `json:"device"`
+	IP         string `json:"ip"`
+	UserAgent  string `json:"userAgent"`
+	CreatedAt  int64  `json:"createdAt"`
+	LastSeenAt int64  `json:"lastSeenAt"`
+	ExpiresAt  int64  `json:"expiresAt"`
+	Current    bool   `json:"current"` // 是否为当前会话
+}
+
+type AccountListSessionsResponse []*AccountSessionInfo
+
+// ListSessions 获取账号会话列表
+//
+//	@Router			/v1/account/sessions [get]
+//	@Summary		获取账号会话列表
+//	@Description	获取账号在各个设备上的登录会话
+//	@Tags			账号
+//	@Accept			plain
+//	@Produce		json
+//	@Success		200	{object}	example.Success{data=AccountListSessionsResponse}	"成功响应"
+//	@Failure		500	{object}	example.ServerError									"服务器出错"
+//	@Failure		400	{object}	example.ClientError									"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized								"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied							"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound							"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest								"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) ListSessions(ctx echo.Context) error {
+	c := ctx.(*middleware.Context)
+
+	r := controller.AccountSessionListRequest{
+		UserID: c.GetUser().ID,
+		Token:  c.GetToken(),
+	}
+	ret, err := h.controller.ListSessions(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := make(AccountListSessionsResponse, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		data = append(data, &AccountSessionInfo{
+			ID:         item.ID,
+			Device:     item.Device,
+			IP:         item.IP,
+			UserAgent:  item.UserAgent,
+			CreatedAt:  item.CreatedAt.Unix(),
+			LastSeenAt: item.LastSeenAt.Unix(),
+			ExpiresAt:  item.ExpiresAt.Unix(),
+			Current:    item.ID == ret.Current,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type AccountRevokeSessionRequest struct {
+	ID string `param:"id"`
+}
+
+// RevokeSession 注销账号会话
+//
+//	@Router			/v1/account/sessions/{id} [delete]
+//	@Summary		注销账号会话
+//	@Description	注销指定设备上的登录会话，该会话的令牌将立即失效
+//	@Tags			账号
+//	@Accept			plain
+//	@Produce		json
+//	@Param			id	path		string						true	"会话 id"
+//	@Success		200	{object}	example.Success				"成功响应"
+//	@Failure		500	{object}	example.ServerError			"服务器出错"
+//	@Failure		400	{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) RevokeSession(ctx echo.Context) error {
+	req := new(AccountRevokeSessionRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountSessionRevokeRequest{
+		UserID: ctx.(*middleware.Context).GetUser().ID,
+		ID:     req.ID,
+	}
+	if err := h.controller.RevokeSession(ctx.Request().Context(), r); err != nil {
 		return err
 	}
 
@@ -157,6 +354,7 @@ func (h *AccountHandler) Logout(ctx echo.Context) error {
 
 type AccountUpdateProfileRequest struct {
 	Nickname string `json:"nickname"`
+	Email    string `json:"email"` // 邮箱，可选，修改后会发送验证邮件
 }
 
 // UpdateProfile 更新账号信息
@@ -187,6 +385,7 @@ func (h *AccountHandler) UpdateProfile(ctx echo.Context) error {
 	r := controller.AccountUpdateProfileRequest{
 		ID:       user.ID,
 		Nickname: req.Nickname,
+		Email:    req.Email,
 	}
 	if err := h.controller.UpdateProfile(ctx.Request().Context(), r); err != nil {
 		return err
@@ -222,10 +421,13 @@ func (h *AccountHandler) GetProfile(ctx echo.Context) error {
 	}
 
 	data := &AccountProfileResponse{
-		ID:       ret.ID,
-		Username: ret.Username,
-		Nickname: ret.Nickname,
-		Phone:    ret.Phone,
+		ID:             ret.ID,
+		Username:       ret.Username,
+		Nickname:       ret.Nickname,
+		Phone:          ret.Phone,
+		Email:          ret.Email,
+		EmailVerified:  ret.EmailVerified,
+		ServiceAccount: ret.ServiceAccount,
 	}
 
 	return ctx.JSON(http.StatusOK, data)
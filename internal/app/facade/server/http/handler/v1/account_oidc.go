@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"net/http"
+	"path"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/controller"
+	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
+)
+
+// oidcBindingCookie the cookie that binds the state to the browser that starts the login
+const oidcBindingCookie = "oidc_binding"
+
+type AccountOIDCAuthorizeRequest struct {
+	Provider string `param:"provider"`
+}
+
+type AccountOIDCAuthorizeResponse struct {
+	AuthorizationURL string `json:"authorizationURL"` // 身份提供方的登录地址，前端跳转至该地址
+}
+
+// OIDCAuthorize 身份提供方登录授权
+//
+//	@Router			/v1/oidc/{provider}/authorize [get]
+//	@Summary		身份提供方登录授权
+//	@Description	获取身份提供方的登录地址，同时设置 HttpOnly 的 Cookie 将 state 绑定至当前浏览器，登录后身份提供方携带 code 和 state 重定向至配置的 redirectURL，再使用 /v1/oidc/{provider}/callback 完成登录
+//	@Tags			账号
+//	@Accept			plain
+//	@Produce		json
+//	@Param			provider	path		string												true	"身份提供方名称"	format(string)
+//	@Success		200			{object}	example.Success{data=AccountOIDCAuthorizeResponse}	"成功响应"
+//	@Failure		500			{object}	example.ServerError									"服务器出错"
+//	@Failure		400			{object}	example.ClientError									"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401			{object}	example.Unauthorized								"登陆失效"
+//	@Failure		403			{object}	example.PermissionDenied							"没有权限"
+//	@Failure		404			{object}	example.ResourceNotFound							"资源不存在"
+//	@Failure		429			{object}	example.TooManyRequest								"请求过于频繁"
+func (h *AccountHandler) OIDCAuthorize(ctx echo.Context) error {
+	req := new(AccountOIDCAuthorizeRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	ret, err := h.controller.OIDCAuthorize(ctx.Request().Context(), req.Provider)
+	if err != nil {
+		return err
+	}
+
+	// the cookie is only sent back to the sibling callback
+	ctx.SetCookie(&http.Cookie{
+		Name:     oidcBindingCookie,
+		Value:    ret.Binding,
+		Path:     path.Join(path.Dir(ctx.Request().URL.Path), "callback"),
+		Expires:  ret.ExpiresAt,
+		Secure:   ctx.Scheme() == "https",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return ctx.JSON(http.StatusOK, AccountOIDCAuthorizeResponse{AuthorizationURL: ret.AuthorizationURL})
+}
+
+type AccountOIDCLoginRequest struct {
+	Provider string `json:"-" param:"provider"`
+	State    string `json:"state"`  // 身份提供方重定向携带的 state
+	Code     string `json:"code"`   // 身份提供方重定向携带的 code
+	Device   string `json:"device"` // 设备名称，可选
+}
+
+// OIDCLogin 身份提供方登录
+//
+//	@Router			/v1/oidc/{provider}/callback [post]
+//	@Summary		身份提供方登录
+//	@Description	使用身份提供方重定向携带的 code 和 state 登录，须由发起授权的浏览器携带授权时设置的 Cookie 请求，首次登录时自动创建用户，需要二次验证时返回 challenge，使用 /v1/login/totp 完成登录
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			provider	path		string										true	"身份提供方名称"	format(string)
+//	@Param			data		body		AccountOIDCLoginRequest						true	"请求体"		format(string)
+//	@Success		200			{object}	example.Success{data=AccountLoginResponse}	"成功响应"
+//	@Failure		500			{object}	example.ServerError							"服务器出错"
+//	@Failure		400			{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401			{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403			{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404			{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429			{object}	example.TooManyRequest						"请求过于频繁"
+func (h *AccountHandler) OIDCLogin(ctx echo.Context) error {
+	req := new(AccountOIDCLoginRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	// the binding is used once, whatever the result is
+	var binding string
+	if cookie, err := ctx.Cookie(oidcBindingCookie); err == nil {
+		binding = cookie.Value
+	}
+	ctx.SetCookie(&http.Cookie{
+		Name:     oidcBindingCookie,
+		Path:     ctx.Request().URL.Path,
+		MaxAge:   -1,
+		Secure:   ctx.Scheme() == "https",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	r := controller.AccountOIDCLoginRequest{
+		Provider: req.Provider,
+		State:    req.State,
+		Binding:  binding,
+		Code:     req.Code,
+		Client:   newSessionClient(ctx, req.Device),
+	}
+	ret, err := h.controller.OIDCLogin(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, newAccountLoginResponse(ret))
+}
@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/facade/server/http/middleware"
+	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
+)
+
+type AccountForgotPasswordRequest struct {
+	Email string `json:"email"` // 已验证的邮箱
+}
+
+// ForgotPassword 忘记密码
+//
+//	@Router			/v1/password/forgot [post]
+//	@Summary		忘记密码
+//	@Description	向已验证的邮箱发送重置密码邮件，无论邮箱是否存在均返回成功
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountForgotPasswordRequest	true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success					"成功响应"
+//	@Failure		500		{object}	example.ServerError				"服务器出错"
+//	@Failure		400		{object}	example.ClientError				"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized			"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied		"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound		"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest			"请求过于频繁"
+func (h *AccountHandler) ForgotPassword(ctx echo.Context) error {
+	req := new(AccountForgotPasswordRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountForgotPasswordRequest{
+		Email: req.Email,
+	}
+	if err := h.controller.ForgotPassword(ctx.Request().Context(), r); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type AccountResetPasswordRequest struct {
+	Token    string `json:"token"`    // 重置密码邮件中的 token
+	Password string `json:"password"` // 新密码
+}
+
+// ResetPassword 重置密码
+//
+//	@Router			/v1/password/reset [post]
+//	@Summary		重置密码
+//	@Description	使用重置密码邮件中的 token 重置密码，token 仅可使用一次，重置后所有设备的会话失效
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountResetPasswordRequest	true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success				"成功响应"
+//	@Failure		500		{object}	example.ServerError			"服务器出错"
+//	@Failure		400		{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest		"请求过于频繁"
+func (h *AccountHandler) ResetPassword(ctx echo.Context) error {
+	req := new(AccountResetPasswordRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountResetPasswordRequest{
+		Token:    req.Token,
+		Password: req.Password,
+	}
+	if err := h.controller.ResetPassword(ctx.Request().Context(), r); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type AccountVerifyEmailRequest struct {
+	Token string `json:"token"` // 验证邮件中的 token
+}
+
+type AccountVerifyEmailResponse = UserInfo
+
+// VerifyEmail 验证邮箱
+//
+//	@Router			/v1/email/verify [post]
+//	@Summary		验证邮箱
+//	@Description	使用验证邮件中的 token 验证邮箱，token 仅可使用一次
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountVerifyEmailRequest							true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=AccountVerifyEmailResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError									"服务器出错"
+//	@Failure		400		{object}	example.ClientError									"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized								"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied							"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound							"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest								"请求过于频繁"
+func (h *AccountHandler) VerifyEmail(ctx echo.Context) error {
+	req := new(AccountVerifyEmailRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountVerifyEmailRequest{
+		Token: req.Token,
+	}
+	ret, err := h.controller.VerifyEmail(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := &AccountVerifyEmailResponse{
+		ID:             ret.ID,
+		Username:       ret.Username,
+		Nickname:       ret.Nickname,
+		Phone:          ret.Phone,
+		Email:          ret.Email,
+		EmailVerified:  ret.EmailVerified,
+		ServiceAccount: ret.ServiceAccount,
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+// SendEmailVerification 发送邮箱验证邮件
+//
+//	@Router			/v1/account/email/verification [post]
+//	@Summary		发送邮箱验证邮件
+//	@Description	重新发送邮箱验证邮件，之前发送的验证邮件失效
+//	@Tags			账号
+//	@Accept			plain
+//	@Produce		json
+//	@Success		200	{object}	example.Success				"成功响应"
+//	@Failure		500	{object}	example.ServerError			"服务器出错"
+//	@Failure		400	{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) SendEmailVerification(ctx echo.Context) error {
+	user := ctx.(*middleware.Context).GetUser()
+
+	if err := h.controller.SendEmailVerification(ctx.Request().Context(), user.ID); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
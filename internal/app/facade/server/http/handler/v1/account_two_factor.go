@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/facade/server/http/middleware"
+	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
+)
+
+type AccountLoginTOTPRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+	Code           string `json:"code"`         // TOTP 验证码
+	RecoveryCode   string `json:"recoveryCode"` // 恢复码，未提供验证码时使用
+}
+
+// LoginTOTP 二次验证登录
+//
+//	@Router			/v1/login/totp [post]
+//	@Summary		二次验证登录
+//	@Description	使用 TOTP 验证码或恢复码完成登录，需要绑定 TOTP 时验证码将同时激活 TOTP 并返回恢复码
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountLoginTOTPRequest						true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=AccountLoginResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError							"服务器出错"
+//	@Failure		400		{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest						"请求过于频繁"
+func (h *AccountHandler) LoginTOTP(ctx echo.Context) error {
+	req := new(AccountLoginTOTPRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountLoginTOTPRequest{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+		RecoveryCode:   req.RecoveryCode,
+	}
+	ret, err := h.controller.LoginTOTP(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, newAccountLoginResponse(ret))
+}
+
+type AccountLoginTOTPEnrollRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+}
+
+type AccountTOTPEnrollmentResponse struct {
+	Secret string `json:"secret"` // base32 编码的密钥
+	URI    string `json:"uri"`    // otpauth URI，可生成二维码供验证器扫描
+}
+
+// LoginTOTPEnroll 登录时绑定 TOTP
+//
+//	@Router			/v1/login/totp/enroll [post]
+//	@Summary		登录时绑定 TOTP
+//	@Description	角色要求二次验证但尚未绑定 TOTP 时，使用登录返回的 challenge 绑定 TOTP
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountLoginTOTPEnrollRequest						true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=AccountTOTPEnrollmentResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError									"服务器出错"
+//	@Failure		400		{object}	example.ClientError									"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized								"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied							"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound							"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest								"请求过于频繁"
+func (h *AccountHandler) LoginTOTPEnroll(ctx echo.Context) error {
+	req := new(AccountLoginTOTPEnrollRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountLoginTOTPEnrollRequest{
+		ChallengeToken: req.ChallengeToken,
+	}
+	ret, err := h.controller.LoginTOTPEnroll(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := AccountTOTPEnrollmentResponse{
+		Secret: ret.Secret,
+		URI:    ret.URI,
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+// EnrollTOTP 绑定 TOTP
+//
+//	@Router			/v1/account/totp [post]
+//	@Summary		绑定 TOTP
+//	@Description	生成 TOTP 密钥，验证码激活后生效
+//	@Tags			账号
+//	@Accept			plain
+//	@Produce		json
+//	@Success		200	{object}	example.Success{data=AccountTOTPEnrollmentResponse}	"成功响应"
+//	@Failure		500	{object}	example.ServerError									"服务器出错"
+//	@Failure		400	{object}	example.ClientError									"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized								"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied							"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound							"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest								"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) EnrollTOTP(ctx echo.Context) error {
+	user := ctx.(*middleware.Context).GetUser()
+
+	ret, err := h.controller.EnrollTOTP(ctx.Request().Context(), user.ID)
+	if err != nil {
+		return err
+	}
+
+	data := AccountTOTPEnrollmentResponse{
+		Secret: ret.Secret,
+		URI:    ret.URI,
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type AccountTOTPRequest struct {
+	Code         string `json:"code"`         // TOTP 验证码
+	RecoveryCode string `json:"recoveryCode"` // 恢复码，仅关闭 TOTP 时可用
+}
+
+type AccountTOTPRecoveryCodesResponse []string
+
+// ActivateTOTP 激活 TOTP
+//
+//	@Router			/v1/account/totp/activate [post]
+//	@Summary		激活 TOTP
+//	@Description	验证 TOTP 验证码并激活，返回的恢复码仅展示一次
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountTOTPRequest										true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=AccountTOTPRecoveryCodesResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError										"服务器出错"
+//	@Failure		400		{object}	example.ClientError										"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized									"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied								"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound								"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest									"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) ActivateTOTP(ctx echo.Context) error {
+	req := new(AccountTOTPRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountTOTPRequest{
+		ID:   ctx.(*middleware.Context).GetUser().ID,
+		Code: req.Code,
+	}
+	ret, err := h.controller.ActivateTOTP(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, AccountTOTPRecoveryCodesResponse(ret))
+}
+
+// DisableTOTP 关闭 TOTP
+//
+//	@Router			/v1/account/totp [delete]
+//	@Summary		关闭 TOTP
+//	@Description	使用 TOTP 验证码或恢复码关闭 TOTP，角色要求二次验证时不可关闭
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountTOTPRequest			true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success				"成功响应"
+//	@Failure		500		{object}	example.ServerError			"服务器出错"
+//	@Failure		400		{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) DisableTOTP(ctx echo.Context) error {
+	req := new(AccountTOTPRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountTOTPRequest{
+		ID:           ctx.(*middleware.Context).GetUser().ID,
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+	}
+	if err := h.controller.DisableTOTP(ctx.Request().Context(), r); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码
+//
+//	@Router			/v1/account/totp/recovery-codes [post]
+//	@Summary		重新生成恢复码
+//	@Description	验证 TOTP 验证码后重新生成恢复码，原有恢复码失效，返回的恢复码仅展示一次
+//	@Tags			账号
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		AccountTOTPRequest										true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=AccountTOTPRecoveryCodesResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError										"服务器出错"
+//	@Failure		400		{object}	example.ClientError										"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized									"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied								"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound								"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest									"请求过于频繁"
+//	@Security		Authorization
+func (h *AccountHandler) RegenerateRecoveryCodes(ctx echo.Context) error {
+	req := new(AccountTOTPRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AccountTOTPRequest{
+		ID:   ctx.(*middleware.Context).GetUser().ID,
+		Code: req.Code,
+	}
+	ret, err := h.controller.RegenerateRecoveryCodes(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, AccountTOTPRecoveryCodesResponse(ret))
+}
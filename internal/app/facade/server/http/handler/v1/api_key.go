@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/controller"
+	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
+)
+
+type APIKeyHandler struct {
+	controller *controller.APIKeyController
+}
+
+func NewAPIKeyHandler(controller *controller.APIKeyController) *APIKeyHandler {
+	return &APIKeyHandler{controller}
+}
+
+type APIKeyInfo struct {
+	ID         int64    `json:"id"`
+	UserID     int64    `json:"userID"`
+	Name       string   `json:"name"`
+	Prefix     string   `json:"prefix"`     // 密钥前缀，用于识别密钥
+	Scopes     []string `json:"scopes"`     // 可访问的权限标识，为空时不限制
+	ExpiresAt  int64    `json:"expiresAt"`  // 过期时间，为 0 时永不过期
+	LastUsedAt int64    `json:"lastUsedAt"` // 最后使用时间
+	CreatedAt  int64    `json:"createdAt"`
+}
+
+type APIKeyListRequest struct {
+	UserID int64 `json:"userID" query:"userID"`
+}
+
+type APIKeyListResponse []*APIKeyInfo
+
+// List API 密钥列表
+//
+//	@Router			/v1/api-keys [get]
+//	@Summary		API 密钥列表
+//	@Description	服务账号的 API 密钥列表
+//	@Tags			API 密钥
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			userID	query		integer										true	"服务账号 id"	format(uint)	minimum(1)
+//	@Success		200		{object}	example.Success{data=APIKeyListResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError							"服务器出错"
+//	@Failure		400		{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest						"请求过于频繁"
+//	@Security		Authorization
+func (h *APIKeyHandler) List(ctx echo.Context) error {
+	req := new(APIKeyListRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.APIKeyListRequest{
+		UserID: req.UserID,
+	}
+	ret, err := h.controller.List(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := make(APIKeyListResponse, 0, len(ret))
+	for _, item := range ret {
+		data = append(data, &APIKeyInfo{
+			ID:         item.ID,
+			UserID:     item.UserID,
+			Name:       item.Name,
+			Prefix:     item.Prefix,
+			Scopes:     item.Scopes,
+			ExpiresAt:  item.ExpiresAt,
+			LastUsedAt: item.LastUsedAt,
+			CreatedAt:  item.CreatedAt,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type APIKeyCreateRequest struct {
+	UserID    int64    `json:"userID"`    // 服务账号 id
+	Name      string   `json:"name"`      // 密钥名称
+	Scopes    []string `json:"scopes"`    // 可访问的权限标识，如 "GET /api/v1/users"，为空时不限制
+	ExpiresAt int64    `json:"expiresAt"` // 过期时间，为 0 时永不过期
+}
+
+type APIKeyCreateResponse struct {
+	APIKeyInfo
+	Key string `json:"key"` // API 密钥，仅在创建时返回一次，通过 X-API-Key 请求头使用
+}
+
+// Create API 密钥新增
+//
+//	@Router			/v1/api-key [post]
+//	@Summary		API 密钥新增
+//	@Description	为服务账号签发 API 密钥，密钥仅在创建时返回一次
+//	@Tags			API 密钥
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		APIKeyCreateRequest							true	"密钥信息"	format(string)
+//	@Success		200		{object}	example.Success{data=APIKeyCreateResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError							"服务器出错"
+//	@Failure		400		{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest						"请求过于频繁"
+//	@Security		Authorization
+func (h *APIKeyHandler) Create(ctx echo.Context) error {
+	req := new(APIKeyCreateRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.APIKeyCreateRequest{
+		UserID:    req.UserID,
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	}
+	ret, err := h.controller.Create(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := &APIKeyCreateResponse{
+		APIKeyInfo: APIKeyInfo{
+			ID:         ret.ID,
+			UserID:     ret.UserID,
+			Name:       ret.Name,
+			Prefix:     ret.Prefix,
+			Scopes:     ret.Scopes,
+			ExpiresAt:  ret.ExpiresAt,
+			LastUsedAt: ret.LastUsedAt,
+			CreatedAt:  ret.CreatedAt,
+		},
+		Key: ret.Key,
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type APIKeyDeleteRequest struct {
+	ID int64 `param:"id"`
+}
+
+// Delete API 密钥删除
+//
+//	@Router			/v1/api-key/{id} [delete]
+//	@Summary		API 密钥删除
+//	@Description	API 密钥删除，删除后立即失效
+//	@Tags			API 密钥
+//	@Accept			plain
+//	@Produce		json
+//	@Param			id	path		integer						true	"密钥 id"	format(uint)	minimum(1)
+//	@Success		200	{object}	example.Success				"成功响应"
+//	@Failure		500	{object}	example.ServerError			"服务器出错"
+//	@Failure		400	{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *APIKeyHandler) Delete(ctx echo.Context) error {
+	req := new(APIKeyDeleteRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	if err := h.controller.Delete(ctx.Request().Context(), req.ID); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
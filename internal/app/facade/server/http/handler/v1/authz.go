@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
+	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
+)
+
+type AuthzHandler struct {
+	controller *controller.AuthzController
+}
+
+func NewAuthzHandler(controller *controller.AuthzController) *AuthzHandler {
+	return &AuthzHandler{controller}
+}
+
+type AuthzExplainRequest struct {
+	User       int64  `query:"user"`       // 用户 id
+	Permission string `query:"permission"` // 权限标识，如 GET /api/v1/users
+}
+
+type AuthzExplainResponse struct {
+	Allowed         bool            `json:"allowed"`         // 是否允许
+	SuperAdmin      bool            `json:"superAdmin"`      // 是否因超级管理员而允许
+	PermissionExist bool            `json:"permissionExist"` // 权限标识是否存在，不存在的权限总是拒绝
+	Permission      *PermissionInfo `json:"permission"`      // 权限，权限标识不存在时为 null
+	Policies        [][]string      `json:"policies"`        // 匹配的策略，授予用户或其继承的角色，格式为 [主体, 域, 权限 id]
+	Conditions      [][]string      `json:"conditions"`      // 匹配的策略的条件，条件满足时策略才生效，格式为 [主体, 域, 权限 id, 条件]
+	RolePath        []string        `json:"rolePath"`        // 从用户到授予权限的策略主体的角色路径，如 ["user_1", "role_2", "role_3"]
+}
+
+// Explain 解释授权决策
+//
+//	@Router			/v1/authz/explain [get]
+//	@Summary		解释授权决策
+//	@Description	解释用户在当前租户内被允许或拒绝访问权限的原因
+//	@Tags			权限
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			user		query		int											true	"用户 id"	format(int64)
+//	@Param			permission	query		string										true	"权限标识"	format(string)
+//	@Success		200			{object}	example.Success{data=AuthzExplainResponse}	"成功响应"
+//	@Failure		500			{object}	example.ServerError							"服务器出错"
+//	@Failure		400			{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401			{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403			{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404			{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429			{object}	example.TooManyRequest						"请求过于频繁"
+//	@Security		Authorization
+func (h *AuthzHandler) Explain(ctx echo.Context) error {
+	req := new(AuthzExplainRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AuthzExplainRequest{
+		User:       req.User,
+		Permission: req.Permission,
+	}
+	ret, err := h.controller.Explain(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := &AuthzExplainResponse{
+		Allowed:         ret.Allowed,
+		SuperAdmin:      ret.SuperAdmin,
+		PermissionExist: ret.PermissionExist,
+		Policies:        ret.Policies,
+		Conditions:      ret.Conditions,
+		RolePath:        ret.RolePath,
+	}
+	if ret.Permission != nil {
+		data.Permission = &PermissionInfo{
+			ID:       ret.Permission.ID,
+			Key:      ret.Permission.Key,
+			Name:     ret.Permission.Name,
+			Desc:     ret.Permission.Desc,
+			ParentID: ret.Permission.ParentID,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type CacheStatsInfo struct {
+	Size     int     `json:"size"`     // 缓存条目数，包含尚未淘汰的过期条目
+	Capacity int     `json:"capacity"` // 缓存容量，为 0 时缓存未启用
+	Hits     uint64  `json:"hits"`     // 命中次数
+	Misses   uint64  `json:"misses"`   // 未命中次数
+	HitRatio float64 `json:"hitRatio"` // 命中率
+}
+
+type AuthzCacheStatsResponse struct {
+	Permissions CacheStatsInfo `json:"permissions"` // 权限标识查询缓存
+	Decisions   CacheStatsInfo `json:"decisions"`   // 授权决策缓存
+}
+
+// CacheStats 授权缓存统计
+//
+//	@Router			/v1/authz/cache [get]
+//	@Summary		授权缓存统计
+//	@Description	当前实例的权限标识查询缓存与授权决策缓存的命中统计
+//	@Tags			权限
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Success		200	{object}	example.Success{data=AuthzCacheStatsResponse}	"成功响应"
+//	@Failure		500	{object}	example.ServerError								"服务器出错"
+//	@Failure		400	{object}	example.ClientError								"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized							"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied						"没有权限"
+//	@Failure		429	{object}	example.TooManyRequest							"请求过于频繁"
+//	@Security		Authorization
+func (h *AuthzHandler) CacheStats(ctx echo.Context) error {
+	ret := h.controller.CacheStats(ctx.Request().Context())
+
+	data := &AuthzCacheStatsResponse{
+		Permissions: newCacheStatsInfo(ret.Permissions),
+		Decisions:   newCacheStatsInfo(ret.Decisions),
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+func newCacheStatsInfo(s domain.CacheStats) CacheStatsInfo {
+	return CacheStatsInfo{
+		Size:     s.Size,
+		Capacity: s.Capacity,
+		Hits:     s.Hits,
+		Misses:   s.Misses,
+		HitRatio: s.HitRatio,
+	}
+}
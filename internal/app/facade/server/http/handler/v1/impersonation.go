@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/facade/server/http/middleware"
+	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
+)
+
+type ImpersonationHandler struct {
+	controller *controller.ImpersonationController
+}
+
+func NewImpersonationHandler(controller *controller.ImpersonationController) *ImpersonationHandler {
+	return &ImpersonationHandler{controller}
+}
+
+type ImpersonateRequest struct {
+	ID     int64  `json:"-" param:"id"`
+	Reason string `json:"reason"` // 模拟登录的原因，记录在审计日志中
+	Device string `json:"device"` // 设备名称，可选
+}
+
+type ImpersonateResponse struct {
+	User  *UserInfo         `json:"user"`
+	Token *AccountTokenInfo `json:"token"` // 仅返回访问令牌，不可刷新，过期后需重新模拟登录
+}
+
+// Impersonate 模拟用户登录
+//
+//	@Router			/v1/user/{id}/impersonate [post]
+//	@Summary		模拟用户登录
+//	@Description	以用户的身份访问系统，用于排查用户反馈的问题，模拟期间的所有请求都会记录在审计日志中，退出当前登录后令牌失效
+//	@Tags			用户
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		integer										true	"用户 id"	format(uint)	minimum(1)
+//	@Param			data	body		ImpersonateRequest							true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success{data=ImpersonateResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError							"服务器出错"
+//	@Failure		400		{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest						"请求过于频繁"
+//	@Security		Authorization
+func (h *ImpersonationHandler) Impersonate(ctx echo.Context) error {
+	req := new(ImpersonateRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	c := ctx.(*middleware.Context)
+	r := controller.ImpersonateRequest{
+		ActorID:    c.GetActor().ID,
+		ActorToken: c.GetToken(),
+		UserID:     req.ID,
+		Reason:     req.Reason,
+		Client:     newSessionClient(ctx, req.Device),
+	}
+	ret, err := h.controller.Impersonate(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := &ImpersonateResponse{
+		User: &UserInfo{
+			ID:             ret.User.ID,
+			Username:       ret.User.Username,
+			Nickname:       ret.User.Nickname,
+			Phone:          ret.User.Phone,
+			Email:          ret.User.Email,
+			EmailVerified:  ret.User.EmailVerified,
+			ServiceAccount: ret.User.ServiceAccount,
+		},
+		Token: &AccountTokenInfo{
+			AccessToken:          ret.Token.AccessToken,
+			AccessTokenExpiresAt: ret.Token.AccessTokenExpiresAt.Unix(),
+		},
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type AuditLogInfo struct {
+	ID        int64  `json:"id"`
+	ActorID   int64  `json:"actorID"` // 操作人 id
+	UserID    int64  `json:"userID"`  // 被模拟的用户 id
+	Action    string `json:"action"`  // 操作：impersonate 开始模拟登录，impersonated_request 模拟期间的请求
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Status    int    `json:"status"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"userAgent"`
+	Detail    string `json:"detail"` // 详情，如模拟登录的原因
+	CreatedAt int64  `json:"createdAt"`
+}
+
+type AuditLogListRequest struct {
+	ActorID int64 `json:"actorID" query:"actorID"`
+	UserID  int64 `json:"userID" query:"userID"`
+	Limit   int   `json:"limit" query:"limit"`
+}
+
+type AuditLogListResponse []*AuditLogInfo
+
+// ListAuditLogs 审计日志列表
+//
+//	@Router			/v1/audit-logs [get]
+//	@Summary		审计日志列表
+//	@Description	最近的审计日志，按时间倒序排列
+//	@Tags			审计日志
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			actorID	query		integer										false	"操作人 id"			format(uint)
+//	@Param			userID	query		integer										false	"被模拟的用户 id"			format(uint)
+//	@Param			limit	query		integer										false	"数量，默认 100，最大 1000"	format(uint)	maximum(1000)
+//	@Success		200		{object}	example.Success{data=AuditLogListResponse}	"成功响应"
+//	@Failure		500		{object}	example.ServerError							"服务器出错"
+//	@Failure		400		{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized						"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied					"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound					"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest						"请求过于频繁"
+//	@Security		Authorization
+func (h *ImpersonationHandler) ListAuditLogs(ctx echo.Context) error {
+	req := new(AuditLogListRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.AuditLogListRequest{
+		ActorID: req.ActorID,
+		UserID:  req.UserID,
+		Limit:   req.Limit,
+	}
+	ret, err := h.controller.ListAuditLogs(ctx.Request().Context(), r)
+	if err != nil {
+		return err
+	}
+
+	data := make(AuditLogListResponse, 0, len(ret))
+	for _, item := range ret {
+		data = append(data, &AuditLogInfo{
+			ID:        item.ID,
+			ActorID:   item.ActorID,
+			UserID:    item.UserID,
+			Action:    item.Action.String(),
+			Method:    item.Method,
+			Path:      item.Path,
+			Status:    item.Status,
+			IP:        item.IP,
+			UserAgent: item.UserAgent,
+			Detail:    item.Detail,
+			CreatedAt: item.CreatedAt,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
@@ -0,0 +1,26 @@
+package v1
+
+import "go-scaffold/internal/app/controller"
+
+type PageRequest struct {
+	Offset int    `json:"offset" query:"offset"` // 偏移量，设置游标时忽略
+	Limit  int    `json:"limit" query:"limit"`   // 每页条数，默认 20，最多 100
+	Cursor string `json:"cursor" query:"cursor"` // 游标，上一页返回的 nextCursor
+	Sort   string `json:"sort" query:"sort"`     // 排序字段，默认 updatedAt
+	Order  string `json:"order" query:"order"`   // 排序方向：asc 升序，desc 降序，默认 desc
+}
+
+func (r PageRequest) toControllerRequest() controller.PageRequest {
+	return controller.PageRequest{
+		Offset: r.Offset,
+		Limit:  r.Limit,
+		Cursor: r.Cursor,
+		Sort:   r.Sort,
+		Order:  r.Order,
+	}
+}
+
+type PageInfo struct {
+	Total      int    `json:"total"`      // 总条数
+	NextCursor string `json:"nextCursor"` // 下一页的游标，最后一页为空
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"go-scaffold/internal/app/controller"
+	"go-scaffold/internal/app/domain"
 	httperr "go-scaffold/internal/app/facade/server/http/pkg/errors"
 )
 
@@ -27,9 +28,13 @@ type PermissionInfo struct {
 
 type PermissionListRequest struct {
 	Keyword string `json:"keyword" query:"keyword"`
+	PageRequest
 }
 
-type PermissionListResponse []*PermissionInfo
+type PermissionListResponse struct {
+	Items []*PermissionInfo `json:"items"`
+	PageInfo
+}
 
 // List 权限列表
 //
@@ -39,7 +44,12 @@ type PermissionListResponse []*PermissionInfo
 //	@Tags			权限
 //	@Accept			x-www-form-urlencoded
 //	@Produce		json
-//	@Param			keyword	query		string											false	"查询字符串"	format(string)
+//	@Param			keyword	query		string											false	"查询字符串"													format(string)
+//	@Param			offset	query		int												false	"偏移量，设置游标时忽略"											format(int)
+//	@Param			limit	query		int												false	"每页条数，默认 20，最多 100"										format(int)
+//	@Param			cursor	query		string											false	"游标，上一页返回的 nextCursor"									format(string)
+//	@Param			sort	query		string											false	"排序字段：id, key, name, createdAt, updatedAt，默认 updatedAt"	format(string)
+//	@Param			order	query		string											false	"排序方向：asc 升序，desc 降序，默认 desc"							format(string)
 //	@Success		200		{object}	example.Success{data=PermissionListResponse}	"成功响应"
 //	@Failure		500		{object}	example.ServerError								"服务器出错"
 //	@Failure		400		{object}	example.ClientError								"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
@@ -55,16 +65,23 @@ func (h *PermissionHandler) List(ctx echo.Context) error {
 	}
 
 	r := controller.PermissionListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: req.PageRequest.toControllerRequest(),
 	}
 	ret, err := h.controller.List(ctx.Request().Context(), r)
 	if err != nil {
 		return err
 	}
 
-	data := make(PermissionListResponse, 0, len(ret))
-	for _, item := range ret {
-		data = append(data, &PermissionInfo{
+	data := &PermissionListResponse{
+		Items: make([]*PermissionInfo, 0, len(ret.Items)),
+		PageInfo: PageInfo{
+			Total:      ret.Total,
+			NextCursor: ret.NextCursor,
+		},
+	}
+	for _, item := range ret.Items {
+		data.Items = append(data.Items, &PermissionInfo{
 			ID:       item.ID,
 			Key:      item.Key,
 			Name:     item.Name,
@@ -246,3 +263,91 @@ func (h *PermissionHandler) Delete(ctx echo.Context) error {
 
 	return ctx.NoContent(http.StatusOK)
 }
+
+type PermissionTreeNode struct {
+	PermissionInfo
+	Children []*PermissionTreeNode `json:"children"` // 子级权限
+}
+
+type PermissionTreeResponse []*PermissionTreeNode
+
+func newPermissionTree(nodes []*domain.PermissionNode) []*PermissionTreeNode {
+	tree := make([]*PermissionTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		tree = append(tree, &PermissionTreeNode{
+			PermissionInfo: PermissionInfo{
+				ID:       node.ID,
+				Key:      node.Key,
+				Name:     node.Name,
+				Desc:     node.Desc,
+				ParentID: node.ParentID,
+			},
+			Children: newPermissionTree(node.Children),
+		})
+	}
+	return tree
+}
+
+// Tree 权限树
+//
+//	@Router			/v1/permissions/tree [get]
+//	@Summary		权限树
+//	@Description	按父级权限组织的权限树
+//	@Tags			权限
+//	@Accept			plain
+//	@Produce		json
+//	@Success		200	{object}	example.Success{data=PermissionTreeResponse}	"成功响应"
+//	@Failure		500	{object}	example.ServerError								"服务器出错"
+//	@Failure		400	{object}	example.ClientError								"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized							"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied						"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound						"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest							"请求过于频繁"
+//	@Security		Authorization
+func (h *PermissionHandler) Tree(ctx echo.Context) error {
+	ret, err := h.controller.Tree(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, PermissionTreeResponse(newPermissionTree(ret)))
+}
+
+type PermissionMoveRequest struct {
+	ID       int64 `json:"id"`
+	ParentID int64 `json:"parentID"` // 新的父级权限 id，为 0 时移动至顶级
+}
+
+// Move 权限移动
+//
+//	@Router			/v1/permission/move [put]
+//	@Summary		权限移动
+//	@Description	将权限及其子级权限移动至新的父级权限下，父级权限不能是自身或其子级权限
+//	@Tags			权限
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		PermissionMoveRequest		true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success				"成功响应"
+//	@Failure		500		{object}	example.ServerError			"服务器出错"
+//	@Failure		400		{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *PermissionHandler) Move(ctx echo.Context) error {
+	req := new(PermissionMoveRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.PermissionMoveRequest{
+		ID:       req.ID,
+		ParentID: req.ParentID,
+	}
+	if err := h.controller.Move(ctx.Request().Context(), r); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
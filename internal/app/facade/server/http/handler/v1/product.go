@@ -26,9 +26,13 @@ type ProductInfo struct {
 
 type ProductListRequest struct {
 	Keyword string `json:"keyword" query:"keyword"`
+	PageRequest
 }
 
-type ProductListResponse []*ProductInfo
+type ProductListResponse struct {
+	Items []*ProductInfo `json:"items"`
+	PageInfo
+}
 
 // List 产品列表
 //
@@ -38,7 +42,12 @@ type ProductListResponse []*ProductInfo
 //	@Tags			产品
 //	@Accept			x-www-form-urlencoded
 //	@Produce		json
-//	@Param			keyword	query		string										false	"查询字符串"	format(string)
+//	@Param			keyword	query		string										false	"查询字符串"														format(string)
+//	@Param			offset	query		int											false	"偏移量，设置游标时忽略"												format(int)
+//	@Param			limit	query		int											false	"每页条数，默认 20，最多 100"											format(int)
+//	@Param			cursor	query		string										false	"游标，上一页返回的 nextCursor"										format(string)
+//	@Param			sort	query		string										false	"排序字段：id, name, price, createdAt, updatedAt，默认 updatedAt"	format(string)
+//	@Param			order	query		string										false	"排序方向：asc 升序，desc 降序，默认 desc"								format(string)
 //	@Success		200		{object}	example.Success{data=ProductListResponse}	"成功响应"
 //	@Failure		500		{object}	example.ServerError							"服务器出错"
 //	@Failure		400		{object}	example.ClientError							"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
@@ -54,16 +63,23 @@ func (h *ProductHandler) List(ctx echo.Context) error {
 	}
 
 	r := controller.ProductListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: req.PageRequest.toControllerRequest(),
 	}
 	ret, err := h.controller.List(ctx.Request().Context(), r)
 	if err != nil {
 		return err
 	}
 
-	data := make(ProductListResponse, 0, len(ret))
-	for _, item := range ret {
-		data = append(data, &ProductInfo{
+	data := &ProductListResponse{
+		Items: make([]*ProductInfo, 0, len(ret.Items)),
+		PageInfo: PageInfo{
+			Total:      ret.Total,
+			NextCursor: ret.NextCursor,
+		},
+	}
+	for _, item := range ret.Items {
+		data.Items = append(data.Items, &ProductInfo{
 			ID:    item.ID,
 			Name:  item.Name,
 			Desc:  item.Desc,
@@ -18,15 +18,21 @@ func NewRoleHandler(controller *controller.RoleController) *RoleHandler {
 }
 
 type RoleInfo struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID                   int64   `json:"id"`
+	Name                 string  `json:"name"`
+	DataScope            string  `json:"dataScope"`            // 数据范围：all 全部，own 本人，department 本部门，custom 自定义部门
+	DataScopeDepartments []int64 `json:"dataScopeDepartments"` // 自定义数据范围的部门 id
 }
 
 type RoleListRequest struct {
 	Keyword string `json:"keyword" query:"keyword"`
+	PageRequest
 }
 
-type RoleListResponse []*RoleInfo
+type RoleListResponse struct {
+	Items []*RoleInfo `json:"items"`
+	PageInfo
+}
 
 // List 角色列表
 //
@@ -36,7 +42,12 @@ type RoleListResponse []*RoleInfo
 //	@Tags			角色
 //	@Accept			x-www-form-urlencoded
 //	@Produce		json
-//	@Param			keyword	query		string									false	"查询字符串"	format(string)
+//	@Param			keyword	query		string									false	"查询字符串"												format(string)
+//	@Param			offset	query		int										false	"偏移量，设置游标时忽略"										format(int)
+//	@Param			limit	query		int										false	"每页条数，默认 20，最多 100"									format(int)
+//	@Param			cursor	query		string									false	"游标，上一页返回的 nextCursor"								format(string)
+//	@Param			sort	query		string									false	"排序字段：id, name, createdAt, updatedAt，默认 updatedAt"	format(string)
+//	@Param			order	query		string									false	"排序方向：asc 升序，desc 降序，默认 desc"						format(string)
 //	@Success		200		{object}	example.Success{data=RoleListResponse}	"成功响应"
 //	@Failure		500		{object}	example.ServerError						"服务器出错"
 //	@Failure		400		{object}	example.ClientError						"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
@@ -52,18 +63,27 @@ func (h *RoleHandler) List(ctx echo.Context) error {
 	}
 
 	r := controller.RoleListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: req.PageRequest.toControllerRequest(),
 	}
 	ret, err := h.controller.List(ctx.Request().Context(), r)
 	if err != nil {
 		return err
 	}
 
-	data := make(RoleListResponse, 0, len(ret))
-	for _, item := range ret {
-		data = append(data, &RoleInfo{
-			ID:   item.ID,
-			Name: item.Name,
+	data := &RoleListResponse{
+		Items: make([]*RoleInfo, 0, len(ret.Items)),
+		PageInfo: PageInfo{
+			Total:      ret.Total,
+			NextCursor: ret.NextCursor,
+		},
+	}
+	for _, item := range ret.Items {
+		data.Items = append(data.Items, &RoleInfo{
+			ID:                   item.ID,
+			Name:                 item.Name,
+			DataScope:            string(item.DataScope),
+			DataScopeDepartments: item.DataScopeDepartments,
 		})
 	}
 
@@ -71,7 +91,9 @@ func (h *RoleHandler) List(ctx echo.Context) error {
 }
 
 type RoleCreateRequest struct {
-	Name string `json:"name"`
+	Name                 string  `json:"name"`
+	DataScope            string  `json:"dataScope"`            // 数据范围：all 全部，own 本人，department 本部门，custom 自定义部门，默认 all
+	DataScopeDepartments []int64 `json:"dataScopeDepartments"` // 自定义数据范围的部门 id，数据范围为 custom 时必填
 }
 
 // Create 角色创建
@@ -99,7 +121,9 @@ func (h *RoleHandler) Create(ctx echo.Context) error {
 
 	r := controller.RoleCreateRequest{
 		RoleAttr: controller.RoleAttr{
-			Name: req.Name,
+			Name:                 req.Name,
+			DataScope:            req.DataScope,
+			DataScopeDepartments: req.DataScopeDepartments,
 		},
 	}
 	if err := h.controller.Create(ctx.Request().Context(), r); err != nil {
@@ -110,8 +134,10 @@ func (h *RoleHandler) Create(ctx echo.Context) error {
 }
 
 type RoleUpdateRequest struct {
-	ID   int64  `json:"id"`
-	Name string `json:"name"`
+	ID                   int64   `json:"id"`
+	Name                 string  `json:"name"`
+	DataScope            string  `json:"dataScope"`            // 数据范围：all 全部，own 本人，department 本部门，custom 自定义部门，默认 all
+	DataScopeDepartments []int64 `json:"dataScopeDepartments"` // 自定义数据范围的部门 id，数据范围为 custom 时必填
 }
 
 // Update 角色更新
@@ -140,7 +166,9 @@ func (h *RoleHandler) Update(ctx echo.Context) error {
 	p := controller.RoleUpdateRequest{
 		ID: req.ID,
 		RoleAttr: controller.RoleAttr{
-			Name: req.Name,
+			Name:                 req.Name,
+			DataScope:            req.DataScope,
+			DataScopeDepartments: req.DataScopeDepartments,
 		},
 	}
 	if err := h.controller.Update(ctx.Request().Context(), p); err != nil {
@@ -185,8 +213,10 @@ func (h *RoleHandler) Detail(ctx echo.Context) error {
 	}
 
 	data := &RoleDetailResponse{
-		ID:   ret.ID,
-		Name: ret.Name,
+		ID:                   ret.ID,
+		Name:                 ret.Name,
+		DataScope:            string(ret.DataScope),
+		DataScopeDepartments: ret.DataScopeDepartments,
 	}
 
 	return ctx.JSON(http.StatusOK, data)
@@ -227,15 +257,17 @@ func (h *RoleHandler) Delete(ctx echo.Context) error {
 }
 
 type RoleGrantPermissionsRequest struct {
-	Role        int64   `json:"role"`
-	Permissions []int64 `json:"permissions"`
+	Role        int64            `json:"role"`
+	Permissions []int64          `json:"permissions"`
+	Conditions  map[int64]string `json:"conditions"` // 权限的生效条件，键为权限 id，如 "time=09:00-18:00 weekday=mon|tue|wed|thu|fri ip=10.0.0.0/8 attr.method=GET"
+	Cascade     bool             `json:"cascade"`    // 是否同时授予权限的所有子级权限，子级权限沿用父级权限的生效条件
 }
 
 // GrantPermissions 授权角色权限
 //
 //	@Router			/v1/role/permissions [post]
 //	@Summary		授权角色权限
-//	@Description	授权角色权限
+//	@Description	授权角色权限，会替换角色原有的权限，cascade 为 true 时同时授予权限的所有子级权限
 //	@Tags			角色
 //	@Accept			json
 //	@Produce		json
@@ -257,6 +289,8 @@ func (h *RoleHandler) GrantPermissions(ctx echo.Context) error {
 	r := controller.RoleGrantPermissionsRequest{
 		Role:        req.Role,
 		Permissions: req.Permissions,
+		Conditions:  req.Conditions,
+		Cascade:     req.Cascade,
 	}
 	if err := h.controller.GrantPermissions(ctx.Request().Context(), r); err != nil {
 		return err
@@ -269,13 +303,20 @@ type RoleGetPermissionsRequest struct {
 	ID int64 `query:"id"`
 }
 
-type RoleGetPermissionsResponse []*PermissionInfo
+type RolePermissionInfo struct {
+	PermissionInfo
+	OriginRoleID int64  `json:"originRoleID"` // 权限的来源角色
+	Inherited    bool   `json:"inherited"`    // 是否继承自父级角色
+	Condition    string `json:"condition"`    // 生效条件，为空时无条件生效
+}
+
+type RoleGetPermissionsResponse []*RolePermissionInfo
 
 // GetPermissions 获取角色权限
 //
 //	@Router			/v1/role/permissions [get]
 //	@Summary		获取角色权限
-//	@Description	获取角色权限
+//	@Description	获取角色的有效权限，包括从父级角色继承的权限
 //	@Tags			角色
 //	@Accept			json
 //	@Produce		json
@@ -301,12 +342,103 @@ func (h *RoleHandler) GetPermissions(ctx echo.Context) error {
 
 	data := make(RoleGetPermissionsResponse, 0, len(ret))
 	for _, item := range ret {
-		data = append(data, &PermissionInfo{
-			ID:       item.ID,
-			Key:      item.Key,
-			Name:     item.Name,
-			Desc:     item.Desc,
-			ParentID: item.ParentID,
+		data = append(data, &RolePermissionInfo{
+			PermissionInfo: PermissionInfo{
+				ID:       item.ID,
+				Key:      item.Key,
+				Name:     item.Name,
+				Desc:     item.Desc,
+				ParentID: item.ParentID,
+			},
+			OriginRoleID: item.OriginRoleID,
+			Inherited:    item.IsInherited(req.ID),
+			Condition:    item.Condition,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, data)
+}
+
+type RoleSetParentsRequest struct {
+	Role    int64   `json:"role"`
+	Parents []int64 `json:"parents"` // 父级角色，为空时移除所有父级角色
+}
+
+// SetParents 设置父级角色
+//
+//	@Router			/v1/role/parents [post]
+//	@Summary		设置父级角色
+//	@Description	设置父级角色，会替换角色原有的父级角色，角色继承父级角色的所有权限
+//	@Tags			角色
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		RoleSetParentsRequest		true	"请求体"	format(string)
+//	@Success		200		{object}	example.Success				"成功响应"
+//	@Failure		500		{object}	example.ServerError			"服务器出错"
+//	@Failure		400		{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401		{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403		{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404		{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429		{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *RoleHandler) SetParents(ctx echo.Context) error {
+	req := new(RoleSetParentsRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	r := controller.RoleSetParentsRequest{
+		Role:    req.Role,
+		Parents: req.Parents,
+	}
+	if err := h.controller.SetParents(ctx.Request().Context(), r); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type RoleGetParentsRequest struct {
+	ID int64 `query:"id"`
+}
+
+type RoleGetParentsResponse []*RoleInfo
+
+// GetParents 获取父级角色
+//
+//	@Router			/v1/role/parents [get]
+//	@Summary		获取父级角色
+//	@Description	获取角色直接继承的父级角色
+//	@Tags			角色
+//	@Accept			x-www-form-urlencoded
+//	@Produce		json
+//	@Param			id	query		integer											true	"角色 id"	format(uint)	minimum(1)
+//	@Success		200	{object}	example.Success{data=RoleGetParentsResponse}	"成功响应"
+//	@Failure		500	{object}	example.ServerError								"服务器出错"
+//	@Failure		400	{object}	example.ClientError								"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized							"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied						"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound						"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest							"请求过于频繁"
+//	@Security		Authorization
+func (h *RoleHandler) GetParents(ctx echo.Context) error {
+	req := new(RoleGetParentsRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	ret, err := h.controller.GetParents(ctx.Request().Context(), req.ID)
+	if err != nil {
+		return err
+	}
+
+	data := make(RoleGetParentsResponse, 0, len(ret))
+	for _, item := range ret {
+		data = append(data, &RoleInfo{
+			ID:                   item.ID,
+			Name:                 item.Name,
+			DataScope:            string(item.DataScope),
+			DataScopeDepartments: item.DataScopeDepartments,
 		})
 	}
 
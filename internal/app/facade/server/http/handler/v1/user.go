@@ -18,17 +18,25 @@ func NewUserHandler(controller *controller.UserController) *UserHandler {
 }
 
 type UserInfo struct {
-	ID       int64  `json:"id"`
-	Username string `json:"username"`
-	Nickname string `json:"nickname"`
-	Phone    string `json:"phone"`
+	ID             int64  `json:"id"`
+	Username       string `json:"username"`
+	Nickname       string `json:"nickname"`
+	Phone          string `json:"phone"`
+	Email          string `json:"email"`
+	EmailVerified  bool   `json:"emailVerified"`
+	ServiceAccount bool   `json:"serviceAccount"` // 是否为服务账号
+	DepartmentID   int64  `json:"departmentID"`   // 所属部门 id
 }
 
 type UserListRequest struct {
 	Keyword string `json:"keyword" query:"keyword"`
+	PageRequest
 }
 
-type UserListResponse []*UserInfo
+type UserListResponse struct {
+	Items []*UserInfo `json:"items"`
+	PageInfo
+}
 
 // List 用户列表
 //
@@ -38,7 +46,12 @@ type UserListResponse []*UserInfo
 //	@Tags			用户
 //	@Accept			x-www-form-urlencoded
 //	@Produce		json
-//	@Param			keyword	query		string									false	"查询字符串"	format(string)
+//	@Param			keyword	query		string									false	"查询字符串"													format(string)
+//	@Param			offset	query		int										false	"偏移量，设置游标时忽略"											format(int)
+//	@Param			limit	query		int										false	"每页条数，默认 20，最多 100"										format(int)
+//	@Param			cursor	query		string									false	"游标，上一页返回的 nextCursor"									format(string)
+//	@Param			sort	query		string									false	"排序字段：id, username, createdAt, updatedAt，默认 updatedAt"	format(string)
+//	@Param			order	query		string									false	"排序方向：asc 升序，desc 降序，默认 desc"							format(string)
 //	@Success		200		{object}	example.Success{data=UserListResponse}	"成功响应"
 //	@Failure		500		{object}	example.ServerError						"服务器出错"
 //	@Failure		400		{object}	example.ClientError						"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
@@ -54,20 +67,31 @@ func (h *UserHandler) List(ctx echo.Context) error {
 	}
 
 	r := controller.UserListRequest{
-		Keyword: req.Keyword,
+		Keyword:     req.Keyword,
+		PageRequest: req.PageRequest.toControllerRequest(),
 	}
 	ret, err := h.controller.List(ctx.Request().Context(), r)
 	if err != nil {
 		return err
 	}
 
-	data := make(UserListResponse, 0, len(ret))
-	for _, item := range ret {
-		data = append(data, &UserInfo{
-			ID:       item.ID,
-			Username: item.Username,
-			Nickname: item.Nickname,
-			Phone:    item.Phone,
+	data := &UserListResponse{
+		Items: make([]*UserInfo, 0, len(ret.Items)),
+		PageInfo: PageInfo{
+			Total:      ret.Total,
+			NextCursor: ret.NextCursor,
+		},
+	}
+	for _, item := range ret.Items {
+		data.Items = append(data.Items, &UserInfo{
+			ID:             item.ID,
+			Username:       item.Username,
+			Nickname:       item.Nickname,
+			Phone:          item.Phone,
+			Email:          item.Email,
+			EmailVerified:  item.EmailVerified(),
+			ServiceAccount: item.ServiceAccount,
+			DepartmentID:   item.DepartmentID,
 		})
 	}
 
@@ -75,10 +99,14 @@ func (h *UserHandler) List(ctx echo.Context) error {
 }
 
 type UserCreateRequest struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Nickname string `json:"nickname"`
-	Phone    string `json:"phone"`
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	Nickname     string `json:"nickname"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`        // 邮箱，可选
+	DepartmentID int64  `json:"departmentID"` // 所属部门 id，可选
+	// 是否为服务账号，服务账号仅可通过 API 密钥访问，无需密码和手机号
+	ServiceAccount bool `json:"serviceAccount"`
 }
 
 // Create 用户创建
@@ -106,11 +134,14 @@ func (h *UserHandler) Create(ctx echo.Context) error {
 
 	r := controller.UserCreateRequest{
 		UserAttr: controller.UserAttr{
-			Username: req.Username,
-			Password: req.Password,
-			Nickname: req.Nickname,
-			Phone:    req.Phone,
+			Username:     req.Username,
+			Password:     req.Password,
+			Nickname:     req.Nickname,
+			Phone:        req.Phone,
+			Email:        req.Email,
+			DepartmentID: req.DepartmentID,
 		},
+		ServiceAccount: req.ServiceAccount,
 	}
 	if err := h.controller.Create(ctx.Request().Context(), r); err != nil {
 		return err
@@ -120,11 +151,13 @@ func (h *UserHandler) Create(ctx echo.Context) error {
 }
 
 type UserUpdateRequest struct {
-	ID       int64  `json:"id"`
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Nickname string `json:"nickname"`
-	Phone    string `json:"phone"`
+	ID           int64  `json:"id"`
+	Username     string `json:"username"`
+	Password     string `json:"password"` // 密码，可选，为空时不修改，服务账号不能设置密码
+	Nickname     string `json:"nickname"`
+	Phone        string `json:"phone"`        // 服务账号可选
+	Email        string `json:"email"`        // 邮箱，可选，修改后需重新验证
+	DepartmentID int64  `json:"departmentID"` // 所属部门 id，可选
 }
 
 // Update 用户更新
@@ -153,10 +186,12 @@ func (h *UserHandler) Update(ctx echo.Context) error {
 	p := controller.UserUpdateRequest{
 		ID: req.ID,
 		UserAttr: controller.UserAttr{
-			Username: req.Username,
-			Password: req.Password,
-			Nickname: req.Nickname,
-			Phone:    req.Phone,
+			Username:     req.Username,
+			Password:     req.Password,
+			Nickname:     req.Nickname,
+			Phone:        req.Phone,
+			Email:        req.Email,
+			DepartmentID: req.DepartmentID,
 		},
 	}
 	if err := h.controller.Update(ctx.Request().Context(), p); err != nil {
@@ -201,10 +236,14 @@ func (h *UserHandler) Detail(ctx echo.Context) error {
 	}
 
 	data := &UserDetailResponse{
-		ID:       ret.ID,
-		Username: ret.Username,
-		Nickname: ret.Nickname,
-		Phone:    ret.Phone,
+		ID:             ret.ID,
+		Username:       ret.Username,
+		Nickname:       ret.Nickname,
+		Phone:          ret.Phone,
+		Email:          ret.Email,
+		EmailVerified:  ret.EmailVerified(),
+		ServiceAccount: ret.ServiceAccount,
+		DepartmentID:   ret.DepartmentID,
 	}
 
 	return ctx.JSON(http.StatusOK, data)
@@ -244,9 +283,50 @@ func (h *UserHandler) Delete(ctx echo.Context) error {
 	return ctx.NoContent(http.StatusOK)
 }
 
+type UserUnlockRequest struct {
+	ID int64 `param:"id"`
+}
+
+// Unlock 解除用户登录锁定
+//
+//	@Router			/v1/user/{id}/lockout [delete]
+//	@Summary		解除用户登录锁定
+//	@Description	解除用户因多次登录失败导致的锁定，并清除失败次数
+//	@Tags			用户
+//	@Accept			plain
+//	@Produce		json
+//	@Param			id	path		integer						true	"用户 id"	format(uint)	minimum(1)
+//	@Success		200	{object}	example.Success				"成功响应"
+//	@Failure		500	{object}	example.ServerError			"服务器出错"
+//	@Failure		400	{object}	example.ClientError			"客户端请求错误（code 类型应为 int，string 仅为了表达多个错误码）"
+//	@Failure		401	{object}	example.Unauthorized		"登陆失效"
+//	@Failure		403	{object}	example.PermissionDenied	"没有权限"
+//	@Failure		404	{object}	example.ResourceNotFound	"资源不存在"
+//	@Failure		429	{object}	example.TooManyRequest		"请求过于频繁"
+//	@Security		Authorization
+func (h *UserHandler) Unlock(ctx echo.Context) error {
+	req := new(UserUnlockRequest)
+	if err := ctx.Bind(req); err != nil {
+		return httperr.WrapHTTTPError(err.(*echo.HTTPError)).SetMessage("request parameter parsing error")
+	}
+
+	if err := h.controller.Unlock(ctx.Request().Context(), req.ID); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+type UserRoleGrant struct {
+	Role      int64 `json:"role"`
+	StartsAt  int64 `json:"startsAt"`  // 生效时间，unix 时间戳，0 为立即生效
+	ExpiresAt int64 `json:"expiresAt"` // 过期时间，unix 时间戳，0 为永不过期
+}
+
 type UserAssignRoleRequest struct {
-	User  int64   `json:"user"`
-	Roles []int64 `json:"roles"`
+	User   int64           `json:"user"`
+	Roles  []int64         `json:"roles"`  // 永久授予的角色
+	Grants []UserRoleGrant `json:"grants"` // 限时授予的角色
 }
 
 // AssignRoles 分配用户角色
@@ -273,8 +353,16 @@ func (h *UserHandler) AssignRoles(ctx echo.Context) error {
 	}
 
 	r := controller.UserAssignRoleRequest{
-		User:  req.User,
-		Roles: req.Roles,
+		User:   req.User,
+		Roles:  req.Roles,
+		Grants: make([]controller.UserRoleGrant, 0, len(req.Grants)),
+	}
+	for _, item := range req.Grants {
+		r.Grants = append(r.Grants, controller.UserRoleGrant{
+			Role:      item.Role,
+			StartsAt:  item.StartsAt,
+			ExpiresAt: item.ExpiresAt,
+		})
 	}
 	if err := h.controller.AssignRoles(ctx.Request().Context(), r); err != nil {
 		return err
@@ -287,7 +375,13 @@ type UserGetRoleRequest struct {
 	ID int64 `query:"id"`
 }
 
-type UserGetRoleResponse []*RoleInfo
+type UserRoleInfo struct {
+	*RoleInfo
+	StartsAt  int64 `json:"startsAt"`  // 生效时间，0 为立即生效
+	ExpiresAt int64 `json:"expiresAt"` // 过期时间，0 为永不过期
+}
+
+type UserGetRoleResponse []*UserRoleInfo
 
 // GetRoles 获取用户角色
 //
@@ -319,9 +413,15 @@ func (h *UserHandler) GetRoles(ctx echo.Context) error {
 
 	data := make(UserGetRoleResponse, 0, len(ret))
 	for _, item := range ret {
-		data = append(data, &RoleInfo{
-			ID:   item.ID,
-			Name: item.Name,
+		data = append(data, &UserRoleInfo{
+			RoleInfo: &RoleInfo{
+				ID:                   item.Role.ID,
+				Name:                 item.Role.Name,
+				DataScope:            string(item.Role.DataScope),
+				DataScopeDepartments: item.Role.DataScopeDepartments,
+			},
+			StartsAt:  item.StartsAt,
+			ExpiresAt: item.ExpiresAt,
 		})
 	}
 
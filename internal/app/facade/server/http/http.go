@@ -35,13 +35,17 @@ var ProviderSet = wire.NewSet(
 	v1.NewProducerHandler,
 	v1.NewAccountHandler,
 	v1.NewUserHandler,
+	v1.NewAPIKeyHandler,
+	v1.NewImpersonationHandler,
 	v1.NewRoleHandler,
 	v1.NewPermissionHandler,
+	v1.NewAuthzHandler,
 	v1.NewProductHandler,
 	// router
 	router.New,
 	router.NewAPIGroup,
 	router.NewAPIV1Group,
+	router.NewPermissions,
 	// HTTP server
 	New,
 )
@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
@@ -15,12 +17,18 @@ import (
 const (
 	defaultTokenHeaderKey         = "Authorization"
 	defaultTokenHeaderValuePrefix = "Bearer "
+	defaultAPIKeyHeaderKey        = "X-API-Key"
+	defaultTenantHeaderKey        = "X-Tenant-ID"
 )
 
 type TokenValidator interface {
 	ValidateToken(ctx context.Context, token string) (*domain.UserProfile, error)
 }
 
+type TenantResolver interface {
+	ResolveTenant(ctx context.Context, user domain.UserProfile, tenant int64) error
+}
+
 type TokenRefresher interface {
 	RefreshToken(ctx context.Context, userProfile domain.UserProfile, token string) (string, error)
 }
@@ -42,6 +50,23 @@ type AuthConfig struct {
 
 	// TokenRefresher handle the refresh of token
 	TokenRefresher TokenRefresher
+
+	// APIKeyHeaderKey key that get the API key from header
+	// if not specified，default: "X-API-Key"
+	APIKeyHeaderKey string
+
+	// APIKeyValidator handle the validate of API key,
+	// the API key takes precedence over the token if both are present
+	APIKeyValidator TokenValidator
+
+	// TenantHeaderKey key that get the tenant that the request acts in from header,
+	// the tenant of the credential is used if the header is absent
+	// if not specified，default: "X-Tenant-ID"
+	TenantHeaderKey string
+
+	// TenantResolver ensure the user can act in the tenant of the header,
+	// the header is ignored if it is not specified
+	TenantResolver TenantResolver
 }
 
 func (c *AuthConfig) WithSkipper(skipper middleware.Skipper) *AuthConfig {
@@ -69,11 +94,33 @@ func (c *AuthConfig) WithTokenRefresher(handler TokenRefresher) *AuthConfig {
 	return c
 }
 
+func (c *AuthConfig) WithAPIKeyHeaderKey(key string) *AuthConfig {
+	c.APIKeyHeaderKey = key
+	return c
+}
+
+func (c *AuthConfig) WithAPIKeyValidator(handler TokenValidator) *AuthConfig {
+	c.APIKeyValidator = handler
+	return c
+}
+
+func (c *AuthConfig) WithTenantHeaderKey(key string) *AuthConfig {
+	c.TenantHeaderKey = key
+	return c
+}
+
+func (c *AuthConfig) WithTenantResolver(handler TenantResolver) *AuthConfig {
+	c.TenantResolver = handler
+	return c
+}
+
 func NewDefaultAuthConfig() *AuthConfig {
 	return &AuthConfig{
 		Skipper:           middleware.DefaultSkipper,
 		HeaderKey:         defaultTokenHeaderKey,
 		HeaderValuePrefix: defaultTokenHeaderValuePrefix,
+		APIKeyHeaderKey:   defaultAPIKeyHeaderKey,
+		TenantHeaderKey:   defaultTenantHeaderKey,
 	}
 }
 
@@ -84,6 +131,12 @@ func Auth(config AuthConfig) echo.MiddlewareFunc {
 				return next(c)
 			}
 
+			if config.APIKeyValidator != nil {
+				if key := c.Request().Header.Get(config.APIKeyHeaderKey); key != "" {
+					return authAPIKey(config, key, next, c)
+				}
+			}
+
 			token := c.Request().Header.Get(config.HeaderKey)
 
 			if token == "" {
@@ -114,7 +167,62 @@ func Auth(config AuthConfig) echo.MiddlewareFunc {
 				c.Response().Header().Set(config.HeaderKey, refreshedToken)
 			}
 
-			return next(&Context{c, *user})
+			if err := resolveTenant(config, c, user); err != nil {
+				return err
+			}
+
+			return next(newContext(c, *user, token))
 		}
 	}
 }
+
+// authAPIKey the API key is never refreshed,
+// and the request must be within the scopes of the API key besides the permissions of the service account
+func authAPIKey(config AuthConfig, key string, next echo.HandlerFunc, c echo.Context) error {
+	user, err := config.APIKeyValidator.ValidateToken(c.Request().Context(), key)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return err
+	} else if err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "invalid API key").SetInternal(err)
+	}
+
+	if !user.InScope(fmt.Sprintf("%s %s", c.Request().Method, c.Path())) {
+		return echo.NewHTTPError(http.StatusForbidden, "access denied")
+	}
+
+	if err := resolveTenant(config, c, user); err != nil {
+		return err
+	}
+
+	return next(newContext(c, *user, ""))
+}
+
+// resolveTenant the user acts in the tenant of the header if it is present, otherwise the tenant of the credential,
+// the tenant can not be switched under impersonation
+func resolveTenant(config AuthConfig, c echo.Context, user *domain.UserProfile) error {
+	header := c.Request().Header.Get(config.TenantHeaderKey)
+	if config.TenantResolver == nil || header == "" {
+		return nil
+	}
+
+	tenant, err := strconv.ParseInt(header, 10, 64)
+	if err != nil || tenant <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "malformed tenant").SetInternal(err)
+	}
+
+	if tenant == user.TenantID {
+		return nil
+	}
+
+	if user.IsImpersonated() {
+		return echo.NewHTTPError(http.StatusForbidden, "the tenant can not be switched under impersonation")
+	}
+
+	if err := config.TenantResolver.ResolveTenant(c.Request().Context(), *user, tenant); err != nil {
+		return err
+	}
+
+	user.TenantID = tenant
+
+	return nil
+}
@@ -6,13 +6,24 @@ import (
 	"go-scaffold/internal/app/domain"
 )
 
+const (
+	// ContextKeyUserID the key of the authenticated user id in the echo context
+	ContextKeyUserID = "auth.user_id"
+	// ContextKeyActorID the key of the impersonator id in the echo context, set only under impersonation
+	ContextKeyActorID = "auth.actor_id"
+	// ContextKeyTenantID the key of the tenant id that the request acts in in the echo context
+	ContextKeyTenantID = "auth.tenant_id"
+)
+
 // Context user profile context
 type Context struct {
 	echo.Context
-	user domain.UserProfile
+	user  domain.UserProfile
+	token string
 }
 
-// GetUser get user profile from context
+// GetUser get user profile from context,
+// it is the impersonated user under impersonation
 func (u *Context) GetUser() domain.UserProfile {
 	return u.user
 }
@@ -21,3 +32,36 @@ func (u *Context) GetUser() domain.UserProfile {
 func (u *Context) SetUser(user domain.UserProfile) {
 	u.user = user
 }
+
+// GetActor get the profile of the real user that makes the request,
+// it is the same as GetUser unless under impersonation
+func (u *Context) GetActor() domain.UserProfile {
+	if u.user.Actor != nil {
+		return *u.user.Actor
+	}
+	return u.user
+}
+
+// IsImpersonated reports whether the request is made under impersonation
+func (u *Context) IsImpersonated() bool {
+	return u.user.IsImpersonated()
+}
+
+// GetToken get the token that the user is authenticated with
+func (u *Context) GetToken() string {
+	return u.token
+}
+
+// newContext wrap the echo context with the authenticated user,
+// the identities are also stored in the echo context for the request logger,
+// and the tenant is stored in the request context for the controllers
+func newContext(c echo.Context, user domain.UserProfile, token string) *Context {
+	c.Set(ContextKeyUserID, user.ID)
+	if user.Actor != nil {
+		c.Set(ContextKeyActorID, user.Actor.ID)
+	}
+	c.Set(ContextKeyTenantID, user.TenantID)
+	c.SetRequest(c.Request().WithContext(domain.NewTenantContext(c.Request().Context(), user.TenantID)))
+
+	return &Context{Context: c, user: user, token: token}
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"go-scaffold/internal/app/domain"
+)
+
+type DataScopeResolver interface {
+	ResolveDataScope(ctx context.Context, user domain.UserProfile) (*domain.DataFilter, error)
+}
+
+type DataScopeConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper middleware.Skipper
+
+	// DataScopeResolver handle the resolve of the data scope
+	DataScopeResolver DataScopeResolver
+}
+
+func (c *DataScopeConfig) WithSkipper(skipper middleware.Skipper) *DataScopeConfig {
+	c.Skipper = skipper
+	return c
+}
+
+func (c *DataScopeConfig) WithResolver(resolver DataScopeResolver) *DataScopeConfig {
+	c.DataScopeResolver = resolver
+	return c
+}
+
+func NewDefaultDataScopeConfig() *DataScopeConfig {
+	return &DataScopeConfig{
+		Skipper: middleware.DefaultSkipper,
+	}
+}
+
+// DataScope store the rows that the user may see in the request context,
+// the repositories apply it to the queries, it must be used after the Auth middleware,
+// the data scope of the impersonated user applies under impersonation
+func DataScope(config DataScopeConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) || config.DataScopeResolver == nil {
+				return next(c)
+			}
+
+			ac, ok := c.(*Context)
+			if !ok {
+				return next(c)
+			}
+
+			filter, err := config.DataScopeResolver.ResolveDataScope(c.Request().Context(), ac.GetUser())
+			if err != nil {
+				return err
+			}
+
+			c.SetRequest(c.Request().WithContext(domain.NewDataFilterContext(c.Request().Context(), *filter)))
+
+			return next(c)
+		}
+	}
+}
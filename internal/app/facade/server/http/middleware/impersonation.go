@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"go-scaffold/internal/app/domain"
+)
+
+type AuditRecorder interface {
+	// Record persist the audit log, the failure is handled by the recorder
+	// so that the request is not affected
+	Record(ctx context.Context, log domain.AuditLog)
+}
+
+type AuditConfig struct {
+	// Skipper defines a function to skip middleware.
+	Skipper middleware.Skipper
+
+	// AuditRecorder handle the record of the audit log
+	AuditRecorder AuditRecorder
+}
+
+func (c *AuditConfig) WithSkipper(skipper middleware.Skipper) *AuditConfig {
+	c.Skipper = skipper
+	return c
+}
+
+func (c *AuditConfig) WithRecorder(recorder AuditRecorder) *AuditConfig {
+	c.AuditRecorder = recorder
+	return c
+}
+
+func NewDefaultAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		Skipper: middleware.DefaultSkipper,
+	}
+}
+
+// Audit record every request made under impersonation into the audit log,
+// it must be used after the Auth middleware
+func Audit(config AuditConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) || config.AuditRecorder == nil {
+				return next(c)
+			}
+
+			ac, ok := c.(*Context)
+			if !ok || !ac.IsImpersonated() {
+				return next(c)
+			}
+
+			// the error is handled in place so that the status of the response is recorded
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			user := ac.GetUser()
+			config.AuditRecorder.Record(context.WithoutCancel(c.Request().Context()), domain.AuditLog{
+				TenantID:  domain.TenantFromContext(c.Request().Context()),
+				ActorID:   user.Actor.ID,
+				UserID:    user.ID,
+				Action:    domain.AuditActionImpersonatedRequest,
+				Method:    c.Request().Method,
+				Path:      c.Request().RequestURI,
+				Status:    c.Response().Status,
+				IP:        c.RealIP(),
+				UserAgent: c.Request().UserAgent(),
+			})
+
+			return nil
+		}
+	}
+}
+
+// DenyImpersonation reject the request made under impersonation,
+// it protects the credentials, the email and the sessions of the impersonated user
+func DenyImpersonation() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if ac, ok := c.(*Context); ok && ac.IsImpersonated() {
+				return echo.NewHTTPError(http.StatusForbidden, "not allowed under impersonation")
+			}
+			return next(c)
+		}
+	}
+}
@@ -11,7 +11,7 @@ import (
 func Logger(logger *slog.Logger) echo.MiddlewareFunc {
 	return middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			logger.InfoContext(c.Request().Context(), "request",
+			attrs := []any{
 				slog.String("id", v.RequestID),
 				slog.String("remote_ip", v.RemoteIP),
 				slog.String("host", v.Host),
@@ -20,7 +20,16 @@ func Logger(logger *slog.Logger) echo.MiddlewareFunc {
 				slog.String("user_agent", v.UserAgent),
 				slog.Int("status", v.Status),
 				slog.String("latency", v.Latency.String()),
-			)
+			}
+			if user, ok := c.Get(ContextKeyUserID).(int64); ok {
+				attrs = append(attrs, slog.Int64("user", user))
+			}
+			// the request made under impersonation is tagged with the real user
+			if actor, ok := c.Get(ContextKeyActorID).(int64); ok {
+				attrs = append(attrs, slog.Int64("impersonator", actor), slog.Bool("impersonated", true))
+			}
+
+			logger.InfoContext(c.Request().Context(), "request", attrs...)
 			return nil
 		},
 		LogLatency:   true,
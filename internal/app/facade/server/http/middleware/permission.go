@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+
+	icasbin "go-scaffold/internal/pkg/casbin"
 )
 
 type PermissionValidator interface {
@@ -47,17 +51,48 @@ func Permission(config PermissionConfig) echo.MiddlewareFunc {
 			if config.PermissionValidator != nil {
 				user := c.(*Context).GetUser()
 				permissionKey := fmt.Sprintf("%s %s", c.Request().Method, c.Path())
+				ctx := icasbin.WithEnvironment(c.Request().Context(), environment(c))
 
-				result, err := config.PermissionValidator.ValidatePermission(c.Request().Context(), user.ID, permissionKey)
+				result, err := config.PermissionValidator.ValidatePermission(ctx, user.ID, permissionKey)
 				if err != nil {
 					return err
 				}
 				if !result {
 					return echo.NewHTTPError(http.StatusForbidden, "access denied")
 				}
+
+				// the impersonation never grants the actor more than its own permissions
+				if user.IsImpersonated() {
+					result, err = config.PermissionValidator.ValidatePermission(ctx, user.Actor.ID, permissionKey)
+					if err != nil {
+						return err
+					}
+					if !result {
+						return echo.NewHTTPError(http.StatusForbidden, "access denied")
+					}
+				}
 			}
 
 			return next(c)
 		}
 	}
 }
+
+// environment the attributes of the request that the conditions of the permissions are evaluated against,
+// the names of the headers are lowercased
+func environment(c echo.Context) icasbin.Environment {
+	attributes := map[string]string{
+		"method": c.Request().Method,
+		"path":   c.Path(),
+	}
+	for name := range c.Request().Header {
+		attributes["header."+strings.ToLower(name)] = c.Request().Header.Get(name)
+	}
+
+	// RealIP trusts the forwarded headers only from the proxies allowed by the IPExtractor of the server
+	return icasbin.Environment{
+		Time:       time.Now(),
+		IP:         c.RealIP(),
+		Attributes: attributes,
+	}
+}
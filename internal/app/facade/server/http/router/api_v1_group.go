@@ -11,16 +11,25 @@ import (
 // ApiV1Group v1 API routing group
 type ApiV1Group struct {
 	accountTokenController      *controller.AccountTokenController
+	apiKeyController            *controller.APIKeyController
+	impersonationController     *controller.ImpersonationController
 	accountPermissionController *controller.AccountPermissionController
-
-	greetHandler      *v1.GreetHandler
-	traceHandler      *v1.TraceHandler
-	producerHandler   *v1.ProducerHandler
-	accountHandler    *v1.AccountHandler
-	userHandler       *v1.UserHandler
-	roleHandler       *v1.RoleHandler
-	permissionHandler *v1.PermissionHandler
-	productHandler    *v1.ProductHandler
+	tenantController            *controller.TenantController
+	dataScopeController         *controller.DataScopeController
+
+	greetHandler         *v1.GreetHandler
+	traceHandler         *v1.TraceHandler
+	producerHandler      *v1.ProducerHandler
+	accountHandler       *v1.AccountHandler
+	userHandler          *v1.UserHandler
+	apiKeyHandler        *v1.APIKeyHandler
+	impersonationHandler *v1.ImpersonationHandler
+	roleHandler          *v1.RoleHandler
+	permissionHandler    *v1.PermissionHandler
+	authzHandler         *v1.AuthzHandler
+	productHandler       *v1.ProductHandler
+
+	permissions *Permissions
 
 	group *echo.Group
 
@@ -30,27 +39,43 @@ type ApiV1Group struct {
 // NewAPIV1Group return *ApiV1Group
 func NewAPIV1Group(
 	accountTokenController *controller.AccountTokenController,
+	apiKeyController *controller.APIKeyController,
+	impersonationController *controller.ImpersonationController,
 	accountPermissionController *controller.AccountPermissionController,
+	tenantController *controller.TenantController,
+	dataScopeController *controller.DataScopeController,
 	greetHandler *v1.GreetHandler,
 	traceHandler *v1.TraceHandler,
 	producerHandler *v1.ProducerHandler,
 	accountHandler *v1.AccountHandler,
 	userHandler *v1.UserHandler,
+	apiKeyHandler *v1.APIKeyHandler,
+	impersonationHandler *v1.ImpersonationHandler,
 	roleHandler *v1.RoleHandler,
 	permissionHandler *v1.PermissionHandler,
+	authzHandler *v1.AuthzHandler,
 	productHandler *v1.ProductHandler,
+	permissions *Permissions,
 ) *ApiV1Group {
 	return &ApiV1Group{
 		accountTokenController:      accountTokenController,
+		apiKeyController:            apiKeyController,
+		impersonationController:     impersonationController,
 		accountPermissionController: accountPermissionController,
+		tenantController:            tenantController,
+		dataScopeController:         dataScopeController,
 		greetHandler:                greetHandler,
 		traceHandler:                traceHandler,
 		productHandler:              productHandler,
 		accountHandler:              accountHandler,
 		userHandler:                 userHandler,
+		apiKeyHandler:               apiKeyHandler,
+		impersonationHandler:        impersonationHandler,
 		roleHandler:                 roleHandler,
 		permissionHandler:           permissionHandler,
+		authzHandler:                authzHandler,
 		producerHandler:             producerHandler,
+		permissions:                 permissions,
 	}
 }
 
@@ -67,47 +92,90 @@ func (g *ApiV1Group) useRoutes() {
 
 	g.group.POST("/register", g.accountHandler.Register)
 	g.group.POST("/login", g.accountHandler.Login)
+	g.group.POST("/login/totp", g.accountHandler.LoginTOTP)
+	g.group.POST("/login/totp/enroll", g.accountHandler.LoginTOTPEnroll)
+	g.group.POST("/token/refresh", g.accountHandler.RefreshToken)
+	g.group.POST("/password/forgot", g.accountHandler.ForgotPassword)
+	g.group.POST("/password/reset", g.accountHandler.ResetPassword)
+	g.group.POST("/email/verify", g.accountHandler.VerifyEmail)
+	g.group.GET("/oidc/:provider/authorize", g.accountHandler.OIDCAuthorize)
+	g.group.POST("/oidc/:provider/callback", g.accountHandler.OIDCLogin)
 
 	g.group.Use(imiddleware.Auth(*imiddleware.NewDefaultAuthConfig().
 		WithTokenValidator(g.accountTokenController).
-		WithTokenRefresher(g.accountTokenController),
+		WithAPIKeyValidator(g.apiKeyController).
+		WithTenantResolver(g.tenantController),
+	))
+	g.group.Use(imiddleware.Audit(*imiddleware.NewDefaultAuditConfig().
+		WithRecorder(g.impersonationController),
 	))
 	{
 		g.group.DELETE("/logout", g.accountHandler.Logout)
-		g.group.PUT("/account/profile", g.accountHandler.UpdateProfile)
+		g.group.PUT("/account/profile", g.accountHandler.UpdateProfile, imiddleware.DenyImpersonation())
 		g.group.GET("/account/profile", g.accountHandler.GetProfile)
+		g.group.POST("/account/email/verification", g.accountHandler.SendEmailVerification, imiddleware.DenyImpersonation())
 		g.group.GET("/account/permissions", g.accountHandler.GetPermissions)
+		g.group.GET("/account/sessions", g.accountHandler.ListSessions)
+		g.group.DELETE("/account/sessions/:id", g.accountHandler.RevokeSession, imiddleware.DenyImpersonation())
+		g.group.POST("/account/totp", g.accountHandler.EnrollTOTP, imiddleware.DenyImpersonation())
+		g.group.POST("/account/totp/activate", g.accountHandler.ActivateTOTP, imiddleware.DenyImpersonation())
+		g.group.DELETE("/account/totp", g.accountHandler.DisableTOTP, imiddleware.DenyImpersonation())
+		g.group.POST("/account/totp/recovery-codes", g.accountHandler.RegenerateRecoveryCodes, imiddleware.DenyImpersonation())
 
 		g.group.Use(imiddleware.Permission(*imiddleware.NewDefaultPermissionConfig().
 			WithValidator(g.accountPermissionController),
 		))
+		g.group.Use(imiddleware.DataScope(*imiddleware.NewDefaultDataScopeConfig().
+			WithResolver(g.dataScopeController),
+		))
 
-		g.group.GET("/users", g.userHandler.List)
-		g.group.GET("/user/:id", g.userHandler.Detail)
-		g.group.POST("/user", g.userHandler.Create)
-		g.group.PUT("/user", g.userHandler.Update)
-		g.group.DELETE("/user/:id", g.userHandler.Delete)
-		g.group.GET("/user/roles", g.userHandler.GetRoles)
-		g.group.POST("/user/roles", g.userHandler.AssignRoles)
-
-		g.group.GET("/roles", g.roleHandler.List)
-		g.group.GET("/role/:id", g.roleHandler.Detail)
-		g.group.POST("/role", g.roleHandler.Create)
-		g.group.PUT("/role", g.roleHandler.Update)
-		g.group.DELETE("/role/:id", g.roleHandler.Delete)
-		g.group.GET("/role/permissions", g.roleHandler.GetPermissions)
-		g.group.POST("/role/permissions", g.roleHandler.GrantPermissions)
-
-		g.group.GET("/permissions", g.permissionHandler.List)
-		g.group.GET("/permission/:id", g.permissionHandler.Detail)
-		g.group.POST("/permission", g.permissionHandler.Create)
-		g.group.PUT("/permission", g.permissionHandler.Update)
-		g.group.DELETE("/permission/:id", g.permissionHandler.Delete)
-
-		g.group.GET("/products", g.productHandler.List)
-		g.group.GET("/product/:id", g.productHandler.Detail)
-		g.group.POST("/product", g.productHandler.Create)
-		g.group.PUT("/product", g.productHandler.Update)
-		g.group.DELETE("/product/:id", g.productHandler.Delete)
+		users := g.permissions.group("/users", "用户管理")
+		apiKeys := g.permissions.group("/api-keys", "API 密钥管理")
+		auditLogs := g.permissions.group("/audit-logs", "审计日志")
+		roles := g.permissions.group("/roles", "角色管理")
+		permissions := g.permissions.group("/permissions", "权限管理")
+		products := g.permissions.group("/products", "产品管理")
+
+		users.route(g.group.GET("/users", g.userHandler.List), "用户列表")
+		users.route(g.group.GET("/user/:id", g.userHandler.Detail), "用户详情")
+		users.route(g.group.POST("/user", g.userHandler.Create), "用户新增")
+		users.route(g.group.PUT("/user", g.userHandler.Update), "用户更新")
+		users.route(g.group.DELETE("/user/:id", g.userHandler.Delete), "用户删除")
+		users.route(g.group.DELETE("/user/:id/lockout", g.userHandler.Unlock), "解除用户锁定")
+		users.route(g.group.GET("/user/roles", g.userHandler.GetRoles), "获取用户角色")
+		users.route(g.group.POST("/user/roles", g.userHandler.AssignRoles), "分配用户角色")
+		users.route(g.group.POST("/user/:id/impersonate", g.impersonationHandler.Impersonate), "模拟用户登录")
+
+		apiKeys.route(g.group.GET("/api-keys", g.apiKeyHandler.List), "API 密钥列表")
+		apiKeys.route(g.group.POST("/api-key", g.apiKeyHandler.Create), "API 密钥新增")
+		apiKeys.route(g.group.DELETE("/api-key/:id", g.apiKeyHandler.Delete), "API 密钥删除")
+
+		auditLogs.route(g.group.GET("/audit-logs", g.impersonationHandler.ListAuditLogs), "审计日志列表")
+
+		roles.route(g.group.GET("/roles", g.roleHandler.List), "角色列表")
+		roles.route(g.group.GET("/role/:id", g.roleHandler.Detail), "角色详情")
+		roles.route(g.group.POST("/role", g.roleHandler.Create), "角色新增")
+		roles.route(g.group.PUT("/role", g.roleHandler.Update), "角色更新")
+		roles.route(g.group.DELETE("/role/:id", g.roleHandler.Delete), "角色删除")
+		roles.route(g.group.GET("/role/permissions", g.roleHandler.GetPermissions), "获取角色权限")
+		roles.route(g.group.POST("/role/permissions", g.roleHandler.GrantPermissions), "授予角色权限")
+		roles.route(g.group.GET("/role/parents", g.roleHandler.GetParents), "获取父级角色")
+		roles.route(g.group.POST("/role/parents", g.roleHandler.SetParents), "设置父级角色")
+
+		permissions.route(g.group.GET("/permissions", g.permissionHandler.List), "权限列表")
+		permissions.route(g.group.GET("/permissions/tree", g.permissionHandler.Tree), "权限树")
+		permissions.route(g.group.GET("/permission/:id", g.permissionHandler.Detail), "权限详情")
+		permissions.route(g.group.POST("/permission", g.permissionHandler.Create), "权限新增")
+		permissions.route(g.group.PUT("/permission", g.permissionHandler.Update), "权限更新")
+		permissions.route(g.group.PUT("/permission/move", g.permissionHandler.Move), "权限移动")
+		permissions.route(g.group.DELETE("/permission/:id", g.permissionHandler.Delete), "权限删除")
+		permissions.route(g.group.GET("/authz/explain", g.authzHandler.Explain), "解释授权决策")
+		permissions.route(g.group.GET("/authz/cache", g.authzHandler.CacheStats), "授权缓存统计")
+
+		products.route(g.group.GET("/products", g.productHandler.List), "产品列表")
+		products.route(g.group.GET("/product/:id", g.productHandler.Detail), "产品详情")
+		products.route(g.group.POST("/product", g.productHandler.Create), "产品新增")
+		products.route(g.group.PUT("/product", g.productHandler.Update), "产品更新")
+		products.route(g.group.DELETE("/product/:id", g.productHandler.Delete), "产品删除")
 	}
 }
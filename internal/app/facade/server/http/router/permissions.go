@@ -0,0 +1,69 @@
+package router
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+
+	"go-scaffold/internal/app/domain"
+)
+
+// Permissions the permissions required by the routes, they are declared next to the routes,
+// and synchronized into the permissions by the command permissions sync
+type Permissions struct {
+	groups []domain.PermissionDefinition
+	routes map[string]domain.PermissionDefinition
+}
+
+// NewPermissions return *Permissions
+func NewPermissions() *Permissions {
+	return &Permissions{
+		routes: make(map[string]domain.PermissionDefinition),
+	}
+}
+
+// permissionGroup the permissions of the routes under the same parent
+type permissionGroup struct {
+	permissions *Permissions
+	key         string
+}
+
+// group declare the top level permission that the permissions of the routes are placed under
+func (p *Permissions) group(key, name string) *permissionGroup {
+	p.groups = append(p.groups, domain.PermissionDefinition{Key: key, Name: name})
+	return &permissionGroup{permissions: p, key: key}
+}
+
+// route declare the permission of the route, the key is the same as the one checked by the Permission middleware,
+// the description is optional
+func (g *permissionGroup) route(r *echo.Route, name string, desc ...string) {
+	d := domain.PermissionDefinition{
+		Key:    permissionKey(r.Method, r.Path),
+		Name:   name,
+		Parent: g.key,
+	}
+	if len(desc) > 0 {
+		d.Desc = desc[0]
+	}
+	g.permissions.routes[d.Key] = d
+}
+
+// Definitions returns the declared permissions of the routes, and the groups they are placed under,
+// the routes without the declaration are not required any permission
+func (p *Permissions) Definitions(routes []*echo.Route) []domain.PermissionDefinition {
+	list := make([]domain.PermissionDefinition, 0, len(p.groups)+len(p.routes))
+	list = append(list, p.groups...)
+
+	for _, r := range routes {
+		if d, ok := p.routes[permissionKey(r.Method, r.Path)]; ok {
+			list = append(list, d)
+		}
+	}
+
+	return list
+}
+
+// permissionKey the permission key of the route
+func permissionKey(method, path string) string {
+	return fmt.Sprintf("%s %s", method, path)
+}
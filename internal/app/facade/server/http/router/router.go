@@ -2,23 +2,27 @@ package router
 
 import (
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/pkg/errors"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
 
+	"go-scaffold/internal/app/controller"
 	imiddleware "go-scaffold/internal/app/facade/server/http/middleware"
 	"go-scaffold/internal/config"
 )
 
 type router struct {
-	logger   *slog.Logger
-	appName  config.AppName
-	appEnv   config.Env
-	hsConf   config.HTTPServer
-	apiGroup *ApiGroup
+	logger                 *slog.Logger
+	appName                config.AppName
+	appEnv                 config.Env
+	hsConf                 config.HTTPServer
+	accountTokenController *controller.AccountTokenController
+	apiGroup               *ApiGroup
 }
 
 // New return http router
@@ -27,21 +31,26 @@ func New(
 	appName config.AppName,
 	appEnv config.Env,
 	hsConf config.HTTPServer,
+	accountTokenController *controller.AccountTokenController,
 	apiGroup *ApiGroup,
-) http.Handler {
+) (http.Handler, error) {
 	r := &router{
-		logger:   logger,
-		appName:  appName,
-		appEnv:   appEnv,
-		hsConf:   hsConf,
-		apiGroup: apiGroup,
+		logger:                 logger,
+		appName:                appName,
+		appEnv:                 appEnv,
+		hsConf:                 hsConf,
+		accountTokenController: accountTokenController,
+		apiGroup:               apiGroup,
 	}
 
-	e := setup(appEnv)
+	e, err := setup(appEnv, hsConf)
+	if err != nil {
+		return nil, err
+	}
 	r.useMiddlewares(e)
 	r.useRoutes(e)
 
-	return e
+	return e, nil
 }
 
 func (r *router) useMiddlewares(e *echo.Echo) {
@@ -62,6 +71,7 @@ func (r *router) useRoutes(e *echo.Echo) {
 
 	group := e.Group(path)
 	group.GET("/ping", func(c echo.Context) error { return c.String(http.StatusOK, "pong") })
+	group.GET("/.well-known/jwks.json", r.jwks)
 
 	// register api routing group
 	r.apiGroup.setup(path, group)
@@ -69,13 +79,48 @@ func (r *router) useRoutes(e *echo.Echo) {
 	r.apiGroup.useRoutes(e)
 }
 
-func setup(appEnv config.Env) *echo.Echo {
+// jwks publish the public keys that verify the access token, see RFC 7517
+func (r *router) jwks(c echo.Context) error {
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(http.StatusOK, echo.Map{"keys": r.accountTokenController.JWKS()})
+}
+
+func setup(appEnv config.Env, hsConf config.HTTPServer) (*echo.Echo, error) {
 	e := echo.New()
 	e.HideBanner = true
 	e.HidePort = true
 	e.Debug = appEnv.IsDebug()
 
-	return e
+	ipExtractor, err := newIPExtractor(hsConf.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	e.IPExtractor = ipExtractor
+
+	return e, nil
+}
+
+// newIPExtractor the client ip is taken from the X-Forwarded-For header only if the request is forwarded by the trusted proxies,
+// otherwise it is the remote address of the connection, so that the clients can not forge their ips
+func newIPExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, proxy := range trustedProxies {
+		_, ipRange, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy %q", proxy)
+		}
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
 }
 
 // parseExternalAddr parse external address
@@ -18,6 +18,7 @@ import (
 var ProviderSet = wire.NewSet(
 	gserv.ProviderSet,
 	hserv.ProviderSet,
+	NewRoleGrantSweeper,
 	New,
 )
 
@@ -32,6 +33,7 @@ func New(
 	appName config.AppName,
 	hs *http.Server,
 	gs *grpc.Server,
+	sweeper *RoleGrantSweeper,
 	// discovery discovery.Discovery, // optional service registered
 ) *Server {
 	hostname, _ := os.Hostname()
@@ -49,6 +51,7 @@ func New(
 	if gs != nil {
 		servers = append(servers, gs)
 	}
+	servers = append(servers, sweeper)
 
 	if len(servers) > 0 {
 		options = append(options, kratos.Server(servers...))
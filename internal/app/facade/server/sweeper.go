@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"go-scaffold/internal/app/facade/cron/job"
+)
+
+// roleGrantSweepInterval the same as the schedule of the role grant sweep job of the cron server
+const roleGrantSweepInterval = time.Minute
+
+var _ transport.Server = (*RoleGrantSweeper)(nil)
+
+// RoleGrantSweeper run the role grant sweep in the server process, so that the lapsed grants are revoked
+// by every replica, whether the cron server is deployed and the casbin watcher is configured or not
+type RoleGrantSweeper struct {
+	job      *job.RoleGrantSweepJob
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewRoleGrantSweeper build role grant sweeper
+func NewRoleGrantSweeper(job *job.RoleGrantSweepJob) *RoleGrantSweeper {
+	return &RoleGrantSweeper{
+		job:  job,
+		stop: make(chan struct{}),
+	}
+}
+
+// Start run the sweep periodically until it is stopped
+func (s *RoleGrantSweeper) Start(ctx context.Context) error {
+	ticker := time.NewTicker(roleGrantSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.job.Run()
+		case <-s.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// Stop stop the sweep, it can be called more than once
+func (s *RoleGrantSweeper) Stop(context.Context) error {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	return nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	iredis "go-scaffold/internal/pkg/redis"
+)
+
+var _ AccountActionRepositoryInterface = (*AccountActionRepository)(nil)
+
+type AccountActionRepositoryInterface interface {
+	// Create record the action, the previous action of the same purpose is replaced
+	Create(ctx context.Context, e domain.AccountAction) error
+	// Consume delete the action, false is returned if it has been used or replaced
+	Consume(ctx context.Context, e domain.AccountAction) (bool, error)
+}
+
+// consumeAccountActionScript compare and delete, so that the action can only be consumed once
+var consumeAccountActionScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+return redis.call('DEL', KEYS[1])
+`)
+
+type AccountActionRepository struct {
+	rdb *iredis.DefaultRedis
+}
+
+func NewAccountActionRepository(rdb *iredis.DefaultRedis) *AccountActionRepository {
+	return &AccountActionRepository{
+		rdb: rdb,
+	}
+}
+
+func (r *AccountActionRepository) Create(ctx context.Context, e domain.AccountAction) error {
+	key := accountActionKey(e.Purpose, e.UserID)
+
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, e.ID, 0)
+		pipe.ExpireAt(ctx, key, e.ExpiresAt)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *AccountActionRepository) Consume(ctx context.Context, e domain.AccountAction) (bool, error) {
+	n, err := consumeAccountActionScript.Run(ctx, r.rdb, []string{accountActionKey(e.Purpose, e.UserID)}, e.ID).Int64()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return n > 0, nil
+}
+
+func accountActionKey(purpose domain.AccountActionPurpose, userID int64) string {
+	return fmt.Sprintf("account:action:%s:%d", purpose, userID)
+}
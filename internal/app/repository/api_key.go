@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	ient "go-scaffold/internal/pkg/ent"
+	"go-scaffold/internal/pkg/ent/ent"
+	"go-scaffold/internal/pkg/ent/ent/apikey"
+)
+
+var _ APIKeyRepositoryInterface = (*APIKeyRepository)(nil)
+
+type (
+	APIKeyFindListParam struct {
+		UserID int64
+	}
+
+	APIKeyRepositoryInterface interface {
+		Filter(ctx context.Context, param APIKeyFindListParam) ([]*domain.APIKey, error)
+		FindOne(ctx context.Context, id int64) (*domain.APIKey, error)
+		FindOneByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+		Create(ctx context.Context, e domain.APIKey) (*domain.APIKey, error)
+		// Touch update the last used time of the API key
+		Touch(ctx context.Context, e domain.APIKey, t time.Time) error
+		Delete(ctx context.Context, e domain.APIKey) error
+		// DeleteByUser delete all the API keys of the user
+		DeleteByUser(ctx context.Context, user int64) error
+	}
+)
+
+type APIKeyRepository struct {
+	client *ient.DefaultClient
+}
+
+func NewAPIKeyRepository(client *ient.DefaultClient) *APIKeyRepository {
+	return &APIKeyRepository{
+		client: client,
+	}
+}
+
+func (r *APIKeyRepository) Filter(ctx context.Context, param APIKeyFindListParam) ([]*domain.APIKey, error) {
+	query := r.client.APIKey.Query()
+
+	if param.UserID != 0 {
+		query.Where(apikey.UserIDEQ(param.UserID))
+	}
+
+	list, err := query.
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	entities := make([]*domain.APIKey, 0, len(list))
+	for _, i := range list {
+		entities = append(entities, (&apiKeyModel{i}).toEntity())
+	}
+
+	return entities, nil
+}
+
+func (r *APIKeyRepository) FindOne(ctx context.Context, id int64) (*domain.APIKey, error) {
+	m, err := r.client.APIKey.Get(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&apiKeyModel{m}).toEntity(), nil
+}
+
+func (r *APIKeyRepository) FindOneByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	m, err := r.client.APIKey.Query().
+		Where(apikey.PrefixEQ(prefix)).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&apiKeyModel{m}).toEntity(), nil
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, e domain.APIKey) (*domain.APIKey, error) {
+	m, err := r.client.APIKey.Create().
+		SetUserID(e.UserID).
+		SetName(e.Name).
+		SetPrefix(e.Prefix).
+		SetHash(e.Hash).
+		SetScopes(strings.Join(e.Scopes, ",")).
+		SetExpiresAt(e.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&apiKeyModel{m}).toEntity(), nil
+}
+
+func (r *APIKeyRepository) Touch(ctx context.Context, e domain.APIKey, t time.Time) error {
+	err := r.client.APIKey.
+		UpdateOneID(e.ID).
+		SetLastUsedAt(t.Unix()).
+		Exec(ctx)
+	return errors.WithStack(handleError(err))
+}
+
+func (r *APIKeyRepository) Delete(ctx context.Context, e domain.APIKey) error {
+	return errors.WithStack(r.client.APIKey.DeleteOneID(e.ID).Exec(ctx))
+}
+
+func (r *APIKeyRepository) DeleteByUser(ctx context.Context, user int64) error {
+	_, err := r.client.APIKey.Delete().
+		Where(apikey.UserIDEQ(user)).
+		Exec(ctx)
+	return errors.WithStack(handleError(err))
+}
+
+type apiKeyModel struct {
+	*ent.APIKey
+}
+
+func (m *apiKeyModel) toEntity() *domain.APIKey {
+	e := &domain.APIKey{
+		ID:         m.ID,
+		UserID:     m.UserID,
+		Name:       m.Name,
+		Prefix:     m.Prefix,
+		Hash:       m.Hash,
+		ExpiresAt:  m.ExpiresAt,
+		LastUsedAt: m.LastUsedAt,
+		CreatedAt:  m.CreatedAt.Unix(),
+	}
+	if m.Scopes != "" {
+		e.Scopes = strings.Split(m.Scopes, ",")
+	}
+	return e
+}
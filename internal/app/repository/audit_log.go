@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	ient "go-scaffold/internal/pkg/ent"
+	"go-scaffold/internal/pkg/ent/ent"
+	"go-scaffold/internal/pkg/ent/ent/auditlog"
+)
+
+var _ AuditLogRepositoryInterface = (*AuditLogRepository)(nil)
+
+type (
+	AuditLogFindListParam struct {
+		TenantID int64
+		ActorID  int64
+		UserID   int64
+		Limit    int
+	}
+
+	AuditLogRepositoryInterface interface {
+		// Filter returns the latest audit logs
+		Filter(ctx context.Context, param AuditLogFindListParam) ([]*domain.AuditLog, error)
+		Create(ctx context.Context, e domain.AuditLog) (*domain.AuditLog, error)
+	}
+)
+
+type AuditLogRepository struct {
+	client *ient.DefaultClient
+}
+
+func NewAuditLogRepository(client *ient.DefaultClient) *AuditLogRepository {
+	return &AuditLogRepository{
+		client: client,
+	}
+}
+
+func (r *AuditLogRepository) Filter(ctx context.Context, param AuditLogFindListParam) ([]*domain.AuditLog, error) {
+	query := r.client.AuditLog.Query()
+
+	if param.TenantID != 0 {
+		query.Where(auditlog.TenantIDEQ(param.TenantID))
+	}
+	if param.ActorID != 0 {
+		query.Where(auditlog.ActorIDEQ(param.ActorID))
+	}
+	if param.UserID != 0 {
+		query.Where(auditlog.UserIDEQ(param.UserID))
+	}
+	if param.Limit > 0 {
+		query.Limit(param.Limit)
+	}
+
+	list, err := query.
+		Order(ent.Desc(auditlog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	entities := make([]*domain.AuditLog, 0, len(list))
+	for _, i := range list {
+		entities = append(entities, (&auditLogModel{i}).toEntity())
+	}
+
+	return entities, nil
+}
+
+func (r *AuditLogRepository) Create(ctx context.Context, e domain.AuditLog) (*domain.AuditLog, error) {
+	m, err := r.client.AuditLog.Create().
+		SetTenantID(e.TenantID).
+		SetActorID(e.ActorID).
+		SetUserID(e.UserID).
+		SetAction(e.Action.String()).
+		SetMethod(e.Method).
+		SetPath(e.Path).
+		SetStatus(e.Status).
+		SetIP(e.IP).
+		SetUserAgent(e.UserAgent).
+		SetDetail(e.Detail).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&auditLogModel{m}).toEntity(), nil
+}
+
+type auditLogModel struct {
+	*ent.AuditLog
+}
+
+func (m *auditLogModel) toEntity() *domain.AuditLog {
+	return &domain.AuditLog{
+		ID:        m.ID,
+		TenantID:  m.TenantID,
+		ActorID:   m.ActorID,
+		UserID:    m.UserID,
+		Action:    domain.AuditAction(m.Action),
+		Method:    m.Method,
+		Path:      m.Path,
+		Status:    m.Status,
+		IP:        m.IP,
+		UserAgent: m.UserAgent,
+		Detail:    m.Detail,
+		CreatedAt: m.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	iredis "go-scaffold/internal/pkg/redis"
+)
+
+var _ LoginAttemptRepositoryInterface = (*LoginAttemptRepository)(nil)
+
+type LoginAttemptRepositoryInterface interface {
+	// FindOne returns the attempts of the subject, which is empty if there is no failure
+	FindOne(ctx context.Context, subject domain.LoginAttemptSubject) (*domain.LoginAttempts, error)
+	// Fail record a failed attempt of the subject, the failures are kept for the window
+	Fail(ctx context.Context, subject domain.LoginAttemptSubject, window time.Duration) (*domain.LoginAttempts, error)
+	Lock(ctx context.Context, subject domain.LoginAttemptSubject, until time.Time) error
+	Reset(ctx context.Context, subject domain.LoginAttemptSubject) error
+}
+
+// failLoginAttemptScript never shorten the expiry of a lockout
+var failLoginAttemptScript = redis.NewScript(`
+local failures = redis.call('HINCRBY', KEYS[1], 'failures', 1)
+redis.call('HSET', KEYS[1], 'last_failed_at', ARGV[1])
+if redis.call('TTL', KEYS[1]) < tonumber(ARGV[2]) then
+	redis.call('EXPIRE', KEYS[1], ARGV[2])
+end
+return redis.call('HGETALL', KEYS[1])
+`)
+
+type LoginAttemptRepository struct {
+	rdb *iredis.DefaultRedis
+}
+
+func NewLoginAttemptRepository(rdb *iredis.DefaultRedis) *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		rdb: rdb,
+	}
+}
+
+func (r *LoginAttemptRepository) FindOne(ctx context.Context, subject domain.LoginAttemptSubject) (*domain.LoginAttempts, error) {
+	values, err := r.rdb.HGetAll(ctx, loginAttemptKey(subject)).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	m := &loginAttemptModel{values}
+	return m.toEntity()
+}
+
+func (r *LoginAttemptRepository) Fail(ctx context.Context, subject domain.LoginAttemptSubject, window time.Duration) (*domain.LoginAttempts, error) {
+	ret, err := failLoginAttemptScript.Run(ctx, r.rdb,
+		[]string{loginAttemptKey(subject)},
+		time.Now().Unix(),
+		int64(window.Seconds()),
+	).StringSlice()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	values := make(map[string]string, len(ret)/2)
+	for i := 0; i+1 < len(ret); i += 2 {
+		values[ret[i]] = ret[i+1]
+	}
+
+	m := &loginAttemptModel{values}
+	return m.toEntity()
+}
+
+func (r *LoginAttemptRepository) Lock(ctx context.Context, subject domain.LoginAttemptSubject, until time.Time) error {
+	key := loginAttemptKey(subject)
+
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, "locked_until", until.Unix())
+		pipe.ExpireAt(ctx, key, until)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *LoginAttemptRepository) Reset(ctx context.Context, subject domain.LoginAttemptSubject) error {
+	return errors.WithStack(r.rdb.Del(ctx, loginAttemptKey(subject)).Err())
+}
+
+func loginAttemptKey(subject domain.LoginAttemptSubject) string {
+	return fmt.Sprintf("account:login_attempts:%s", subject)
+}
+
+type loginAttemptModel struct {
+	values map[string]string
+}
+
+func (m *loginAttemptModel) toEntity() (*domain.LoginAttempts, error) {
+	e := new(domain.LoginAttempts)
+
+	if v, ok := m.values["failures"]; ok {
+		failures, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		e.Failures = failures
+	}
+
+	for field, t := range map[string]*time.Time{
+		"last_failed_at": &e.LastFailedAt,
+		"locked_until":   &e.LockedUntil,
+	} {
+		v, ok := m.values[field]
+		if !ok {
+			continue
+		}
+		unix, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		*t = time.Unix(unix, 0)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	iredis "go-scaffold/internal/pkg/redis"
+)
+
+var _ LoginChallengeRepositoryInterface = (*LoginChallengeRepository)(nil)
+
+type LoginChallengeRepositoryInterface interface {
+	FindOne(ctx context.Context, token string) (*domain.LoginChallenge, error)
+	Create(ctx context.Context, e domain.LoginChallenge) error
+	// IncrAttempts increase the number of verification attempts of the challenge
+	IncrAttempts(ctx context.Context, token string) (int64, error)
+	Delete(ctx context.Context, token string) error
+}
+
+// incrChallengeAttemptsScript never recreate an expired challenge
+var incrChallengeAttemptsScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+return redis.call('HINCRBY', KEYS[1], 'attempts', 1)
+`)
+
+type LoginChallengeRepository struct {
+	rdb *iredis.DefaultRedis
+}
+
+func NewLoginChallengeRepository(rdb *iredis.DefaultRedis) *LoginChallengeRepository {
+	return &LoginChallengeRepository{
+		rdb: rdb,
+	}
+}
+
+func (r *LoginChallengeRepository) FindOne(ctx context.Context, token string) (*domain.LoginChallenge, error) {
+	values, err := r.rdb.HGetAll(ctx, loginChallengeKey(token)).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(values) == 0 {
+		return nil, errors.WithStack(ErrRecordNotFound)
+	}
+
+	m := &loginChallengeModel{values}
+	return m.toEntity(token)
+}
+
+func (r *LoginChallengeRepository) Create(ctx context.Context, e domain.LoginChallenge) error {
+	key := loginChallengeKey(e.Token)
+
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"user_id", e.UserID,
+			"device", e.Client.Device,
+			"ip", e.Client.IP,
+			"user_agent", e.Client.UserAgent,
+			"enroll_required", e.EnrollRequired,
+			"expires_at", e.ExpiresAt.Unix(),
+			"attempts", 0,
+		)
+		pipe.ExpireAt(ctx, key, e.ExpiresAt)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *LoginChallengeRepository) IncrAttempts(ctx context.Context, token string) (int64, error) {
+	ret, err := incrChallengeAttemptsScript.Run(ctx, r.rdb, []string{loginChallengeKey(token)}).Int64()
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if ret < 0 {
+		return 0, errors.WithStack(ErrRecordNotFound)
+	}
+	return ret, nil
+}
+
+func (r *LoginChallengeRepository) Delete(ctx context.Context, token string) error {
+	return errors.WithStack(r.rdb.Del(ctx, loginChallengeKey(token)).Err())
+}
+
+// loginChallengeKey the token is stored as sha256 digest, never in plaintext
+func loginChallengeKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("account:login_challenge:%s", hex.EncodeToString(sum[:]))
+}
+
+type loginChallengeModel struct {
+	values map[string]string
+}
+
+func (m *loginChallengeModel) toEntity(token string) (*domain.LoginChallenge, error) {
+	userID, err := strconv.ParseInt(m.values["user_id"], 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	expiresAt, err := strconv.ParseInt(m.values["expires_at"], 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	enrollRequired, err := strconv.ParseBool(m.values["enroll_required"])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.LoginChallenge{
+		Token:  token,
+		UserID: userID,
+		Client: domain.SessionClient{
+			Device:    m.values["device"],
+			IP:        m.values["ip"],
+			UserAgent: m.values["user_agent"],
+		},
+		EnrollRequired: enrollRequired,
+		ExpiresAt:      time.Unix(expiresAt, 0),
+	}, nil
+}
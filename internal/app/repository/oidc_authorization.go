@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	iredis "go-scaffold/internal/pkg/redis"
+)
+
+var _ OIDCAuthorizationRepositoryInterface = (*OIDCAuthorizationRepository)(nil)
+
+type OIDCAuthorizationRepositoryInterface interface {
+	Create(ctx context.Context, e domain.OIDCAuthorization) error
+	// Consume get and delete the authorization, so that the state can only be used once
+	Consume(ctx context.Context, state string) (*domain.OIDCAuthorization, error)
+}
+
+// consumeOIDCAuthorizationScript get and delete, GETDEL is not available before Redis 6.2
+var consumeOIDCAuthorizationScript = redis.NewScript(`
+local value = redis.call('GET', KEYS[1])
+if value then
+	redis.call('DEL', KEYS[1])
+end
+return value
+`)
+
+type OIDCAuthorizationRepository struct {
+	rdb *iredis.DefaultRedis
+}
+
+func NewOIDCAuthorizationRepository(rdb *iredis.DefaultRedis) *OIDCAuthorizationRepository {
+	return &OIDCAuthorizationRepository{
+		rdb: rdb,
+	}
+}
+
+func (r *OIDCAuthorizationRepository) Create(ctx context.Context, e domain.OIDCAuthorization) error {
+	value, err := json.Marshal(e)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := oidcAuthorizationKey(e.State)
+
+	_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, key, value, 0)
+		pipe.ExpireAt(ctx, key, e.ExpiresAt)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *OIDCAuthorizationRepository) Consume(ctx context.Context, state string) (*domain.OIDCAuthorization, error) {
+	value, err := consumeOIDCAuthorizationScript.Run(ctx, r.rdb, []string{oidcAuthorizationKey(state)}).Text()
+	if errors.Is(err, redis.Nil) {
+		return nil, errors.WithStack(ErrRecordNotFound)
+	} else if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	e := new(domain.OIDCAuthorization)
+	if err := json.Unmarshal([]byte(value), e); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return e, nil
+}
+
+func oidcAuthorizationKey(state string) string {
+	return fmt.Sprintf("oidc:authorization:%s", state)
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+)
+
+// pageQuery the ent query of the list that is paginated
+type pageQuery[Q any, P, O ~func(*sql.Selector), E any] interface {
+	Clone() Q
+	Where(...P) Q
+	Order(...O) Q
+	Offset(int) Q
+	Limit(int) Q
+	Count(context.Context) (int, error)
+	All(context.Context) ([]E, error)
+}
+
+// sortField the column that the list is sorted by, the value of the column is kept in the cursor
+type sortField[E any] struct {
+	column string
+	value  func(E) any // int64 or string
+}
+
+// sortFields the sort fields of the list keyed by the names of domain.Pagination.Sort,
+// they must include domain.SortFieldID, the id is the tie breaker of the rows of the same value
+type sortFields[E any] map[string]sortField[E]
+
+// pageCursor the keyset of the last row of the page
+type pageCursor struct {
+	Sort  string           `json:"s"`
+	Order domain.SortOrder `json:"o"`
+	Value any              `json:"v"`
+	ID    int64            `json:"id"`
+}
+
+func (c pageCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageCursor(s string) (*pageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.WithStack(domain.ErrInvalidCursor)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	c := &pageCursor{}
+	if err := decoder.Decode(c); err != nil {
+		return nil, errors.WithStack(domain.ErrInvalidCursor)
+	}
+
+	// the numbers are kept as int64
+	switch v := c.Value.(type) {
+	case json.Number:
+		if c.Value, err = v.Int64(); err != nil {
+			return nil, errors.WithStack(domain.ErrInvalidCursor)
+		}
+	case string:
+	default:
+		return nil, errors.WithStack(domain.ErrInvalidCursor)
+	}
+
+	return c, nil
+}
+
+// paginate returns the page of the rows of the query, and the number of all the rows of the query,
+// the rows are sorted by the sort field and then the id, in the same order
+func paginate[Q pageQuery[Q, P, O, E], P, O ~func(*sql.Selector), E any](
+	ctx context.Context,
+	query Q,
+	page domain.Pagination,
+	fields sortFields[E],
+) (*domain.Page[E], error) {
+	if page.Sort == "" {
+		page.Sort = domain.SortFieldUpdatedAt
+	}
+	if page.Order == "" {
+		page.Order = domain.SortOrderDesc
+	}
+
+	field, ok := fields[page.Sort]
+	if !ok {
+		return nil, errors.Wrap(domain.ErrInvalidSortField, page.Sort)
+	}
+
+	var cursor *pageCursor
+	if page.Cursor != "" {
+		c, err := decodePageCursor(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.Sort != page.Sort || c.Order != page.Order {
+			return nil, errors.Wrap(domain.ErrInvalidCursor, "the cursor is issued for another sorting")
+		}
+		cursor = c
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	order, compare := sql.Desc, sql.LT
+	if page.Order == domain.SortOrderAsc {
+		order, compare = sql.Asc, sql.GT
+	}
+
+	if cursor != nil {
+		query = query.Where(func(s *sql.Selector) {
+			s.Where(sql.Or(
+				compare(s.C(field.column), cursor.Value),
+				sql.And(
+					sql.EQ(s.C(field.column), cursor.Value),
+					compare(s.C("id"), cursor.ID),
+				),
+			))
+		})
+	} else if page.Offset > 0 {
+		query = query.Offset(page.Offset)
+	}
+
+	query = query.Order(func(s *sql.Selector) {
+		s.OrderBy(order(s.C(field.column)), order(s.C("id")))
+	})
+
+	// one more row is queried to tell whether there is the next page
+	if page.Limit > 0 {
+		query = query.Limit(page.Limit + 1)
+	}
+
+	list, err := query.All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	result := &domain.Page[E]{Items: list, Total: total}
+	if page.Limit > 0 && len(list) > page.Limit {
+		result.Items = list[:page.Limit]
+
+		last := result.Items[page.Limit-1]
+		result.NextCursor = pageCursor{
+			Sort:  page.Sort,
+			Order: page.Order,
+			Value: field.value(last),
+			ID:    fields[domain.SortFieldID].value(last).(int64),
+		}.encode()
+	}
+
+	return result, nil
+}
@@ -8,9 +8,13 @@ import (
 	"github.com/pkg/errors"
 
 	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/config"
+	icasbin "go-scaffold/internal/pkg/casbin"
 	ient "go-scaffold/internal/pkg/ent"
 	"go-scaffold/internal/pkg/ent/ent"
 	"go-scaffold/internal/pkg/ent/ent/permission"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
+	"go-scaffold/pkg/lru"
 )
 
 var _ PermissionRepositoryInterface = (*PermissionRepository)(nil)
@@ -21,7 +25,10 @@ type (
 	}
 
 	PermissionRepositoryInterface interface {
+		// Filter returns all the permissions that match the filter
 		Filter(ctx context.Context, param PermissionFindListParam) ([]*domain.Permission, error)
+		// Paginate returns the page of the permissions that match the filter
+		Paginate(ctx context.Context, param PermissionFindListParam, page domain.Pagination) (*domain.Page[*domain.Permission], error)
 		FindList(ctx context.Context, idList []int64) ([]*domain.Permission, error)
 		FindOne(ctx context.Context, id int64) (*domain.Permission, error)
 		FindOneByKey(ctx context.Context, key string) (*domain.Permission, error)
@@ -32,34 +39,41 @@ type (
 		Create(ctx context.Context, e domain.Permission) error
 		Update(ctx context.Context, e domain.Permission) error
 		Delete(ctx context.Context, e domain.Permission) error
+		// KeyCacheStats returns the statistics of the cache of FindOneByKey
+		KeyCacheStats() lru.Stats
 	}
 )
 
 type PermissionRepository struct {
 	client   *ient.DefaultClient
-	enforcer *casbin.Enforcer
+	enforcer *casbin.SyncedEnforcer
+	uow      *UnitOfWork
+	// keys the permissions found by the keys, they are invalidated by the changes of the permissions,
+	// the changes made by the other replicas are seen after the entries expire
+	keys *lru.Cache[string, domain.Permission]
 }
 
-func NewPermissionRepository(client *ient.DefaultClient, enforcer *casbin.Enforcer) *PermissionRepository {
+func NewPermissionRepository(
+	client *ient.DefaultClient,
+	enforcer *casbin.SyncedEnforcer,
+	uow *UnitOfWork,
+	casbinConf config.Casbin,
+) (*PermissionRepository, error) {
+	keys, err := icasbin.NewCache[string, domain.Permission](casbinConf.Cache)
+	if err != nil {
+		return nil, err
+	}
+
 	return &PermissionRepository{
 		client:   client,
 		enforcer: enforcer,
-	}
+		uow:      uow,
+		keys:     keys,
+	}, nil
 }
 
 func (r *PermissionRepository) Filter(ctx context.Context, param PermissionFindListParam) ([]*domain.Permission, error) {
-	query := r.client.Permission.Query()
-
-	if param.Keyword != "" {
-		query.Where(
-			permission.Or(
-				permission.NameContains(param.Keyword),
-				permission.DescContains(param.Keyword),
-			),
-		)
-	}
-
-	list, err := query.
+	list, err := r.filterQuery(ctx, param).
 		Order(ent.Desc(permission.FieldUpdatedAt)).
 		All(ctx)
 	if err != nil {
@@ -74,8 +88,47 @@ func (r *PermissionRepository) Filter(ctx context.Context, param PermissionFindL
 	return entities, nil
 }
 
+func (r *PermissionRepository) Paginate(ctx context.Context, param PermissionFindListParam, page domain.Pagination) (*domain.Page[*domain.Permission], error) {
+	result, err := paginate[*ent.PermissionQuery, predicate.Permission, permission.OrderOption](ctx, r.filterQuery(ctx, param), page, permissionSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]*domain.Permission, 0, len(result.Items))
+	for _, i := range result.Items {
+		entities = append(entities, (&permissionModel{i}).toEntity())
+	}
+
+	return &domain.Page[*domain.Permission]{Items: entities, Total: result.Total, NextCursor: result.NextCursor}, nil
+}
+
+// filterQuery the query of the permissions that match the filter
+func (r *PermissionRepository) filterQuery(ctx context.Context, param PermissionFindListParam) *ent.PermissionQuery {
+	query := clientFromContext(ctx, r.client).Permission.Query()
+
+	if param.Keyword != "" {
+		query.Where(
+			permission.Or(
+				permission.NameContains(param.Keyword),
+				permission.DescContains(param.Keyword),
+			),
+		)
+	}
+
+	return query
+}
+
+// permissionSortFields the columns of domain.PermissionSortFields
+var permissionSortFields = sortFields[*ent.Permission]{
+	domain.SortFieldID:        {permission.FieldID, func(m *ent.Permission) any { return m.ID }},
+	"key":                     {permission.FieldKey, func(m *ent.Permission) any { return m.Key }},
+	"name":                    {permission.FieldName, func(m *ent.Permission) any { return m.Name }},
+	domain.SortFieldCreatedAt: {permission.FieldCreatedAt, func(m *ent.Permission) any { return m.CreatedAt.Unix() }},
+	domain.SortFieldUpdatedAt: {permission.FieldUpdatedAt, func(m *ent.Permission) any { return m.UpdatedAt.Unix() }},
+}
+
 func (r *PermissionRepository) FindList(ctx context.Context, idList []int64) ([]*domain.Permission, error) {
-	data, err := r.client.Permission.Query().
+	data, err := clientFromContext(ctx, r.client).Permission.Query().
 		Where(permission.IDIn(idList...)).
 		All(ctx)
 	if err != nil {
@@ -90,33 +143,45 @@ func (r *PermissionRepository) FindList(ctx context.Context, idList []int64) ([]
 }
 
 func (r *PermissionRepository) FindOne(ctx context.Context, id int64) (*domain.Permission, error) {
-	m, err := r.client.Permission.Get(ctx, id)
+	m, err := clientFromContext(ctx, r.client).Permission.Get(ctx, id)
 	if err != nil {
 		return nil, errors.WithStack(handleError(err))
 	}
 	return (&permissionModel{m}).toEntity(), nil
 }
 
+// FindOneByKey the permission is cached, the key that is not found or is found in a unit of work is not cached
 func (r *PermissionRepository) FindOneByKey(ctx context.Context, key string) (*domain.Permission, error) {
-	m, err := r.client.Permission.Query().Where(permission.KeyEQ(key)).Only(ctx)
+	if e, ok := r.keys.Get(key); ok {
+		return &e, nil
+	}
+
+	m, err := clientFromContext(ctx, r.client).Permission.Query().Where(permission.KeyEQ(key)).Only(ctx)
 	if err != nil {
 		return nil, errors.WithStack(handleError(err))
 	}
-	return (&permissionModel{m}).toEntity(), nil
+
+	e := (&permissionModel{m}).toEntity()
+	if !inUnitOfWork(ctx) {
+		// the permission created in the unit of work is gone if the unit of work is rolled back
+		r.keys.Add(key, *e)
+	}
+
+	return e, nil
 }
 
 func (r *PermissionRepository) Exist(ctx context.Context, id int64) (bool, error) {
-	exist, err := r.client.Permission.Query().Where(permission.IDEQ(id)).Exist(ctx)
+	exist, err := clientFromContext(ctx, r.client).Permission.Query().Where(permission.IDEQ(id)).Exist(ctx)
 	return exist, errors.WithStack(handleError(err))
 }
 
 func (r *PermissionRepository) KeyExist(ctx context.Context, key string) (bool, error) {
-	exist, err := r.client.Permission.Query().Where(permission.KeyEQ(key)).Exist(ctx)
+	exist, err := clientFromContext(ctx, r.client).Permission.Query().Where(permission.KeyEQ(key)).Exist(ctx)
 	return exist, errors.WithStack(handleError(err))
 }
 
 func (r *PermissionRepository) KeyExistExcludeID(ctx context.Context, key string, excludeID int64) (bool, error) {
-	exist, err := r.client.Permission.Query().Where(
+	exist, err := clientFromContext(ctx, r.client).Permission.Query().Where(
 		permission.KeyEQ(key),
 		permission.IDNEQ(excludeID),
 	).Exist(ctx)
@@ -124,38 +189,63 @@ func (r *PermissionRepository) KeyExistExcludeID(ctx context.Context, key string
 }
 
 func (r *PermissionRepository) HasChild(ctx context.Context, id int64) (bool, error) {
-	count, err := r.client.Permission.Query().Where(permission.ParentIDEQ(id)).Count(ctx)
+	count, err := clientFromContext(ctx, r.client).Permission.Query().Where(permission.ParentIDEQ(id)).Count(ctx)
 	return count > 0, errors.WithStack(handleError(err))
 }
 
 func (r *PermissionRepository) Create(ctx context.Context, e domain.Permission) error {
-	_, err := r.client.Permission.Create().
+	_, err := clientFromContext(ctx, r.client).Permission.Create().
 		SetKey(e.Key).
 		SetName(e.Name).
 		SetDesc(e.Desc).
 		SetParentID(e.ParentID).
 		Save(ctx)
-	return errors.WithStack(handleError(err))
+	if err != nil {
+		return errors.WithStack(handleError(err))
+	}
+
+	r.keys.Remove(e.Key)
+	return nil
 }
 
 func (r *PermissionRepository) Update(ctx context.Context, e domain.Permission) error {
-	_, err := r.client.Permission.
+	_, err := clientFromContext(ctx, r.client).Permission.
 		UpdateOneID(e.ID).
 		SetKey(e.Key).
 		SetName(e.Name).
 		SetDesc(e.Desc).
 		SetParentID(e.ParentID).
 		Save(ctx)
-	return errors.WithStack(handleError(err))
+	if err != nil {
+		return errors.WithStack(handleError(err))
+	}
+
+	// the previous key of the permission is not known
+	r.keys.Purge()
+	return nil
 }
 
 func (r *PermissionRepository) Delete(ctx context.Context, e domain.Permission) error {
-	_, err := r.enforcer.DeletePermission(fmt.Sprintf("%d", e.ID))
-	if err != nil {
-		return errors.WithStack(err)
-	}
+	defer r.keys.Purge()
+
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		// the permission is the object of the policies, DeletePermission filters the field after the subject
+		_, err := enforcer.RemoveFilteredPolicy(2, fmt.Sprintf("%d", e.ID))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, err = enforcer.RemoveFilteredNamedPolicy(icasbin.ConditionPolicyType, 2, fmt.Sprintf("%d", e.ID))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		return errors.WithStack(client.Permission.DeleteOneID(e.ID).Exec(ctx))
+	})
+}
 
-	return errors.WithStack(r.client.Permission.DeleteOneID(e.ID).Exec(ctx))
+func (r *PermissionRepository) KeyCacheStats() lru.Stats {
+	return r.keys.Stats()
 }
 
 type permissionModel struct {
@@ -8,6 +8,11 @@ import (
 	"github.com/pkg/errors"
 )
 
+// GetPolicyDomain the casbin domain of the tenant, the roles and permissions are granted within the domain
+func GetPolicyDomain(tenantID int64) string {
+	return fmt.Sprintf("tenant_%d", tenantID)
+}
+
 func GetPolicyUser(userID int64) string {
 	return fmt.Sprintf("user_%d", userID)
 }
@@ -25,6 +30,11 @@ func GetPolicyRole(roleID int64) string {
 	return fmt.Sprintf("role_%d", roleID)
 }
 
+// IsPolicyRole reports whether the policy subject is a role
+func IsPolicyRole(sub string) bool {
+	return strings.HasPrefix(sub, "role_")
+}
+
 func FromPolicyRole(role string) (int64, error) {
 	rs := strings.Trim(role, "role_")
 	roleID, err := strconv.ParseInt(rs, 10, 64)
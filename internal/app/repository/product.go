@@ -8,6 +8,7 @@ import (
 	"go-scaffold/internal/app/domain"
 	ient "go-scaffold/internal/pkg/ent"
 	"go-scaffold/internal/pkg/ent/ent"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
 	"go-scaffold/internal/pkg/ent/ent/product"
 )
 
@@ -18,8 +19,12 @@ type (
 		Keyword string
 	}
 
+	// ProductRepositoryInterface the products are isolated by the tenant that the context carries
 	ProductRepositoryInterface interface {
+		// Filter returns all the products that match the filter
 		Filter(ctx context.Context, param ProductFindListParam) ([]*domain.Product, error)
+		// Paginate returns the page of the products that match the filter
+		Paginate(ctx context.Context, param ProductFindListParam, page domain.Pagination) (*domain.Page[*domain.Product], error)
 		FindOne(ctx context.Context, id int64) (*domain.Product, error)
 		Exist(ctx context.Context, id int64) (bool, error)
 		Create(ctx context.Context, e domain.Product) error
@@ -39,18 +44,7 @@ func NewProductRepository(client *ient.DefaultClient) *ProductRepository {
 }
 
 func (r *ProductRepository) Filter(ctx context.Context, param ProductFindListParam) ([]*domain.Product, error) {
-	query := r.client.Product.Query()
-
-	if param.Keyword != "" {
-		query.Where(
-			product.Or(
-				product.NameContains(param.Keyword),
-				product.DescContains(param.Keyword),
-			),
-		)
-	}
-
-	list, err := query.
+	list, err := r.filterQuery(ctx, param).
 		Order(ent.Desc(product.FieldUpdatedAt)).
 		All(ctx)
 	if err != nil {
@@ -65,8 +59,49 @@ func (r *ProductRepository) Filter(ctx context.Context, param ProductFindListPar
 	return entities, nil
 }
 
+func (r *ProductRepository) Paginate(ctx context.Context, param ProductFindListParam, page domain.Pagination) (*domain.Page[*domain.Product], error) {
+	result, err := paginate[*ent.ProductQuery, predicate.Product, product.OrderOption](ctx, r.filterQuery(ctx, param), page, productSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]*domain.Product, 0, len(result.Items))
+	for _, i := range result.Items {
+		entities = append(entities, (&productModel{i}).toEntity())
+	}
+
+	return &domain.Page[*domain.Product]{Items: entities, Total: result.Total, NextCursor: result.NextCursor}, nil
+}
+
+// filterQuery the query of the products that match the filter
+func (r *ProductRepository) filterQuery(ctx context.Context, param ProductFindListParam) *ent.ProductQuery {
+	query := r.client.Product.Query().Where(product.TenantIDEQ(domain.TenantFromContext(ctx)))
+
+	if param.Keyword != "" {
+		query.Where(
+			product.Or(
+				product.NameContains(param.Keyword),
+				product.DescContains(param.Keyword),
+			),
+		)
+	}
+
+	return query
+}
+
+// productSortFields the columns of domain.ProductSortFields
+var productSortFields = sortFields[*ent.Product]{
+	domain.SortFieldID:        {product.FieldID, func(m *ent.Product) any { return m.ID }},
+	"name":                    {product.FieldName, func(m *ent.Product) any { return m.Name }},
+	"price":                   {product.FieldPrice, func(m *ent.Product) any { return int64(m.Price) }},
+	domain.SortFieldCreatedAt: {product.FieldCreatedAt, func(m *ent.Product) any { return m.CreatedAt.Unix() }},
+	domain.SortFieldUpdatedAt: {product.FieldUpdatedAt, func(m *ent.Product) any { return m.UpdatedAt.Unix() }},
+}
+
 func (r *ProductRepository) FindOne(ctx context.Context, id int64) (*domain.Product, error) {
-	m, err := r.client.Product.Get(ctx, id)
+	m, err := r.client.Product.Query().
+		Where(product.IDEQ(id), product.TenantIDEQ(domain.TenantFromContext(ctx))).
+		Only(ctx)
 	if err != nil {
 		return nil, errors.WithStack(handleError(err))
 	}
@@ -74,31 +109,53 @@ func (r *ProductRepository) FindOne(ctx context.Context, id int64) (*domain.Prod
 }
 
 func (r *ProductRepository) Exist(ctx context.Context, id int64) (bool, error) {
-	exist, err := r.client.Product.Query().Where(product.IDEQ(id)).Exist(ctx)
+	exist, err := r.client.Product.Query().
+		Where(product.IDEQ(id), product.TenantIDEQ(domain.TenantFromContext(ctx))).
+		Exist(ctx)
 	return exist, errors.WithStack(handleError(err))
 }
 
 func (r *ProductRepository) Create(ctx context.Context, e domain.Product) error {
 	_, err := r.client.Product.Create().
+		SetTenantID(domain.TenantFromContext(ctx)).
 		SetName(e.Name).
 		SetDesc(e.Desc).
 		SetPrice(e.Price).
+		SetOwnerID(e.OwnerID).
+		SetDepartmentID(e.DepartmentID).
 		Save(ctx)
 	return errors.WithStack(handleError(err))
 }
 
 func (r *ProductRepository) Update(ctx context.Context, e domain.Product) error {
-	_, err := r.client.Product.
-		UpdateOneID(e.ID).
+	n, err := r.client.Product.
+		Update().
+		Where(product.IDEQ(e.ID), product.TenantIDEQ(domain.TenantFromContext(ctx))).
 		SetName(e.Name).
 		SetDesc(e.Desc).
 		SetPrice(e.Price).
 		Save(ctx)
-	return errors.WithStack(handleError(err))
+	if err != nil {
+		return errors.WithStack(handleError(err))
+	}
+	if n == 0 {
+		return errors.WithStack(ErrRecordNotFound)
+	}
+	return nil
 }
 
 func (r *ProductRepository) Delete(ctx context.Context, e domain.Product) error {
-	return errors.WithStack(r.client.Product.DeleteOneID(e.ID).Exec(ctx))
+	n, err := r.client.Product.
+		Delete().
+		Where(product.IDEQ(e.ID), product.TenantIDEQ(domain.TenantFromContext(ctx))).
+		Exec(ctx)
+	if err != nil {
+		return errors.WithStack(handleError(err))
+	}
+	if n == 0 {
+		return errors.WithStack(ErrRecordNotFound)
+	}
+	return nil
 }
 
 type productModel struct {
@@ -107,9 +164,12 @@ type productModel struct {
 
 func (m *productModel) toEntity() *domain.Product {
 	return &domain.Product{
-		ID:    m.ID,
-		Name:  m.Name,
-		Desc:  m.Desc,
-		Price: m.Price,
+		ID:           m.ID,
+		TenantID:     m.TenantID,
+		Name:         m.Name,
+		Desc:         m.Desc,
+		Price:        m.Price,
+		OwnerID:      m.OwnerID,
+		DepartmentID: m.DepartmentID,
 	}
 }
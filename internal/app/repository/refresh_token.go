@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	iredis "go-scaffold/internal/pkg/redis"
+)
+
+var _ RefreshTokenRepositoryInterface = (*RefreshTokenRepository)(nil)
+
+type RefreshTokenRepositoryInterface interface {
+	FindOne(ctx context.Context, token string) (*domain.RefreshToken, error)
+	Create(ctx context.Context, e domain.RefreshToken) error
+	// MarkRotated mark the refresh token as exchanged,
+	// false is returned if it has been exchanged before
+	MarkRotated(ctx context.Context, token string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+}
+
+// markRotatedScript set the rotated time only once, and never recreate an expired token
+var markRotatedScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+return redis.call('HSETNX', KEYS[1], 'rotated_at', ARGV[1])
+`)
+
+type RefreshTokenRepository struct {
+	rdb *iredis.DefaultRedis
+}
+
+func NewRefreshTokenRepository(rdb *iredis.DefaultRedis) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		rdb: rdb,
+	}
+}
+
+func (r *RefreshTokenRepository) FindOne(ctx context.Context, token string) (*domain.RefreshToken, error) {
+	values, err := r.rdb.HGetAll(ctx, refreshTokenKey(token)).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(values) == 0 {
+		return nil, errors.WithStack(ErrRecordNotFound)
+	}
+
+	m := &refreshTokenModel{values}
+	return m.toEntity(token)
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, e domain.RefreshToken) error {
+	key := refreshTokenKey(e.Token)
+	familyKey := refreshTokenFamilyKey(e.FamilyID)
+
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"user_id", e.UserID,
+			"family_id", e.FamilyID,
+			"expires_at", e.ExpiresAt.Unix(),
+		)
+		pipe.ExpireAt(ctx, key, e.ExpiresAt)
+		pipe.SAdd(ctx, familyKey, key)
+		pipe.ExpireAt(ctx, familyKey, e.ExpiresAt)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *RefreshTokenRepository) MarkRotated(ctx context.Context, token string) (bool, error) {
+	ret, err := markRotatedScript.Run(ctx, r.rdb, []string{refreshTokenKey(token)}, time.Now().Unix()).Int()
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	if ret < 0 {
+		return false, errors.WithStack(ErrRecordNotFound)
+	}
+	return ret == 1, nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	familyKey := refreshTokenFamilyKey(familyID)
+
+	keys, err := r.rdb.SMembers(ctx, familyKey).Result()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(r.rdb.Del(ctx, append(keys, familyKey)...).Err())
+}
+
+// refreshTokenKey the token is stored as sha256 digest, never in plaintext
+func refreshTokenKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("account:refresh_token:%s", hex.EncodeToString(sum[:]))
+}
+
+func refreshTokenFamilyKey(familyID string) string {
+	return fmt.Sprintf("account:refresh_token_family:%s", familyID)
+}
+
+type refreshTokenModel struct {
+	values map[string]string
+}
+
+func (m *refreshTokenModel) toEntity(token string) (*domain.RefreshToken, error) {
+	userID, err := strconv.ParseInt(m.values["user_id"], 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	expiresAt, err := strconv.ParseInt(m.values["expires_at"], 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	e := &domain.RefreshToken{
+		Token:     token,
+		UserID:    userID,
+		FamilyID:  m.values["family_id"],
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+
+	if v, ok := m.values["rotated_at"]; ok {
+		rotatedAt, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		e.RotatedAt = time.Unix(rotatedAt, 0)
+	}
+
+	return e, nil
+}
@@ -11,10 +11,21 @@ import (
 )
 
 var ProviderSet = wire.NewSet(
+	wire.NewSet(wire.Bind(new(TransactionInterface), new(*UnitOfWork)), NewUnitOfWork),
 	wire.NewSet(wire.Bind(new(UserRepositoryInterface), new(*UserRepository)), NewUserRepository),
 	wire.NewSet(wire.Bind(new(RoleRepositoryInterface), new(*RoleRepository)), NewRoleRepository),
 	wire.NewSet(wire.Bind(new(PermissionRepositoryInterface), new(*PermissionRepository)), NewPermissionRepository),
 	wire.NewSet(wire.Bind(new(ProductRepositoryInterface), new(*ProductRepository)), NewProductRepository),
+	wire.NewSet(wire.Bind(new(APIKeyRepositoryInterface), new(*APIKeyRepository)), NewAPIKeyRepository),
+	wire.NewSet(wire.Bind(new(UserIdentityRepositoryInterface), new(*UserIdentityRepository)), NewUserIdentityRepository),
+	wire.NewSet(wire.Bind(new(AuditLogRepositoryInterface), new(*AuditLogRepository)), NewAuditLogRepository),
+	wire.NewSet(wire.Bind(new(RefreshTokenRepositoryInterface), new(*RefreshTokenRepository)), NewRefreshTokenRepository),
+	wire.NewSet(wire.Bind(new(SessionRepositoryInterface), new(*SessionRepository)), NewSessionRepository),
+	wire.NewSet(wire.Bind(new(LoginChallengeRepositoryInterface), new(*LoginChallengeRepository)), NewLoginChallengeRepository),
+	wire.NewSet(wire.Bind(new(LoginAttemptRepositoryInterface), new(*LoginAttemptRepository)), NewLoginAttemptRepository),
+	wire.NewSet(wire.Bind(new(AccountActionRepositoryInterface), new(*AccountActionRepository)), NewAccountActionRepository),
+	wire.NewSet(wire.Bind(new(OIDCAuthorizationRepositoryInterface), new(*OIDCAuthorizationRepository)), NewOIDCAuthorizationRepository),
+	wire.NewSet(wire.Bind(new(TenantRepositoryInterface), new(*TenantRepository)), NewTenantRepository),
 )
 
 var ErrRecordNotFound = errors.New("record not found")
@@ -4,60 +4,74 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
 
 	"go-scaffold/internal/app/domain"
+	icasbin "go-scaffold/internal/pkg/casbin"
 	ient "go-scaffold/internal/pkg/ent"
 	"go-scaffold/internal/pkg/ent/ent"
 	"go-scaffold/internal/pkg/ent/ent/permission"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
 	"go-scaffold/internal/pkg/ent/ent/role"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
 )
 
 var _ RoleRepositoryInterface = (*RoleRepository)(nil)
 
 type (
 	RoleFindListParam struct {
-		Keyword string
+		TenantID int64 // the roles of all the tenants if it is 0
+		Keyword  string
 	}
 
 	RoleRepositoryInterface interface {
+		// Filter returns all the roles that match the filter
 		Filter(ctx context.Context, param RoleFindListParam) ([]*domain.Role, error)
+		// Paginate returns the page of the roles that match the filter
+		Paginate(ctx context.Context, param RoleFindListParam, page domain.Pagination) (*domain.Page[*domain.Role], error)
 		FindList(ctx context.Context, idList []int64) ([]*domain.Role, error)
 		FindOne(ctx context.Context, id int64) (*domain.Role, error)
 		Exist(ctx context.Context, id int64) (bool, error)
-		NameExist(ctx context.Context, name string) (bool, error)
-		NameExistExcludeID(ctx context.Context, name string, excludeID int64) (bool, error)
+		// NameExist the role name is unique within the tenant
+		NameExist(ctx context.Context, tenant int64, name string) (bool, error)
+		NameExistExcludeID(ctx context.Context, tenant int64, name string, excludeID int64) (bool, error)
 		Create(ctx context.Context, e domain.Role) error
 		Update(ctx context.Context, e domain.Role) error
 		Delete(ctx context.Context, e domain.Role) error
-		GrantPermissions(ctx context.Context, role int64, permissions []int64) error
+		// GrantPermissions replace the permissions of the role and their conditions,
+		// the conditions are keyed by the permission id, the permissions without the conditions are unconditional
+		GrantPermissions(ctx context.Context, role int64, permissions []int64, conditions map[int64]string) error
+		// GetPermissions returns the permissions granted to the role directly
 		GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error)
+		// GetConditions returns the conditions of the permissions granted to the role directly, keyed by the permission id
+		GetConditions(ctx context.Context, id int64) (map[int64]string, error)
+		// SetParents replace the parent roles that the role inherits
+		SetParents(ctx context.Context, role int64, parents []int64) error
+		// GetHierarchy returns the parent roles of all the roles
+		GetHierarchy(ctx context.Context) (domain.RoleHierarchy, error)
 	}
 )
 
 type RoleRepository struct {
 	client   *ient.DefaultClient
-	enforcer *casbin.Enforcer
+	enforcer *casbin.SyncedEnforcer
+	uow      *UnitOfWork
 }
 
-func NewRoleRepository(client *ient.DefaultClient, enforcer *casbin.Enforcer) *RoleRepository {
+func NewRoleRepository(client *ient.DefaultClient, enforcer *casbin.SyncedEnforcer, uow *UnitOfWork) *RoleRepository {
 	return &RoleRepository{
 		client:   client,
 		enforcer: enforcer,
+		uow:      uow,
 	}
 }
 
 func (r *RoleRepository) Filter(ctx context.Context, param RoleFindListParam) ([]*domain.Role, error) {
-	query := r.client.Role.Query()
-
-	if param.Keyword != "" {
-		query.Where(role.NameContains(param.Keyword))
-	}
-
-	list, err := query.
+	list, err := r.filterQuery(ctx, param).
 		Order(ent.Desc(role.FieldUpdatedAt)).
 		All(ctx)
 	if err != nil {
@@ -72,8 +86,45 @@ func (r *RoleRepository) Filter(ctx context.Context, param RoleFindListParam) ([
 	return entities, nil
 }
 
+func (r *RoleRepository) Paginate(ctx context.Context, param RoleFindListParam, page domain.Pagination) (*domain.Page[*domain.Role], error) {
+	result, err := paginate[*ent.RoleQuery, predicate.Role, role.OrderOption](ctx, r.filterQuery(ctx, param), page, roleSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]*domain.Role, 0, len(result.Items))
+	for _, i := range result.Items {
+		entities = append(entities, (&roleModel{i}).toEntity())
+	}
+
+	return &domain.Page[*domain.Role]{Items: entities, Total: result.Total, NextCursor: result.NextCursor}, nil
+}
+
+// filterQuery the query of the roles that match the filter
+func (r *RoleRepository) filterQuery(ctx context.Context, param RoleFindListParam) *ent.RoleQuery {
+	query := clientFromContext(ctx, r.client).Role.Query()
+
+	if param.TenantID != 0 {
+		query.Where(role.TenantIDEQ(param.TenantID))
+	}
+
+	if param.Keyword != "" {
+		query.Where(role.NameContains(param.Keyword))
+	}
+
+	return query
+}
+
+// roleSortFields the columns of domain.RoleSortFields
+var roleSortFields = sortFields[*ent.Role]{
+	domain.SortFieldID:        {role.FieldID, func(m *ent.Role) any { return m.ID }},
+	"name":                    {role.FieldName, func(m *ent.Role) any { return m.Name }},
+	domain.SortFieldCreatedAt: {role.FieldCreatedAt, func(m *ent.Role) any { return m.CreatedAt.Unix() }},
+	domain.SortFieldUpdatedAt: {role.FieldUpdatedAt, func(m *ent.Role) any { return m.UpdatedAt.Unix() }},
+}
+
 func (r *RoleRepository) FindList(ctx context.Context, idList []int64) ([]*domain.Role, error) {
-	data, err := r.client.Role.Query().
+	data, err := clientFromContext(ctx, r.client).Role.Query().
 		Where(role.IDIn(idList...)).
 		All(ctx)
 	if err != nil {
@@ -88,7 +139,7 @@ func (r *RoleRepository) FindList(ctx context.Context, idList []int64) ([]*domai
 }
 
 func (r *RoleRepository) FindOne(ctx context.Context, id int64) (*domain.Role, error) {
-	m, err := r.client.Role.Get(ctx, id)
+	m, err := clientFromContext(ctx, r.client).Role.Get(ctx, id)
 	if err != nil {
 		return nil, errors.WithStack(handleError(err))
 	}
@@ -96,17 +147,21 @@ func (r *RoleRepository) FindOne(ctx context.Context, id int64) (*domain.Role, e
 }
 
 func (r *RoleRepository) Exist(ctx context.Context, id int64) (bool, error) {
-	exist, err := r.client.Role.Query().Where(role.IDEQ(id)).Exist(ctx)
+	exist, err := clientFromContext(ctx, r.client).Role.Query().Where(role.IDEQ(id)).Exist(ctx)
 	return exist, errors.WithStack(handleError(err))
 }
 
-func (r *RoleRepository) NameExist(ctx context.Context, name string) (bool, error) {
-	exist, err := r.client.Role.Query().Where(role.NameEQ(name)).Exist(ctx)
+func (r *RoleRepository) NameExist(ctx context.Context, tenant int64, name string) (bool, error) {
+	exist, err := clientFromContext(ctx, r.client).Role.Query().Where(
+		role.TenantIDEQ(tenant),
+		role.NameEQ(name),
+	).Exist(ctx)
 	return exist, errors.WithStack(handleError(err))
 }
 
-func (r *RoleRepository) NameExistExcludeID(ctx context.Context, name string, excludeID int64) (bool, error) {
-	exist, err := r.client.Role.Query().Where(
+func (r *RoleRepository) NameExistExcludeID(ctx context.Context, tenant int64, name string, excludeID int64) (bool, error) {
+	exist, err := clientFromContext(ctx, r.client).Role.Query().Where(
+		role.TenantIDEQ(tenant),
 		role.NameEQ(name),
 		role.IDNEQ(excludeID),
 	).Exist(ctx)
@@ -114,64 +169,114 @@ func (r *RoleRepository) NameExistExcludeID(ctx context.Context, name string, ex
 }
 
 func (r *RoleRepository) Create(ctx context.Context, e domain.Role) error {
-	_, err := r.client.Role.Create().
+	_, err := clientFromContext(ctx, r.client).Role.Create().
+		SetTenantID(e.TenantID).
 		SetName(e.Name).
+		SetDataScope(string(e.DataScope)).
+		SetDataScopeDepartments(joinIDs(e.DataScopeDepartments)).
 		Save(ctx)
 	return errors.WithStack(handleError(err))
 }
 
 func (r *RoleRepository) Update(ctx context.Context, e domain.Role) error {
-	_, err := r.client.Role.
+	_, err := clientFromContext(ctx, r.client).Role.
 		UpdateOneID(e.ID).
 		SetName(e.Name).
+		SetDataScope(string(e.DataScope)).
+		SetDataScopeDepartments(joinIDs(e.DataScopeDepartments)).
 		Save(ctx)
 	return errors.WithStack(handleError(err))
 }
 
 func (r *RoleRepository) Delete(ctx context.Context, e domain.Role) error {
-	_, err := r.enforcer.DeleteRole(GetPolicyRole(e.ID))
-	if err != nil {
-		return errors.WithStack(err)
-	}
+	policyRole := GetPolicyRole(e.ID)
 
-	return errors.WithStack(r.client.Role.DeleteOneID(e.ID).Exec(ctx))
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		// the role is removed from the policies of all the tenants,
+		// DeleteRole keeps the links to the parent roles
+		_, err := enforcer.RemoveFilteredGroupingPolicy(0, policyRole)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, err = enforcer.DeleteRole(policyRole)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, err = enforcer.RemoveFilteredNamedPolicy(icasbin.ConditionPolicyType, 0, policyRole)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, err = client.RoleGrant.Delete().Where(rolegrant.RoleIDEQ(e.ID)).Exec(ctx)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		return errors.WithStack(client.Role.DeleteOneID(e.ID).Exec(ctx))
+	})
 }
 
-func (r *RoleRepository) GrantPermissions(ctx context.Context, role int64, permissions []int64) error {
+func (r *RoleRepository) GrantPermissions(ctx context.Context, role int64, permissions []int64, conditions map[int64]string) error {
 	policyRole := GetPolicyRole(role)
 
-	_, err := r.enforcer.DeletePermissionsForUser(policyRole)
-	if err != nil {
-		return errors.WithStack(handleError(err))
-	}
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		policyDomain, err := findRolePolicyDomain(ctx, client, role)
+		if err != nil {
+			return err
+		}
 
-	ps := lo.Map(permissions, func(p int64, index int) []string {
-		return []string{fmt.Sprintf("%d", p)}
-	})
+		_, err = enforcer.DeletePermissionsForUser(policyRole)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
 
-	_, err = r.enforcer.AddPermissionsForUser(policyRole, ps...)
-	return errors.WithStack(handleError(err))
+		_, err = enforcer.RemoveFilteredNamedPolicy(icasbin.ConditionPolicyType, 0, policyRole)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		ps := lo.Map(permissions, func(p int64, index int) []string {
+			return []string{policyDomain, fmt.Sprintf("%d", p)}
+		})
+
+		_, err = enforcer.AddPermissionsForUser(policyRole, ps...)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		cs := lo.FilterMap(permissions, func(p int64, index int) ([]string, bool) {
+			return []string{policyRole, policyDomain, fmt.Sprintf("%d", p), conditions[p]}, conditions[p] != ""
+		})
+		if len(cs) == 0 {
+			return nil
+		}
+
+		_, err = enforcer.AddNamedPolicies(icasbin.ConditionPolicyType, cs)
+		return errors.WithStack(handleError(err))
+	})
 }
 
 func (r *RoleRepository) GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error) {
-	pss, err := r.enforcer.GetPermissionsForUser(GetPolicyRole(id))
+	pss, err := enforcerFromContext(ctx, r.enforcer).GetPermissionsForUser(GetPolicyRole(id))
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	ps := make([]int64, 0, len(pss))
 	for _, s := range pss {
-		if len(s) < 2 {
+		if len(s) < 3 {
 			continue
 		}
-		i, err := strconv.ParseInt(s[1], 10, 64)
+		i, err := strconv.ParseInt(s[2], 10, 64)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
 		ps = append(ps, i)
 	}
 
-	data, err := r.client.Permission.Query().
+	data, err := clientFromContext(ctx, r.client).Permission.Query().
 		Where(permission.IDIn(ps...)).
 		All(ctx)
 	if err != nil {
@@ -185,13 +290,119 @@ func (r *RoleRepository) GetPermissions(ctx context.Context, id int64) ([]*domai
 	return list, err
 }
 
+func (r *RoleRepository) GetConditions(ctx context.Context, id int64) (map[int64]string, error) {
+	rules, err := enforcerFromContext(ctx, r.enforcer).GetFilteredNamedPolicy(icasbin.ConditionPolicyType, 0, GetPolicyRole(id))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	conditions := make(map[int64]string, len(rules))
+	for _, rule := range rules {
+		if len(rule) < 4 {
+			continue
+		}
+		i, err := strconv.ParseInt(rule[2], 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		conditions[i] = rule[3]
+	}
+
+	return conditions, nil
+}
+
+func (r *RoleRepository) SetParents(ctx context.Context, role int64, parents []int64) error {
+	policyRole := GetPolicyRole(role)
+
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		policyDomain, err := findRolePolicyDomain(ctx, client, role)
+		if err != nil {
+			return err
+		}
+
+		_, err = enforcer.DeleteRolesForUser(policyRole, policyDomain)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		if len(parents) == 0 {
+			return nil
+		}
+
+		ps := lo.Map(parents, func(p int64, index int) string {
+			return GetPolicyRole(p)
+		})
+
+		_, err = enforcer.AddRolesForUser(policyRole, ps, policyDomain)
+		return errors.WithStack(handleError(err))
+	})
+}
+
+func (r *RoleRepository) GetHierarchy(ctx context.Context) (domain.RoleHierarchy, error) {
+	rules, err := enforcerFromContext(ctx, r.enforcer).GetGroupingPolicy()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	hierarchy := make(domain.RoleHierarchy)
+	for _, rule := range rules {
+		if len(rule) < 2 || !IsPolicyRole(rule[0]) || !IsPolicyRole(rule[1]) {
+			continue
+		}
+
+		role, err := FromPolicyRole(rule[0])
+		if err != nil {
+			return nil, err
+		}
+		parent, err := FromPolicyRole(rule[1])
+		if err != nil {
+			return nil, err
+		}
+
+		hierarchy[role] = append(hierarchy[role], parent)
+	}
+
+	return hierarchy, nil
+}
+
 type roleModel struct {
 	*ent.Role
 }
 
 func (m *roleModel) toEntity() *domain.Role {
 	return &domain.Role{
-		ID:   m.ID,
-		Name: m.Name,
+		ID:                   m.ID,
+		TenantID:             m.TenantID,
+		Name:                 m.Name,
+		DataScope:            domain.DataScope(m.DataScope),
+		DataScopeDepartments: splitIDs(m.DataScopeDepartments),
+	}
+}
+
+// findRolePolicyDomain returns the casbin domain of the tenant that the role belongs to
+func findRolePolicyDomain(ctx context.Context, client *ient.DefaultClient, id int64) (string, error) {
+	m, err := client.Role.Get(ctx, id)
+	if err != nil {
+		return "", errors.WithStack(handleError(err))
+	}
+	return GetPolicyDomain(m.TenantID), nil
+}
+
+func joinIDs(ids []int64) string {
+	return strings.Join(lo.Map(ids, func(id int64, index int) string {
+		return strconv.FormatInt(id, 10)
+	}), ",")
+}
+
+// splitIDs the malformed ids are ignored
+func splitIDs(s string) []int64 {
+	ids := make([]int64, 0)
+	for _, i := range strings.Split(s, ",") {
+		id, err := strconv.ParseInt(i, 10, 64)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
 	}
+	return ids
 }
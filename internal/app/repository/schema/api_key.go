@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"go-scaffold/internal/app/repository/schema/mixin"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:   "api_keys",
+			Options: "COMMENT='API 密钥表'",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+		mixin.SoftDeleteMixin{},
+	}
+}
+
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("prefix"),
+		index.Fields("user_id"),
+	}
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.Int64("user_id").Default(0).Comment("服务账号 id"),
+		field.String("name").Default("").Comment("名称"),
+		field.String("prefix").Default("").Comment("密钥前缀"),
+		field.String("hash").Default("").Comment("密钥摘要"),
+		field.String("scopes").Default("").Comment("授权范围"),
+		field.Int64("expires_at").Default(0).Comment("过期时间"),
+		field.Int64("last_used_at").Default(0).Comment("最后使用时间"),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"go-scaffold/internal/app/repository/schema/mixin"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:   "audit_logs",
+			Options: "COMMENT='审计日志表'",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+// Mixin of the AuditLog, the audit logs are append-only
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("actor_id"),
+		index.Fields("user_id"),
+		index.Fields("created_at"),
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.Int64("tenant_id").Default(1).Comment("租户 id"),
+		field.Int64("actor_id").Default(0).Comment("操作人 id"),
+		field.Int64("user_id").Default(0).Comment("被模拟的用户 id"),
+		field.String("action").Default("").Comment("操作"),
+		field.String("method").Default("").Comment("请求方法"),
+		field.String("path").Default("").Comment("请求路径"),
+		field.Int("status").Default(0).Comment("响应状态码"),
+		field.String("ip").Default("").Comment("客户端 IP"),
+		field.String("user_agent").Default("").Comment("客户端 User-Agent"),
+		field.String("detail").Default("").Comment("详情"),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,87 @@
+package mixin
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/pkg/ent/ent/intercept"
+)
+
+// DataScopeMixin restrict the queries to the rows that the request may see, see domain.DataFilter
+type DataScopeMixin struct {
+	mixin.Schema
+
+	// OwnerField the field of the user that owns the row
+	OwnerField string
+}
+
+// Fields of the DataScopeMixin.
+func (DataScopeMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("department_id").Default(0).Comment("所属部门 id"),
+	}
+}
+
+// Interceptors of the DataScopeMixin.
+func (d DataScopeMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if isSkipDataScope(ctx) {
+				return nil
+			}
+
+			filter, ok := domain.DataFilterFromContext(ctx)
+			if !ok || filter.All {
+				return nil
+			}
+
+			d.P(q, filter)
+			return nil
+		}),
+	}
+}
+
+// P adds a storage-level predicate of the filter to the queries.
+func (d DataScopeMixin) P(w interface{ WhereP(...func(*sql.Selector)) }, filter domain.DataFilter) {
+	w.WhereP(func(s *sql.Selector) {
+		ps := make([]*sql.Predicate, 0, 2)
+		if filter.Own {
+			ps = append(ps, sql.EQ(s.C(d.OwnerField), filter.UserID))
+		}
+		if len(filter.Departments) > 0 {
+			ps = append(ps, sql.In(s.C(d.Fields()[0].Descriptor().Name), toAny(filter.Departments)...))
+		}
+
+		if len(ps) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.Or(ps...))
+	})
+}
+
+type dataScopeKey struct{}
+
+// SkipDataScope returns a new context that skips the data scope interceptor,
+// e.g. the uniqueness of the fields is checked among all the rows
+func SkipDataScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dataScopeKey{}, true)
+}
+
+func isSkipDataScope(ctx context.Context) bool {
+	skip, _ := ctx.Value(dataScopeKey{}).(bool)
+	return skip
+}
+
+func toAny[T any](vs []T) []any {
+	list := make([]any, 0, len(vs))
+	for _, v := range vs {
+		list = append(list, v)
+	}
+	return list
+}
@@ -29,12 +29,16 @@ func (Product) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.TimeMixin{},
 		mixin.SoftDeleteMixin{},
+		mixin.DataScopeMixin{OwnerField: "owner_id"},
 	}
 }
 
 func (Product) Indexes() []ent.Index {
 	return []ent.Index{
+		index.Fields("tenant_id"),
 		index.Fields("name"),
+		index.Fields("owner_id"),
+		index.Fields("department_id"),
 	}
 }
 
@@ -42,9 +46,11 @@ func (Product) Indexes() []ent.Index {
 func (Product) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").Unique().Immutable(),
+		field.Int64("tenant_id").Default(1).Comment("租户 id"),
 		field.String("name").Default("").Comment("名称"),
 		field.String("desc").Default("").Comment("描述"),
 		field.Int("price").Default(0).Comment("价格"),
+		field.Int64("owner_id").Default(0).Comment("创建人 id"),
 	}
 }
 
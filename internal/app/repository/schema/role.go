@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 
 	"go-scaffold/internal/app/repository/schema/mixin"
 )
@@ -31,11 +32,20 @@ func (Role) Mixin() []ent.Mixin {
 	}
 }
 
+func (Role) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").Unique(),
+	}
+}
+
 // Fields of the Role.
 func (Role) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").Unique().Immutable(),
-		field.String("name").Unique().MaxLen(32).Comment("角色名称"),
+		field.Int64("tenant_id").Default(1).Comment("租户 id"),
+		field.String("name").MaxLen(32).Comment("角色名称"),
+		field.String("data_scope").Default("all").Comment("数据范围：all 全部，own 本人，department 本部门，custom 自定义部门"),
+		field.String("data_scope_departments").Default("").Comment("自定义数据范围的部门 id，逗号分隔"),
 	}
 }
 
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"go-scaffold/internal/app/repository/schema/mixin"
+)
+
+// RoleGrant holds the schema definition for the RoleGrant entity.
+type RoleGrant struct {
+	ent.Schema
+}
+
+func (RoleGrant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:   "role_grants",
+			Options: "COMMENT='角色授予表'",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+// Mixin of the RoleGrant, the grants are deleted once they lapse
+func (RoleGrant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
+
+func (RoleGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id"),
+		index.Fields("starts_at"),
+		index.Fields("expires_at"),
+	}
+}
+
+// Fields of the RoleGrant.
+func (RoleGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.Int64("tenant_id").Default(0).Comment("租户 id"),
+		field.Int64("user_id").Default(0).Comment("用户 id"),
+		field.Int64("role_id").Default(0).Comment("角色 id"),
+		field.Int64("starts_at").Default(0).Comment("生效时间"),
+		field.Int64("expires_at").Default(0).Comment("过期时间"),
+		field.Int64("activated_at").Default(0).Comment("策略写入时间，0 为尚未写入"),
+	}
+}
+
+// Edges of the RoleGrant.
+func (RoleGrant) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+
+	"go-scaffold/internal/app/repository/schema/mixin"
+)
+
+// Tenant holds the schema definition for the Tenant entity.
+type Tenant struct {
+	ent.Schema
+}
+
+func (Tenant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:   "tenants",
+			Options: "COMMENT='租户表'",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+func (Tenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+		mixin.SoftDeleteMixin{},
+	}
+}
+
+// Fields of the Tenant.
+func (Tenant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.String("name").Unique().MaxLen(64).Comment("租户名称"),
+	}
+}
+
+// Edges of the Tenant.
+func (Tenant) Edges() []ent.Edge {
+	return nil
+}
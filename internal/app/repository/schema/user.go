@@ -29,13 +29,17 @@ func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.TimeMixin{},
 		mixin.SoftDeleteMixin{},
+		mixin.DataScopeMixin{OwnerField: "id"},
 	}
 }
 
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
+		index.Fields("tenant_id"),
+		index.Fields("department_id"),
 		index.Fields("username"),
 		index.Fields("phone"),
+		index.Fields("email"),
 	}
 }
 
@@ -43,11 +47,19 @@ func (User) Indexes() []ent.Index {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("id").Unique().Immutable(),
+		field.Int64("tenant_id").Default(1).Comment("所属租户 id"),
 		field.String("username").Default("").Comment("用户名"),
 		field.String("password").Default("").Comment("密码"),
 		field.String("nickname").Default("").Comment("用户名"),
 		field.String("phone").Default("").Comment("电话"),
+		field.String("email").Default("").Comment("邮箱"),
+		field.Int64("email_verified_at").Default(0).Comment("邮箱验证时间"),
+		field.Bool("service_account").Default(false).Comment("是否为服务账号"),
 		field.String("salt").Default("").Comment("盐值"),
+		field.String("totp_secret").Default("").Comment("TOTP 密钥"),
+		field.Int64("totp_enabled_at").Default(0).Comment("TOTP 启用时间"),
+		field.String("totp_recovery_codes").Default("").Comment("TOTP 恢复码摘要"),
+		field.Int64("totp_last_step").Default(0).Comment("最后使用的 TOTP 时间步"),
 	}
 }
 
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"go-scaffold/internal/app/repository/schema/mixin"
+)
+
+// UserIdentity holds the schema definition for the UserIdentity entity.
+type UserIdentity struct {
+	ent.Schema
+}
+
+func (UserIdentity) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:   "user_identities",
+			Options: "COMMENT='用户外部身份表'",
+		},
+		entsql.WithComments(true),
+	}
+}
+
+// Mixin of the UserIdentity, the identity is deleted permanently along with the user,
+// so that the subject can be provisioned again
+func (UserIdentity) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
+
+func (UserIdentity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "subject").Unique(),
+		index.Fields("user_id"),
+	}
+}
+
+// Fields of the UserIdentity.
+func (UserIdentity) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").Unique().Immutable(),
+		field.Int64("user_id").Default(0).Comment("用户 id"),
+		field.String("provider").Default("").Comment("身份提供方"),
+		field.String("subject").Default("").Comment("身份提供方的用户标识"),
+	}
+}
+
+// Edges of the UserIdentity.
+func (UserIdentity) Edges() []ent.Edge {
+	return nil
+}
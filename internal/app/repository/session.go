@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	iredis "go-scaffold/internal/pkg/redis"
+)
+
+var _ SessionRepositoryInterface = (*SessionRepository)(nil)
+
+type SessionRepositoryInterface interface {
+	FindOne(ctx context.Context, id string) (*domain.Session, error)
+	FindListByUser(ctx context.Context, userID int64) ([]*domain.Session, error)
+	Create(ctx context.Context, e domain.Session) error
+	// Update update the client, last seen time and expiry of the session
+	Update(ctx context.Context, e domain.Session) error
+	Delete(ctx context.Context, e domain.Session) error
+}
+
+// updateSessionScript never recreate a session that has been deleted
+var updateSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 2))
+redis.call('EXPIREAT', KEYS[1], ARGV[1])
+return 1
+`)
+
+type SessionRepository struct {
+	rdb *iredis.DefaultRedis
+}
+
+func NewSessionRepository(rdb *iredis.DefaultRedis) *SessionRepository {
+	return &SessionRepository{
+		rdb: rdb,
+	}
+}
+
+func (r *SessionRepository) FindOne(ctx context.Context, id string) (*domain.Session, error) {
+	values, err := r.rdb.HGetAll(ctx, sessionKey(id)).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(values) == 0 {
+		return nil, errors.WithStack(ErrRecordNotFound)
+	}
+
+	m := &sessionModel{values}
+	return m.toEntity(id)
+}
+
+func (r *SessionRepository) FindListByUser(ctx context.Context, userID int64) ([]*domain.Session, error) {
+	key := userSessionsKey(userID)
+
+	// drop the expired sessions from the index
+	max := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := r.rdb.ZRemRangeByScore(ctx, key, "-inf", max).Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	ids, err := r.rdb.ZRevRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	cmds := make([]*redis.StringStringMapCmd, 0, len(ids))
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, id := range ids {
+			cmds = append(cmds, pipe.HGetAll(ctx, sessionKey(id)))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	list := make([]*domain.Session, 0, len(ids))
+	for i, cmd := range cmds {
+		if len(cmd.Val()) == 0 { // revoked concurrently
+			continue
+		}
+
+		m := &sessionModel{cmd.Val()}
+		e, err := m.toEntity(ids[i])
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, e)
+	}
+
+	return list, nil
+}
+
+func (r *SessionRepository) Create(ctx context.Context, e domain.Session) error {
+	key := sessionKey(e.ID)
+	indexKey := userSessionsKey(e.UserID)
+
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key,
+			"user_id", e.UserID,
+			"device", e.Device,
+			"ip", e.IP,
+			"user_agent", e.UserAgent,
+			"created_at", e.CreatedAt.Unix(),
+			"last_seen_at", e.LastSeenAt.Unix(),
+			"expires_at", e.ExpiresAt.Unix(),
+		)
+		pipe.ExpireAt(ctx, key, e.ExpiresAt)
+		pipe.ZAdd(ctx, indexKey, &redis.Z{Score: float64(e.ExpiresAt.Unix()), Member: e.ID})
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func (r *SessionRepository) Update(ctx context.Context, e domain.Session) error {
+	ret, err := updateSessionScript.Run(ctx, r.rdb, []string{sessionKey(e.ID)},
+		e.ExpiresAt.Unix(),
+		"device", e.Device,
+		"ip", e.IP,
+		"user_agent", e.UserAgent,
+		"last_seen_at", e.LastSeenAt.Unix(),
+		"expires_at", e.ExpiresAt.Unix(),
+	).Int()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if ret == 0 {
+		return errors.WithStack(ErrRecordNotFound)
+	}
+
+	err = r.rdb.ZAddXX(ctx, userSessionsKey(e.UserID), &redis.Z{Score: float64(e.ExpiresAt.Unix()), Member: e.ID}).Err()
+	return errors.WithStack(err)
+}
+
+func (r *SessionRepository) Delete(ctx context.Context, e domain.Session) error {
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(e.ID))
+		pipe.ZRem(ctx, userSessionsKey(e.UserID), e.ID)
+		return nil
+	})
+	return errors.WithStack(err)
+}
+
+func sessionKey(id string) string {
+	return fmt.Sprintf("account:session:%s", id)
+}
+
+// userSessionsKey the sessions of the user, scored by the expiry
+func userSessionsKey(userID int64) string {
+	return fmt.Sprintf("account:user_sessions:%d", userID)
+}
+
+type sessionModel struct {
+	values map[string]string
+}
+
+func (m *sessionModel) toEntity(id string) (*domain.Session, error) {
+	userID, err := strconv.ParseInt(m.values["user_id"], 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	times := make(map[string]time.Time, 3)
+	for _, field := range []string{"created_at", "last_seen_at", "expires_at"} {
+		v, err := strconv.ParseInt(m.values[field], 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		times[field] = time.Unix(v, 0)
+	}
+
+	return &domain.Session{
+		ID: id,
+		SessionClient: domain.SessionClient{
+			Device:    m.values["device"],
+			IP:        m.values["ip"],
+			UserAgent: m.values["user_agent"],
+		},
+		UserID:     userID,
+		CreatedAt:  times["created_at"],
+		LastSeenAt: times["last_seen_at"],
+		ExpiresAt:  times["expires_at"],
+	}, nil
+}
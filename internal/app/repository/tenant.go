@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	ient "go-scaffold/internal/pkg/ent"
+	"go-scaffold/internal/pkg/ent/ent"
+	"go-scaffold/internal/pkg/ent/ent/tenant"
+)
+
+var _ TenantRepositoryInterface = (*TenantRepository)(nil)
+
+type TenantRepositoryInterface interface {
+	FindOne(ctx context.Context, id int64) (*domain.Tenant, error)
+	Exist(ctx context.Context, id int64) (bool, error)
+}
+
+type TenantRepository struct {
+	client *ient.DefaultClient
+}
+
+func NewTenantRepository(client *ient.DefaultClient) *TenantRepository {
+	return &TenantRepository{
+		client: client,
+	}
+}
+
+func (r *TenantRepository) FindOne(ctx context.Context, id int64) (*domain.Tenant, error) {
+	m, err := r.client.Tenant.Get(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&tenantModel{m}).toEntity(), nil
+}
+
+func (r *TenantRepository) Exist(ctx context.Context, id int64) (bool, error) {
+	exist, err := r.client.Tenant.Query().Where(tenant.IDEQ(id)).Exist(ctx)
+	return exist, errors.WithStack(handleError(err))
+}
+
+type tenantModel struct {
+	*ent.Tenant
+}
+
+func (m *tenantModel) toEntity() *domain.Tenant {
+	return &domain.Tenant{
+		ID:   m.ID,
+		Name: m.Name,
+	}
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/config"
+	icasbin "go-scaffold/internal/pkg/casbin"
+	ient "go-scaffold/internal/pkg/ent"
+	"go-scaffold/internal/pkg/ent/ent"
+)
+
+// UnitOfWorkEnforcer the enforcer that the policy changes of the unit of work are made through,
+// it is either the enforcer of the transaction or the shared synced enforcer
+type UnitOfWorkEnforcer interface {
+	casbin.IEnforcer
+	AddRolesForUser(user string, roles []string, domain ...string) (bool, error)
+}
+
+// UnitOfWorkFunc the ent mutations and the policy changes of the unit of work,
+// they must be made through the client and the enforcer passed in
+type UnitOfWorkFunc func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error
+
+// TransactionInterface run the changes of the repositories in one transaction
+type TransactionInterface interface {
+	// Transaction run fn in a unit of work, the changes that the repositories make with the context passed to fn
+	// join it, they are all rolled back if fn returns an error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+var _ TransactionInterface = (*UnitOfWork)(nil)
+
+// UnitOfWork run the ent mutations and the casbin policy writes in the same database transaction,
+// the enforcer is reloaded only after the transaction is committed
+type UnitOfWork struct {
+	driver    string
+	client    *ient.DefaultClient
+	enforcer  *casbin.SyncedEnforcer
+	watcher   *icasbin.Watcher
+	decisions *icasbin.DecisionCache
+}
+
+func NewUnitOfWork(
+	conf config.DefaultDatabase,
+	client *ient.DefaultClient,
+	enforcer *casbin.SyncedEnforcer,
+	watcher *icasbin.Watcher,
+	decisions *icasbin.DecisionCache,
+) *UnitOfWork {
+	return &UnitOfWork{
+		driver:    conf.Driver.String(),
+		client:    client,
+		enforcer:  enforcer,
+		watcher:   watcher,
+		decisions: decisions,
+	}
+}
+
+// Do run fn in a transaction, the transaction is rolled back if fn returns an error.
+// The policy writes join the transaction only if the policies are stored by the ent adapter,
+// otherwise they are persisted by the adapter of the enforcer immediately.
+// fn joins the unit of work that the context runs in, which commits the changes
+func (u *UnitOfWork) Do(ctx context.Context, fn UnitOfWorkFunc) (err error) {
+	if s, ok := ctx.Value(unitOfWorkContextKey{}).(*unitOfWorkScope); ok {
+		return fn(ctx, s.tx.Client(), s.enforcer)
+	}
+
+	tx, err := u.client.Tx(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	var enforcer UnitOfWorkEnforcer
+	tef, transactional, err := icasbin.NewTxEnforcer(ctx, u.enforcer, u.driver, tx)
+	if err != nil {
+		return rollback(tx, err)
+	}
+	if transactional {
+		enforcer = tef
+	} else {
+		enforcer = u.enforcer
+	}
+
+	ctx = context.WithValue(ctx, unitOfWorkContextKey{}, &unitOfWorkScope{tx: tx, enforcer: enforcer})
+	if err := fn(ctx, tx.Client(), enforcer); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if !transactional {
+		return nil
+	}
+
+	if err := u.reload(); err != nil {
+		return err
+	}
+
+	// the peers reload the whole policy as well
+	if u.watcher != nil {
+		return errors.WithStack(u.watcher.Update())
+	}
+
+	return nil
+}
+
+func (u *UnitOfWork) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return u.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		return fn(ctx)
+	})
+}
+
+// Refresh reload the policy if the watcher is not configured,
+// since the policy changes made by the peers are not seen otherwise
+func (u *UnitOfWork) Refresh() error {
+	if u.watcher != nil {
+		return nil
+	}
+	return u.reload()
+}
+
+// watched reports whether the peers are notified of the policy changes by the watcher
+func (u *UnitOfWork) watched() bool {
+	return u.watcher != nil
+}
+
+func (u *UnitOfWork) reload() error {
+	if err := u.enforcer.LoadPolicy(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// the reload does not notify the watcher of the enforcer
+	u.decisions.Invalidate()
+
+	return nil
+}
+
+type unitOfWorkContextKey struct{}
+
+// unitOfWorkScope the transaction and the enforcer of the running unit of work
+type unitOfWorkScope struct {
+	tx       *ent.Tx
+	enforcer UnitOfWorkEnforcer
+}
+
+// inUnitOfWork reports whether the context runs in a unit of work
+func inUnitOfWork(ctx context.Context) bool {
+	_, ok := ctx.Value(unitOfWorkContextKey{}).(*unitOfWorkScope)
+	return ok
+}
+
+// clientFromContext returns the client of the unit of work that the context runs in,
+// so that the reads see the uncommitted changes, otherwise returns the client passed in
+func clientFromContext(ctx context.Context, client *ient.DefaultClient) *ent.Client {
+	if s, ok := ctx.Value(unitOfWorkContextKey{}).(*unitOfWorkScope); ok {
+		return s.tx.Client()
+	}
+	return client
+}
+
+// enforcerFromContext returns the enforcer of the unit of work that the context runs in,
+// otherwise returns the enforcer passed in
+func enforcerFromContext(ctx context.Context, enforcer *casbin.SyncedEnforcer) UnitOfWorkEnforcer {
+	if s, ok := ctx.Value(unitOfWorkContextKey{}).(*unitOfWorkScope); ok {
+		return s.enforcer
+	}
+	return enforcer
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return errors.Wrapf(err, "rollback: %v", rerr)
+	}
+	return errors.WithStack(err)
+}
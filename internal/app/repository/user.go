@@ -2,17 +2,25 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
 
 	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository/schema/mixin"
+	icasbin "go-scaffold/internal/pkg/casbin"
 	ient "go-scaffold/internal/pkg/ent"
 	"go-scaffold/internal/pkg/ent/ent"
 	"go-scaffold/internal/pkg/ent/ent/permission"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
 	"go-scaffold/internal/pkg/ent/ent/role"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
 	"go-scaffold/internal/pkg/ent/ent/user"
 )
 
@@ -20,40 +28,119 @@ var _ UserRepositoryInterface = (*UserRepository)(nil)
 
 type (
 	UserFindListParam struct {
-		Keyword string
+		TenantID int64 // the users of all the tenants if it is 0
+		Keyword  string
 	}
 
 	UserRepositoryInterface interface {
+		// Filter returns all the users that match the filter
 		Filter(ctx context.Context, param UserFindListParam) ([]*domain.User, error)
+		// Paginate returns the page of the users that match the filter
+		Paginate(ctx context.Context, param UserFindListParam, page domain.Pagination) (*domain.Page[*domain.User], error)
 		FindOne(ctx context.Context, id int64) (*domain.User, error)
 		FindOneByUsername(ctx context.Context, username string) (*domain.User, error)
+		FindOneByEmail(ctx context.Context, email string) (*domain.User, error)
 		Exist(ctx context.Context, id int64) (bool, error)
+		// UsernameExist the username is unique among all the users regardless of the data scope
 		UsernameExist(ctx context.Context, username string) (bool, error)
 		UsernameExistExcludeID(ctx context.Context, username string, excludeID int64) (bool, error)
+		// EmailExistExcludeID the excludeID is 0 if no user is excluded
+		EmailExistExcludeID(ctx context.Context, email string, excludeID int64) (bool, error)
 		Create(ctx context.Context, e domain.User) (*domain.User, error)
+		// Update the service account flag is immutable, which is only set by Create
 		Update(ctx context.Context, e domain.User) (*domain.User, error)
+		// UpdateTOTP update the TOTP secret and recovery codes, which are never touched by Update
+		UpdateTOTP(ctx context.Context, e domain.User) error
+		// UseTOTPStep record the time step of the accepted TOTP code,
+		// false is returned if the step or a later one has been used, i.e. the code is replayed
+		UseTOTPStep(ctx context.Context, e domain.User, step int64) (bool, error)
+		// UseRecoveryCode consume the recovery code of the user,
+		// false is returned if it is invalid or has been used concurrently
+		UseRecoveryCode(ctx context.Context, e domain.User, code string) (bool, error)
+		// VerifyEmail mark the email of the user as verified,
+		// false is returned if the email has been changed concurrently
+		VerifyEmail(ctx context.Context, e domain.User, email string) (bool, error)
 		Delete(ctx context.Context, e domain.User) error
-		AssignRoles(ctx context.Context, user int64, roles []int64) error
-		GetRoles(ctx context.Context, id int64) ([]*domain.Role, error)
-		GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error)
+		// AssignRoles replace the role grants of the user within the tenant, the roles of the other tenants are kept,
+		// the grants out of their validity windows are kept until the sweep
+		AssignRoles(ctx context.Context, tenant, user int64, grants []domain.RoleGrant) error
+		// SyncRoles grant the roles and revoke the rest of the managed roles of the user within the tenant without the validity windows,
+		// the roles out of the managed ones are kept
+		SyncRoles(ctx context.Context, tenant, user int64, managed, granted []int64) error
+		// AddRole grant the role to the user within the tenant of the role without the validity window,
+		// the other roles of the user are kept
+		AddRole(ctx context.Context, user int64, role int64) error
+		// GetRoles returns the roles in effect of the user within the tenant, or within all the tenants if the tenant is 0
+		GetRoles(ctx context.Context, tenant, id int64) ([]*domain.Role, error)
+		// GetRoleGrants returns the role grants of the user within the tenant, or within all the tenants if the tenant is 0,
+		// including the scheduled ones which are not in effect yet
+		GetRoleGrants(ctx context.Context, tenant, id int64) ([]*domain.RoleGrant, error)
+		// SweepRoleGrants bring the grants that have started into effect, and revoke the ones that have lapsed at the time,
+		// each transition is applied by only one of the replicas, the others reload the policy once they find it stale
+		// if the watcher is not configured
+		SweepRoleGrants(ctx context.Context, t time.Time) (*domain.RoleGrantSweepResult, error)
+		// GetPermissions returns the permissions of the user within the tenant, including the inherited ones
+		GetPermissions(ctx context.Context, tenant, id int64) ([]*domain.Permission, error)
+		// ExplainPermission explain the decision of the enforcer on the permission of the user within the tenant
+		ExplainPermission(ctx context.Context, tenant, id, permission int64) (*domain.PermissionExplanation, error)
 	}
 )
 
 type UserRepository struct {
 	client   *ient.DefaultClient
-	enforcer *casbin.Enforcer
+	enforcer *casbin.SyncedEnforcer
+	uow      *UnitOfWork
+	// activeGrants the grants in effect at the previous sweep, whose lapse may be swept by the peers
+	activeGrants   []*ent.RoleGrant
+	activeGrantsMu sync.Mutex
 }
 
-func NewUserRepository(client *ient.DefaultClient, enforcer *casbin.Enforcer) *UserRepository {
+func NewUserRepository(client *ient.DefaultClient, enforcer *casbin.SyncedEnforcer, uow *UnitOfWork) *UserRepository {
 	return &UserRepository{
 		client:   client,
 		enforcer: enforcer,
+		uow:      uow,
 	}
 }
 
 func (r *UserRepository) Filter(ctx context.Context, param UserFindListParam) ([]*domain.User, error) {
+	list, err := r.filterQuery(param).
+		Order(ent.Desc(user.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	entities := make([]*domain.User, 0, len(list))
+	for _, i := range list {
+		entities = append(entities, (&userModel{i}).toEntity())
+	}
+
+	return entities, nil
+}
+
+func (r *UserRepository) Paginate(ctx context.Context, param UserFindListParam, page domain.Pagination) (*domain.Page[*domain.User], error) {
+	result, err := paginate[*ent.UserQuery, predicate.User, user.OrderOption](ctx, r.filterQuery(param), page, userSortFields)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]*domain.User, 0, len(result.Items))
+	for _, i := range result.Items {
+		entities = append(entities, (&userModel{i}).toEntity())
+	}
+
+	return &domain.Page[*domain.User]{Items: entities, Total: result.Total, NextCursor: result.NextCursor}, nil
+}
+
+// filterQuery the query of the users that match the filter
+func (r *UserRepository) filterQuery(param UserFindListParam) *ent.UserQuery {
 	query := r.client.User.Query()
 
+	if param.TenantID != 0 {
+		query.Where(user.TenantIDEQ(param.TenantID))
+	}
+
 	if param.Keyword != "" {
 		query.Where(
 			user.Or(
@@ -64,19 +151,15 @@ func (r *UserRepository) Filter(ctx context.Context, param UserFindListParam) ([
 		)
 	}
 
-	list, err := query.
-		Order(ent.Desc(user.FieldUpdatedAt)).
-		All(ctx)
-	if err != nil {
-		return nil, errors.WithStack(handleError(err))
-	}
-
-	entities := make([]*domain.User, 0, len(list))
-	for _, i := range list {
-		entities = append(entities, (&userModel{i}).toEntity())
-	}
+	return query
+}
 
-	return entities, nil
+// userSortFields the columns of domain.UserSortFields
+var userSortFields = sortFields[*ent.User]{
+	domain.SortFieldID:        {user.FieldID, func(m *ent.User) any { return m.ID }},
+	"username":                {user.FieldUsername, func(m *ent.User) any { return m.Username }},
+	domain.SortFieldCreatedAt: {user.FieldCreatedAt, func(m *ent.User) any { return m.CreatedAt.Unix() }},
+	domain.SortFieldUpdatedAt: {user.FieldUpdatedAt, func(m *ent.User) any { return m.UpdatedAt.Unix() }},
 }
 
 func (r *UserRepository) FindOne(ctx context.Context, id int64) (*domain.User, error) {
@@ -97,13 +180,23 @@ func (r *UserRepository) FindOneByUsername(ctx context.Context, username string)
 	return (&userModel{m}).toEntity(), nil
 }
 
+func (r *UserRepository) FindOneByEmail(ctx context.Context, email string) (*domain.User, error) {
+	m, err := r.client.User.Query().
+		Where(user.EmailEQ(email)).
+		First(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&userModel{m}).toEntity(), nil
+}
+
 func (r *UserRepository) Exist(ctx context.Context, id int64) (bool, error) {
 	exist, err := r.client.User.Query().Where(user.IDEQ(id)).Exist(ctx)
 	return exist, errors.WithStack(handleError(err))
 }
 
 func (r *UserRepository) UsernameExist(ctx context.Context, username string) (bool, error) {
-	exist, err := r.client.User.Query().Where(user.UsernameEQ(username)).Exist(ctx)
+	exist, err := r.client.User.Query().Where(user.UsernameEQ(username)).Exist(mixin.SkipDataScope(ctx))
 	return exist, errors.WithStack(handleError(err))
 }
 
@@ -111,16 +204,29 @@ func (r *UserRepository) UsernameExistExcludeID(ctx context.Context, username st
 	exist, err := r.client.User.Query().Where(
 		user.UsernameEQ(username),
 		user.IDNEQ(excludeID),
-	).Exist(ctx)
+	).Exist(mixin.SkipDataScope(ctx))
+	return exist, errors.WithStack(handleError(err))
+}
+
+func (r *UserRepository) EmailExistExcludeID(ctx context.Context, email string, excludeID int64) (bool, error) {
+	exist, err := r.client.User.Query().Where(
+		user.EmailEQ(email),
+		user.IDNEQ(excludeID),
+	).Exist(mixin.SkipDataScope(ctx))
 	return exist, errors.WithStack(handleError(err))
 }
 
 func (r *UserRepository) Create(ctx context.Context, e domain.User) (*domain.User, error) {
 	m, err := r.client.User.Create().
+		SetTenantID(e.TenantID).
+		SetDepartmentID(e.DepartmentID).
 		SetUsername(e.Username).
 		SetPassword(string(e.Password)).
 		SetNickname(e.Nickname).
 		SetPhone(e.Phone).
+		SetEmail(e.Email).
+		SetEmailVerifiedAt(e.EmailVerifiedAt).
+		SetServiceAccount(e.ServiceAccount).
 		SetSalt(e.Salt).
 		Save(ctx)
 	if err != nil {
@@ -132,10 +238,13 @@ func (r *UserRepository) Create(ctx context.Context, e domain.User) (*domain.Use
 func (r *UserRepository) Update(ctx context.Context, e domain.User) (*domain.User, error) {
 	m, err := r.client.User.
 		UpdateOneID(e.ID).
+		SetDepartmentID(e.DepartmentID).
 		SetUsername(e.Username).
 		SetPassword(string(e.Password)).
 		SetNickname(e.Nickname).
 		SetPhone(e.Phone).
+		SetEmail(e.Email).
+		SetEmailVerifiedAt(e.EmailVerifiedAt).
 		SetSalt(e.Salt).
 		Save(ctx)
 	if err != nil {
@@ -144,40 +253,215 @@ func (r *UserRepository) Update(ctx context.Context, e domain.User) (*domain.Use
 	return (&userModel{m}).toEntity(), nil
 }
 
-func (r *UserRepository) Delete(ctx context.Context, e domain.User) error {
-	_, err := r.enforcer.DeleteUser(GetPolicyUser(e.ID))
+func (r *UserRepository) UpdateTOTP(ctx context.Context, e domain.User) error {
+	err := r.client.User.
+		UpdateOneID(e.ID).
+		SetTotpSecret(e.TOTPSecret).
+		SetTotpEnabledAt(e.TOTPEnabledAt).
+		SetTotpRecoveryCodes(strings.Join(e.TOTPRecoveryCodes, ",")).
+		SetTotpLastStep(e.TOTPLastStep).
+		Exec(ctx)
+	return errors.WithStack(handleError(err))
+}
+
+func (r *UserRepository) UseTOTPStep(ctx context.Context, e domain.User, step int64) (bool, error) {
+	// compare and swap, so that a code is accepted only once even if it is verified concurrently
+	n, err := r.client.User.Update().
+		Where(
+			user.IDEQ(e.ID),
+			user.TotpLastStepLT(step),
+		).
+		SetTotpLastStep(step).
+		Save(ctx)
 	if err != nil {
-		return errors.WithStack(err)
+		return false, errors.WithStack(handleError(err))
 	}
 
-	return errors.WithStack(r.client.User.DeleteOneID(e.ID).Exec(ctx))
+	return n > 0, nil
 }
 
-func (r *UserRepository) AssignRoles(ctx context.Context, user int64, roles []int64) error {
-	policyUser := GetPolicyUser(user)
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, e domain.User, code string) (bool, error) {
+	used := e
+	used.TOTPRecoveryCodes = append([]string(nil), e.TOTPRecoveryCodes...)
+	if !used.UseRecoveryCode(code) {
+		return false, nil
+	}
 
-	_, err := r.enforcer.DeleteRolesForUser(policyUser)
+	// compare and swap, so that a recovery code can only be used once
+	n, err := r.client.User.Update().
+		Where(
+			user.IDEQ(e.ID),
+			user.TotpRecoveryCodesEQ(strings.Join(e.TOTPRecoveryCodes, ",")),
+		).
+		SetTotpRecoveryCodes(strings.Join(used.TOTPRecoveryCodes, ",")).
+		Save(ctx)
 	if err != nil {
+		return false, errors.WithStack(handleError(err))
+	}
+
+	return n > 0, nil
+}
+
+func (r *UserRepository) VerifyEmail(ctx context.Context, e domain.User, email string) (bool, error) {
+	n, err := r.client.User.Update().
+		Where(
+			user.IDEQ(e.ID),
+			user.EmailEQ(email),
+		).
+		SetEmailVerifiedAt(time.Now().Unix()).
+		Save(ctx)
+	if err != nil {
+		return false, errors.WithStack(handleError(err))
+	}
+
+	return n > 0, nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, e domain.User) error {
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		_, err := enforcer.DeleteUser(GetPolicyUser(e.ID))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		_, err = client.RoleGrant.Delete().Where(rolegrant.UserIDEQ(e.ID)).Exec(ctx)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		return errors.WithStack(client.User.DeleteOneID(e.ID).Exec(ctx))
+	})
+}
+
+func (r *UserRepository) AssignRoles(ctx context.Context, tenant, user int64, grants []domain.RoleGrant) error {
+	policyUser := GetPolicyUser(user)
+	policyDomain := GetPolicyDomain(tenant)
+	now := time.Now()
+
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		_, err := enforcer.DeleteRolesForUser(policyUser, policyDomain)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		_, err = client.RoleGrant.Delete().
+			Where(
+				rolegrant.TenantIDEQ(tenant),
+				rolegrant.UserIDEQ(user),
+			).
+			Exec(ctx)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		// only the bounded grants are persisted, the policies are enough for the others,
+		// the grants in effect are activated below, the others are activated by the sweep
+		creates := lo.FilterMap(grants, func(g domain.RoleGrant, index int) (*ent.RoleGrantCreate, bool) {
+			return client.RoleGrant.Create().
+				SetTenantID(tenant).
+				SetUserID(user).
+				SetRoleID(g.RoleID).
+				SetStartsAt(g.StartsAt).
+				SetExpiresAt(g.ExpiresAt).
+				SetActivatedAt(lo.Ternary(g.IsEffective(now), now.Unix(), 0)), g.IsBounded()
+		})
+		if len(creates) > 0 {
+			if err := client.RoleGrant.CreateBulk(creates...).Exec(ctx); err != nil {
+				return errors.WithStack(handleError(err))
+			}
+		}
+
+		rs := lo.FilterMap(grants, func(g domain.RoleGrant, index int) (string, bool) {
+			return GetPolicyRole(g.RoleID), g.IsEffective(now)
+		})
+		if len(rs) == 0 {
+			return nil
+		}
+
+		_, err = enforcer.AddRolesForUser(policyUser, lo.Uniq(rs), policyDomain)
 		return errors.WithStack(handleError(err))
+	})
+}
+
+func (r *UserRepository) SyncRoles(ctx context.Context, tenant, user int64, managed, granted []int64) error {
+	policyUser := GetPolicyUser(user)
+	policyDomain := GetPolicyDomain(tenant)
+	revoked := lo.Without(managed, granted...)
+
+	if len(revoked) == 0 && len(granted) == 0 {
+		return nil
 	}
 
-	rs := lo.Map(roles, func(r int64, index int) string {
-		return GetPolicyRole(r)
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		// the synchronized roles are never revoked by the sweep afterwards
+		_, err := client.RoleGrant.Delete().
+			Where(
+				rolegrant.TenantIDEQ(tenant),
+				rolegrant.UserIDEQ(user),
+				rolegrant.RoleIDIn(append(revoked, granted...)...),
+			).
+			Exec(ctx)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		for _, role := range revoked {
+			if _, err := enforcer.DeleteRoleForUser(policyUser, GetPolicyRole(role), policyDomain); err != nil {
+				return errors.WithStack(handleError(err))
+			}
+		}
+
+		// one by one, since the roles that have been granted are skipped
+		for _, role := range granted {
+			if _, err := enforcer.AddRoleForUser(policyUser, GetPolicyRole(role), policyDomain); err != nil {
+				return errors.WithStack(handleError(err))
+			}
+		}
+
+		return nil
 	})
+}
 
-	_, err = r.enforcer.AddRolesForUser(policyUser, rs)
-	return errors.WithStack(handleError(err))
+func (r *UserRepository) AddRole(ctx context.Context, user int64, role int64) error {
+	policyDomain, err := findRolePolicyDomain(ctx, r.client, role)
+	if err != nil {
+		return err
+	}
+
+	return r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		// the role is never revoked by the sweep afterwards
+		_, err := client.RoleGrant.Delete().
+			Where(
+				rolegrant.UserIDEQ(user),
+				rolegrant.RoleIDEQ(role),
+			).
+			Exec(ctx)
+		if err != nil {
+			return errors.WithStack(handleError(err))
+		}
+
+		_, err = enforcer.AddRoleForUser(GetPolicyUser(user), GetPolicyRole(role), policyDomain)
+		return errors.WithStack(handleError(err))
+	})
 }
 
-func (r *UserRepository) GetRoles(ctx context.Context, id int64) ([]*domain.Role, error) {
-	rss, err := r.enforcer.GetRolesForUser(GetPolicyUser(id))
+func (r *UserRepository) GetRoles(ctx context.Context, tenant, id int64) ([]*domain.Role, error) {
+	fieldValues := []string{GetPolicyUser(id)}
+	if tenant != 0 {
+		fieldValues = append(fieldValues, "", GetPolicyDomain(tenant))
+	}
+
+	rules, err := r.enforcer.GetFilteredGroupingPolicy(0, fieldValues...)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	rs := make([]int64, 0, len(rss))
-	for _, s := range rss {
-		i, err := FromPolicyRole(s)
+	rs := make([]int64, 0, len(rules))
+	for _, rule := range rules {
+		if len(rule) < 2 || !IsPolicyRole(rule[1]) {
+			continue
+		}
+		i, err := FromPolicyRole(rule[1])
 		if err != nil {
 			return nil, err
 		}
@@ -198,18 +482,218 @@ func (r *UserRepository) GetRoles(ctx context.Context, id int64) ([]*domain.Role
 	return list, err
 }
 
-func (r *UserRepository) GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error) {
-	pss, err := r.enforcer.GetImplicitPermissionsForUser(GetPolicyUser(id))
+func (r *UserRepository) GetRoleGrants(ctx context.Context, tenant, id int64) ([]*domain.RoleGrant, error) {
+	roles, err := r.GetRoles(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.client.RoleGrant.Query().Where(rolegrant.UserIDEQ(id))
+	if tenant != 0 {
+		query.Where(rolegrant.TenantIDEQ(tenant))
+	}
+	windows, err := query.All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	windowsByRole := lo.KeyBy(windows, func(m *ent.RoleGrant) int64 {
+		return m.RoleID
+	})
+
+	list := make([]*domain.RoleGrant, 0, len(windows)+len(roles))
+	for _, item := range roles {
+		g := &domain.RoleGrant{RoleID: item.ID, Role: item}
+		if m, ok := windowsByRole[item.ID]; ok {
+			g.StartsAt, g.ExpiresAt = m.StartsAt, m.ExpiresAt
+			delete(windowsByRole, item.ID)
+		}
+		list = append(list, g)
+	}
+
+	// the rest are the scheduled grants that are not in effect yet
+	if len(windowsByRole) == 0 {
+		return list, nil
+	}
+
+	data, err := r.client.Role.Query().
+		Where(role.IDIn(lo.Keys(windowsByRole)...)).
+		Order(ent.Asc(role.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	for _, item := range data {
+		m := windowsByRole[item.ID]
+		list = append(list, &domain.RoleGrant{
+			RoleID:    item.ID,
+			StartsAt:  m.StartsAt,
+			ExpiresAt: m.ExpiresAt,
+			Role:      (&roleModel{item}).toEntity(),
+		})
+	}
+
+	return list, nil
+}
+
+func (r *UserRepository) SweepRoleGrants(ctx context.Context, t time.Time) (*domain.RoleGrantSweepResult, error) {
+	now := t.Unix()
+
+	lapsed, err := r.client.RoleGrant.Query().
+		Where(
+			rolegrant.ExpiresAtGT(0),
+			rolegrant.ExpiresAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	due, err := r.client.RoleGrant.Query().
+		Where(
+			rolegrant.ActivatedAtEQ(0),
+			rolegrant.StartsAtLTE(now),
+			rolegrant.Or(
+				rolegrant.ExpiresAtEQ(0),
+				rolegrant.ExpiresAtGT(now),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+
+	result := &domain.RoleGrantSweepResult{}
+	if len(lapsed) == 0 && len(due) == 0 {
+		stale, err := r.staleRoleGrants(ctx, now)
+		if err != nil {
+			return nil, err
+		}
+		if !stale {
+			return result, nil
+		}
+
+		// the grants are swept by the peers, the policy is reloaded by the unit of work otherwise
+		return result, r.uow.Refresh()
+	}
+
+	err = r.uow.Do(ctx, func(ctx context.Context, client *ent.Client, enforcer UnitOfWorkEnforcer) error {
+		// each transition is claimed by the change of its row, so that it is applied by only one of the replicas
+		for _, m := range lapsed {
+			n, err := client.RoleGrant.Delete().Where(rolegrant.IDEQ(m.ID)).Exec(ctx)
+			if err != nil {
+				return errors.WithStack(handleError(err))
+			}
+			if n == 0 {
+				continue
+			}
+
+			if _, err := enforcer.RemoveGroupingPolicy(roleGrantRule(m)...); err != nil {
+				return errors.WithStack(err)
+			}
+			result.Revoked++
+		}
+
+		for _, m := range due {
+			n, err := client.RoleGrant.Update().
+				Where(
+					rolegrant.IDEQ(m.ID),
+					rolegrant.ActivatedAtEQ(0),
+				).
+				SetActivatedAt(now).
+				Save(ctx)
+			if err != nil {
+				return errors.WithStack(handleError(err))
+			}
+			if n == 0 {
+				continue
+			}
+
+			if _, err := enforcer.AddGroupingPolicy(roleGrantRule(m)...); err != nil {
+				return errors.WithStack(err)
+			}
+			result.Activated++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// staleRoleGrants reports whether the policy misses the transitions of the grants applied by the peers,
+// which are not seen if the watcher is not configured: the grants that are activated but not loaded,
+// and the grants in effect at the previous sweep that have lapsed but are still loaded
+func (r *UserRepository) staleRoleGrants(ctx context.Context, now int64) (bool, error) {
+	if r.uow.watched() {
+		return false, nil
+	}
+
+	active, err := r.client.RoleGrant.Query().
+		Where(
+			rolegrant.ActivatedAtGT(0),
+			rolegrant.StartsAtLTE(now),
+			rolegrant.Or(
+				rolegrant.ExpiresAtEQ(0),
+				rolegrant.ExpiresAtGT(now),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return false, errors.WithStack(handleError(err))
+	}
+
+	r.activeGrantsMu.Lock()
+	previous := r.activeGrants
+	r.activeGrants = active
+	r.activeGrantsMu.Unlock()
+
+	for _, m := range active {
+		ok, err := r.enforcer.HasGroupingPolicy(roleGrantRule(m)...)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+		if !ok {
+			return true, nil
+		}
+	}
+
+	for _, m := range previous {
+		if m.ExpiresAt == 0 || m.ExpiresAt > now {
+			continue
+		}
+		ok, err := r.enforcer.HasGroupingPolicy(roleGrantRule(m)...)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// roleGrantRule the grouping policy of the role grant
+func roleGrantRule(m *ent.RoleGrant) []any {
+	return []any{GetPolicyUser(m.UserID), GetPolicyRole(m.RoleID), GetPolicyDomain(m.TenantID)}
+}
+
+func (r *UserRepository) GetPermissions(ctx context.Context, tenant, id int64) ([]*domain.Permission, error) {
+	pss, err := r.enforcer.GetImplicitPermissionsForUser(GetPolicyUser(id), GetPolicyDomain(tenant))
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
 	ps := make([]int64, 0, len(pss))
 	for _, s := range pss {
-		if len(s) < 2 {
+		if len(s) < 3 {
 			continue
 		}
-		i, err := strconv.ParseInt(s[1], 10, 64)
+		i, err := strconv.ParseInt(s[2], 10, 64)
 		if err != nil {
 			return nil, errors.WithStack(err)
 		}
@@ -231,17 +715,120 @@ func (r *UserRepository) GetPermissions(ctx context.Context, id int64) ([]*domai
 	return list, err
 }
 
+func (r *UserRepository) ExplainPermission(ctx context.Context, tenant, id, permission int64) (*domain.PermissionExplanation, error) {
+	policyUser := GetPolicyUser(id)
+	policyDomain := GetPolicyDomain(tenant)
+	obj := strconv.FormatInt(permission, 10)
+
+	allowed, explain, err := r.enforcer.EnforceEx(policyUser, policyDomain, obj, icasbin.EnvironmentFromContext(ctx))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	roles, err := r.enforcer.GetImplicitRolesForUser(policyUser, policyDomain)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	subjects := append([]string{policyUser}, roles...)
+
+	rules, err := r.enforcer.GetFilteredPolicy(1, policyDomain, obj)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	policies := lo.Filter(rules, func(rule []string, index int) bool {
+		return lo.Contains(subjects, rule[0])
+	})
+
+	conditions, err := r.enforcer.GetFilteredNamedPolicy(icasbin.ConditionPolicyType, 1, policyDomain, obj)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	e := &domain.PermissionExplanation{
+		Allowed:  allowed,
+		Policies: policies,
+		Conditions: lo.Filter(conditions, func(rule []string, index int) bool {
+			return lo.Contains(subjects, rule[0])
+		}),
+		RolePath: make([]string, 0),
+	}
+
+	if !allowed {
+		return e, nil
+	}
+
+	// the matcher reports the first policy line if the subject is a super admin, whether the line matches or not
+	granted := lo.ContainsBy(policies, func(rule []string) bool {
+		return slices.Equal(rule, explain)
+	})
+	if !granted {
+		e.SuperAdmin = true
+		return e, nil
+	}
+
+	e.RolePath, err = r.rolePath(policyUser, explain[0], policyDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// rolePath the shortest path from the subject to the role through the role links within the domain
+func (r *UserRepository) rolePath(sub, role, dom string) ([]string, error) {
+	prev := map[string]string{sub: ""}
+	queue := []string{sub}
+
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+
+		if s == role {
+			path := make([]string, 0)
+			for ; s != ""; s = prev[s] {
+				path = append([]string{s}, path...)
+			}
+			return path, nil
+		}
+
+		roles, err := r.enforcer.GetRolesForUser(s, dom)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, next := range roles {
+			if _, ok := prev[next]; !ok {
+				prev[next] = s
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return make([]string, 0), nil
+}
+
 type userModel struct {
 	*ent.User
 }
 
 func (m *userModel) toEntity() *domain.User {
-	return &domain.User{
-		ID:       m.ID,
-		Username: m.Username,
-		Password: domain.Password(m.Password),
-		Nickname: m.Nickname,
-		Phone:    m.Phone,
-		Salt:     m.Salt,
+	e := &domain.User{
+		ID:              m.ID,
+		TenantID:        m.TenantID,
+		DepartmentID:    m.DepartmentID,
+		Username:        m.Username,
+		Password:        domain.Password(m.Password),
+		Nickname:        m.Nickname,
+		Phone:           m.Phone,
+		Email:           m.Email,
+		EmailVerifiedAt: m.EmailVerifiedAt,
+		ServiceAccount:  m.ServiceAccount,
+		Salt:            m.Salt,
+		TOTPSecret:      m.TotpSecret,
+		TOTPEnabledAt:   m.TotpEnabledAt,
+		TOTPLastStep:    m.TotpLastStep,
+	}
+	if m.TotpRecoveryCodes != "" {
+		e.TOTPRecoveryCodes = strings.Split(m.TotpRecoveryCodes, ",")
 	}
+	return e
 }
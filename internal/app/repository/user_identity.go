@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	ient "go-scaffold/internal/pkg/ent"
+	"go-scaffold/internal/pkg/ent/ent"
+	"go-scaffold/internal/pkg/ent/ent/useridentity"
+)
+
+var _ UserIdentityRepositoryInterface = (*UserIdentityRepository)(nil)
+
+type UserIdentityRepositoryInterface interface {
+	FindOneBySubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error)
+	Create(ctx context.Context, e domain.UserIdentity) (*domain.UserIdentity, error)
+	// DeleteByUser delete all the identities of the user
+	DeleteByUser(ctx context.Context, user int64) error
+}
+
+type UserIdentityRepository struct {
+	client *ient.DefaultClient
+}
+
+func NewUserIdentityRepository(client *ient.DefaultClient) *UserIdentityRepository {
+	return &UserIdentityRepository{
+		client: client,
+	}
+}
+
+func (r *UserIdentityRepository) FindOneBySubject(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	m, err := r.client.UserIdentity.Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.SubjectEQ(subject),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&userIdentityModel{m}).toEntity(), nil
+}
+
+func (r *UserIdentityRepository) Create(ctx context.Context, e domain.UserIdentity) (*domain.UserIdentity, error) {
+	m, err := r.client.UserIdentity.Create().
+		SetUserID(e.UserID).
+		SetProvider(e.Provider).
+		SetSubject(e.Subject).
+		Save(ctx)
+	if err != nil {
+		return nil, errors.WithStack(handleError(err))
+	}
+	return (&userIdentityModel{m}).toEntity(), nil
+}
+
+func (r *UserIdentityRepository) DeleteByUser(ctx context.Context, user int64) error {
+	_, err := r.client.UserIdentity.Delete().
+		Where(useridentity.UserIDEQ(user)).
+		Exec(ctx)
+	return errors.WithStack(handleError(err))
+}
+
+type userIdentityModel struct {
+	*ent.UserIdentity
+}
+
+func (m *userIdentityModel) toEntity() *domain.UserIdentity {
+	return &domain.UserIdentity{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Provider:  m.Provider,
+		Subject:   m.Subject,
+		CreatedAt: m.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+)
+
+// AccountActionTokenClaims the purpose is carried as the audience,
+// so that the token can not be used as the access token or for another purpose
+type AccountActionTokenClaims struct {
+	jwt.RegisteredClaims
+	Email string `json:"email,omitempty"`
+}
+
+// GenerateActionToken sign the account action with the key ring
+func (s *AccountTokenService) GenerateActionToken(action domain.AccountAction) (string, error) {
+	key, err := s.ring.SigningKey(time.Now())
+	if err != nil {
+		return "", err
+	}
+
+	claims := AccountActionTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        action.ID,
+			Subject:   strconv.FormatInt(action.UserID, 10),
+			Audience:  jwt.ClaimStrings{action.Purpose.String()},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(action.ExpiresAt),
+		},
+		Email: action.Email,
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+
+	sign, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return sign, nil
+}
+
+// ValidateActionToken verify the token is signed for the purpose and not expired
+func (s *AccountTokenService) ValidateActionToken(token string, purpose domain.AccountActionPurpose) (*domain.AccountAction, error) {
+	t, err := jwt.ParseWithClaims(token, &AccountActionTokenClaims{}, s.keyFunc,
+		jwt.WithValidMethods(s.ring.Methods()),
+		jwt.WithAudience(purpose.String()),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	claims, ok := t.Claims.(*AccountActionTokenClaims)
+	if !t.Valid || !ok || claims.ID == "" {
+		return nil, errors.New("invalid token claims")
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &domain.AccountAction{
+		ID:        claims.ID,
+		Purpose:   purpose,
+		UserID:    userID,
+		Email:     claims.Email,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}, nil
+}
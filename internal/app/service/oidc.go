@@ -0,0 +1,459 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/config"
+)
+
+var (
+	// ErrUnknownOIDCProvider the provider is not configured
+	ErrUnknownOIDCProvider = errors.New("unknown OIDC provider")
+
+	// ErrInvalidIDToken the ID token is not issued by the provider for the authorization request
+	ErrInvalidIDToken = errors.New("invalid ID token")
+)
+
+const (
+	oidcHTTPTimeout = time.Second * 10
+	// oidcKeysRefreshInterval the keys are fetched again for the unknown kid at most once per interval
+	oidcKeysRefreshInterval = time.Minute
+	oidcIDTokenLeeway       = time.Minute
+	oidcMaxResponseSize     = 1 << 20
+)
+
+var (
+	defaultOIDCScopes        = []string{"openid", "profile", "email"}
+	defaultOIDCUsernameClaim = "preferred_username"
+
+	oidcSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+)
+
+// OIDCProviders the configured identity providers
+type OIDCProviders struct {
+	clients map[string]*OIDCClient
+}
+
+// NewOIDCProviders build the clients of the providers according to the configuration,
+// the providers are discovered lazily, so that the application starts even if they are unavailable
+func NewOIDCProviders(conf config.App) (*OIDCProviders, error) {
+	clients := make(map[string]*OIDCClient, len(conf.OIDC.Providers))
+
+	for _, pc := range conf.OIDC.Providers {
+		if pc.Name == "" || pc.Issuer == "" || pc.ClientID == "" || pc.RedirectURL == "" {
+			return nil, errors.Errorf("the name, issuer, client id and redirect url of the OIDC provider %q are required", pc.Name)
+		}
+		if _, ok := clients[pc.Name]; ok {
+			return nil, errors.Errorf("duplicate OIDC provider: %s", pc.Name)
+		}
+		clients[pc.Name] = NewOIDCClient(pc, &http.Client{Timeout: oidcHTTPTimeout})
+	}
+
+	return &OIDCProviders{clients: clients}, nil
+}
+
+// Get returns the client of the provider
+func (p *OIDCProviders) Get(name string) (*OIDCClient, error) {
+	c, ok := p.clients[name]
+	if !ok {
+		return nil, errors.WithStack(ErrUnknownOIDCProvider)
+	}
+	return c, nil
+}
+
+// oidcMetadata the provider configuration, see OpenID Connect Discovery 1.0
+type oidcMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCClient the relying party of the provider, only the authorization code flow with PKCE is supported
+type OIDCClient struct {
+	conf   config.OIDCProvider
+	client *http.Client
+
+	mu            sync.Mutex
+	metadata      *oidcMetadata
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewOIDCClient returns *OIDCClient
+func NewOIDCClient(conf config.OIDCProvider, client *http.Client) *OIDCClient {
+	if len(conf.Scopes) == 0 {
+		conf.Scopes = defaultOIDCScopes
+	}
+	if conf.UsernameClaim == "" {
+		conf.UsernameClaim = defaultOIDCUsernameClaim
+	}
+
+	return &OIDCClient{
+		conf:   conf,
+		client: client,
+	}
+}
+
+// Config returns the configuration of the provider
+func (c *OIDCClient) Config() config.OIDCProvider {
+	return c.conf
+}
+
+// AuthCodeURL returns the URL of the provider that the user signs in at
+func (c *OIDCClient) AuthCodeURL(ctx context.Context, auth domain.OIDCAuthorization) (string, error) {
+	m, err := c.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(m.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.conf.ClientID)
+	q.Set("redirect_uri", c.conf.RedirectURL)
+	q.Set("scope", strings.Join(c.conf.Scopes, " "))
+	q.Set("state", auth.State)
+	q.Set("nonce", auth.Nonce)
+	q.Set("code_challenge", auth.CodeChallenge())
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Exchange exchange the authorization code for the ID token, and returns the identity in the verified ID token
+func (c *OIDCClient) Exchange(ctx context.Context, auth domain.OIDCAuthorization, code string) (*domain.OIDCIdentity, error) {
+	m, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.conf.RedirectURL)
+	form.Set("code_verifier", auth.CodeVerifier)
+	if c.conf.ClientSecret == "" {
+		form.Set("client_id", c.conf.ClientID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if c.conf.ClientSecret != "" {
+		// client_secret_basic, the credentials are form-urlencoded first, see RFC 6749 section 2.3.1
+		req.SetBasicAuth(url.QueryEscape(c.conf.ClientID), url.QueryEscape(c.conf.ClientSecret))
+	}
+
+	var ret struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := c.do(req, &ret)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || ret.Error != "" {
+		return nil, errors.Errorf("token request failed with status %d: %s %s", status, ret.Error, ret.ErrorDescription)
+	}
+	if ret.IDToken == "" {
+		return nil, errors.New("the token response does not contain the ID token")
+	}
+
+	return c.verify(ctx, m, auth, ret.IDToken)
+}
+
+// verify the ID token, see OpenID Connect Core 1.0 section 3.1.3.7
+func (c *OIDCClient) verify(ctx context.Context, m *oidcMetadata, auth domain.OIDCAuthorization, raw string) (*domain.OIDCIdentity, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return c.key(ctx, m, kid)
+	},
+		jwt.WithValidMethods(oidcSigningMethods),
+		jwt.WithIssuer(m.Issuer),
+		jwt.WithAudience(c.conf.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(oidcIDTokenLeeway),
+	)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidIDToken, err.Error())
+	}
+
+	nonce, _ := claims["nonce"].(string)
+	if subtle.ConstantTimeCompare([]byte(nonce), []byte(auth.Nonce)) != 1 {
+		return nil, errors.Wrap(ErrInvalidIDToken, "nonce mismatch")
+	}
+	if azp, ok := claims["azp"].(string); ok && azp != c.conf.ClientID {
+		return nil, errors.Wrap(ErrInvalidIDToken, "authorized party mismatch")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.Wrap(ErrInvalidIDToken, "missing subject")
+	}
+
+	identity := &domain.OIDCIdentity{
+		Provider:      c.conf.Name,
+		Subject:       subject,
+		Username:      stringClaim(claims, c.conf.UsernameClaim),
+		Name:          stringClaim(claims, "name"),
+		Email:         stringClaim(claims, "email"),
+		EmailVerified: boolClaim(claims, "email_verified"),
+	}
+	if c.conf.RoleClaim != "" {
+		identity.Groups = stringsClaim(claims, c.conf.RoleClaim)
+	}
+
+	return identity, nil
+}
+
+// discover fetch the provider configuration, it is cached once fetched
+func (c *OIDCClient) discover(ctx context.Context) (*oidcMetadata, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.metadata != nil {
+		return c.metadata, nil
+	}
+
+	m := new(oidcMetadata)
+	if err := c.getJSON(ctx, strings.TrimSuffix(c.conf.Issuer, "/")+"/.well-known/openid-configuration", m); err != nil {
+		return nil, errors.WithMessage(err, "discover the OIDC provider")
+	}
+	if m.Issuer != c.conf.Issuer {
+		return nil, errors.Errorf("the issuer %q of the provider configuration does not match %q", m.Issuer, c.conf.Issuer)
+	}
+	if m.AuthorizationEndpoint == "" || m.TokenEndpoint == "" || m.JWKSURI == "" {
+		return nil, errors.New("the provider configuration is incomplete")
+	}
+
+	c.metadata = m
+	return m, nil
+}
+
+// key returns the verification key by the kid, the keys are fetched again if the kid is unknown,
+// the key is chosen if the kid is absent and there is only one key
+func (c *OIDCClient) key(ctx context.Context, m *oidcMetadata, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := lookupOIDCKey(c.keys, kid); ok {
+		return key, nil
+	}
+	if c.keys != nil && time.Since(c.keysFetchedAt) < oidcKeysRefreshInterval {
+		return nil, errors.WithStack(ErrUnknownSigningKey)
+	}
+
+	var set struct {
+		Keys []JWK `json:"keys"`
+	}
+	if err := c.getJSON(ctx, m.JWKSURI, &set); err != nil {
+		return nil, errors.WithMessage(err, "fetch the keys of the OIDC provider")
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// the unsupported keys are skipped, the tokens signed by them are rejected
+		if pub, err := k.PublicKey(); err == nil {
+			keys[k.Kid] = pub
+		}
+	}
+	c.keys, c.keysFetchedAt = keys, time.Now()
+
+	if key, ok := lookupOIDCKey(c.keys, kid); ok {
+		return key, nil
+	}
+	return nil, errors.WithStack(ErrUnknownSigningKey)
+}
+
+func lookupOIDCKey(keys map[string]crypto.PublicKey, kid string) (crypto.PublicKey, bool) {
+	if key, ok := keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func (c *OIDCClient) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	status, err := c.do(req, v)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return errors.Errorf("GET %s failed with status %d", u, status)
+	}
+	return nil
+}
+
+// do send the request and decode the JSON response, the response of the error status is decoded as well
+func (c *OIDCClient) do(req *http.Request, v any) (int, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	if err := json.Unmarshal(body, v); err != nil && resp.StatusCode == http.StatusOK {
+		return 0, errors.WithStack(err)
+	}
+	return resp.StatusCode, nil
+}
+
+// PublicKey decode the public key in JSON Web Key format
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		pub := &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, errors.New("the point is not on the curve")
+		}
+		return pub, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, errors.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 public key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, errors.Errorf("unsupported key type %q", k.Kty)
+}
+
+// claim returns the claim by the name, nested claims are separated by dots
+func claim(claims jwt.MapClaims, name string) any {
+	if v, ok := claims[name]; ok {
+		return v
+	}
+
+	var v any = map[string]any(claims)
+	for _, key := range strings.Split(name, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claim(claims, name).(string)
+	return s
+}
+
+// boolClaim some providers encode the boolean claims as strings
+func boolClaim(claims jwt.MapClaims, name string) bool {
+	switch v := claim(claims, name).(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// stringsClaim the claim may be a single string or an array of strings
+func stringsClaim(claims jwt.MapClaims, name string) []string {
+	switch v := claim(claims, name).(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, i := range v {
+			if s, ok := i.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
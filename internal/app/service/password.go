@@ -0,0 +1,30 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/config"
+)
+
+// NewPasswordHasher build the password hasher according to the configuration
+func NewPasswordHasher(conf config.App) (domain.PasswordHasher, error) {
+	algorithm := conf.Password.Algorithm
+	if algorithm == "" {
+		algorithm = config.Argon2id
+	}
+	if !algorithm.IsSupported() {
+		return nil, errors.Errorf("unsupported password algorithm: %s", algorithm)
+	}
+
+	var current domain.PasswordHasher
+	switch algorithm {
+	case config.Bcrypt:
+		current = domain.NewBcryptPasswordHasher(conf.Password.Bcrypt.Cost)
+	case config.Argon2id:
+		argon2id := conf.Password.Argon2id
+		current = domain.NewArgon2idPasswordHasher(argon2id.Memory, argon2id.Iterations, argon2id.Parallelism)
+	}
+
+	return domain.NewDelegatingPasswordHasher(algorithm.String(), current), nil
+}
@@ -0,0 +1,10 @@
+package service
+
+import "github.com/google/wire"
+
+var ProviderSet = wire.NewSet(
+	NewPasswordHasher,
+	NewAccountTokenKeyRingFromConfig,
+	NewAccountTokenService,
+	NewOIDCProviders,
+)
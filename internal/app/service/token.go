@@ -8,7 +8,13 @@ import (
 )
 
 type AccountTokenData struct {
-	UserID int64 `json:"userID"`
+	UserID   int64  `json:"userID"`
+	FamilyID string `json:"familyID"` // the refresh token family that the access token is issued with
+	// ActorID the real user behind the impersonation, UserID is the impersonated user,
+	// the impersonation token is bound to the session family of the actor
+	ActorID int64 `json:"actorID,omitempty"`
+	// TenantID the tenant that the user acts in by default, the tenant of the user if it is 0
+	TenantID int64 `json:"tenantID,omitempty"`
 }
 
 type AccountTokenClaims struct {
@@ -16,27 +22,38 @@ type AccountTokenClaims struct {
 	Data *AccountTokenData `json:"data"`
 }
 
+// AccountTokenService sign and verify the access token with the key ring,
+// the kid header identifies the key so that the token can be verified offline by the JWKS
 type AccountTokenService struct {
-	Key    string
-	Expire time.Duration
+	ring *AccountTokenKeyRing
 }
 
-func NewAccountTokenService(key string) *AccountTokenService {
+func NewAccountTokenService(ring *AccountTokenKeyRing) *AccountTokenService {
 	return &AccountTokenService{
-		Key: key,
+		ring: ring,
 	}
 }
 
 func (s *AccountTokenService) Generate(expire time.Duration, data AccountTokenData) (string, error) {
+	now := time.Now()
+
+	key, err := s.ring.SigningKey(now)
+	if err != nil {
+		return "", err
+	}
+
 	claims := AccountTokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expire)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expire)),
 		},
 		Data: &data,
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	sign, err := token.SignedString([]byte(s.Key))
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+
+	sign, err := token.SignedString(key.PrivateKey)
 	if err != nil {
 		return "", errors.WithStack(err)
 	}
@@ -45,23 +62,49 @@ func (s *AccountTokenService) Generate(expire time.Duration, data AccountTokenDa
 }
 
 func (s *AccountTokenService) ValidateToken(token string) (*AccountTokenClaims, error) {
-	t, err := jwt.ParseWithClaims(token, &AccountTokenClaims{}, func(token *jwt.Token) (any, error) {
-		return []byte(s.Key), nil
-	})
+	t, err := jwt.ParseWithClaims(token, &AccountTokenClaims{}, s.keyFunc, jwt.WithValidMethods(s.ring.Methods()))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	claim, ok := t.Claims.(*AccountTokenClaims)
-	if !t.Valid || !ok {
-		return nil, errors.WithStack(err)
+	if !t.Valid || !ok || claim.Data == nil {
+		return nil, errors.New("invalid token claims")
 	}
 
 	return claim, nil
 }
 
+// keyFunc look up the verification key by the kid header
+func (s *AccountTokenService) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, err := s.ring.VerificationKey(kid, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if key.Method.Alg() != token.Method.Alg() {
+		return nil, errors.Errorf("signing method %s does not match the key %s", token.Method.Alg(), kid)
+	}
+
+	return key.PublicKey(), nil
+}
+
+// JWKS returns the public keys that verify the access token
+func (s *AccountTokenService) JWKS() []JWK {
+	return s.ring.JWKS(time.Now())
+}
+
 func ParseAccountTokenUnverified(token string) (*AccountTokenClaims, error) {
 	t, _, err := jwt.NewParser().ParseUnverified(token, &AccountTokenClaims{})
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	return t.Claims.(*AccountTokenClaims), nil
+	claims := t.Claims.(*AccountTokenClaims)
+	if claims.Data == nil {
+		return nil, errors.New("invalid token claims")
+	}
+
+	return claims, nil
 }
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"log/slog"
+	"math/big"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/config"
+)
+
+var (
+	// ErrNoSigningKey there is no key in effect to sign the token
+	ErrNoSigningKey = errors.New("no signing key in effect")
+
+	// ErrUnknownSigningKey the kid of the token is not in the key ring
+	ErrUnknownSigningKey = errors.New("unknown signing key")
+)
+
+// AccountTokenKey the key that signs the access token
+type AccountTokenKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	NotBefore  time.Time
+	NotAfter   time.Time
+}
+
+// NewAccountTokenKey returns *AccountTokenKey, the signing method is chosen by the type of the key
+func NewAccountTokenKey(id string, key crypto.Signer, notBefore, notAfter time.Time) (*AccountTokenKey, error) {
+	var method jwt.SigningMethod
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		method = jwt.SigningMethodRS256
+	case ed25519.PrivateKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.Errorf("unsupported signing key type %T", key)
+	}
+
+	return &AccountTokenKey{
+		ID:         id,
+		Method:     method,
+		PrivateKey: key,
+		NotBefore:  notBefore,
+		NotAfter:   notAfter,
+	}, nil
+}
+
+// PublicKey the key that verifies the token
+func (k *AccountTokenKey) PublicKey() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
+
+// IsSigning reports whether the key signs the tokens at the time
+func (k *AccountTokenKey) IsSigning(t time.Time) bool {
+	return !t.Before(k.NotBefore) && (k.NotAfter.IsZero() || t.Before(k.NotAfter))
+}
+
+// IsPublished reports whether the key verifies the tokens at the time,
+// the upcoming key is published ahead so that the verifiers can cache it before it signs,
+// the retired key is published until the tokens signed by it expire
+func (k *AccountTokenKey) IsPublished(t time.Time, overlap time.Duration) bool {
+	return k.NotAfter.IsZero() || t.Before(k.NotAfter.Add(overlap))
+}
+
+// JWK the public key in JSON Web Key format, see RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JWK returns the public key in JSON Web Key format
+func (k *AccountTokenKey) JWK() JWK {
+	jwk := JWK{
+		Use: "sig",
+		Kid: k.ID,
+		Alg: k.Method.Alg(),
+	}
+
+	switch pub := k.PublicKey().(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+
+	return jwk
+}
+
+// AccountTokenKeyRing the keys that sign and verify the access token
+type AccountTokenKeyRing struct {
+	keys    []*AccountTokenKey
+	overlap time.Duration
+}
+
+// NewAccountTokenKeyRing returns *AccountTokenKeyRing
+//
+// the retired keys overlap the new keys by the lifetime of the access token
+func NewAccountTokenKeyRing(keys ...*AccountTokenKey) *AccountTokenKeyRing {
+	keys = append([]*AccountTokenKey(nil), keys...)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].NotBefore.After(keys[j].NotBefore)
+	})
+
+	return &AccountTokenKeyRing{
+		keys:    keys,
+		overlap: domain.AccountAccessTokenExpireDuration,
+	}
+}
+
+// NewAccountTokenKeyRingFromConfig load the key ring according to the configuration
+func NewAccountTokenKeyRingFromConfig(logger *slog.Logger, conf config.App) (*AccountTokenKeyRing, error) {
+	if len(conf.Token.Keys) == 0 {
+		logger.Warn("the token signing keys are not configured, an ephemeral key is generated, " +
+			"the tokens are invalidated on restart and can not be verified by other instances")
+
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		k, err := NewAccountTokenKey("ephemeral", key, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+
+		return NewAccountTokenKeyRing(k), nil
+	}
+
+	keys := make([]*AccountTokenKey, 0, len(conf.Token.Keys))
+	ids := make(map[string]struct{}, len(conf.Token.Keys))
+
+	for _, kc := range conf.Token.Keys {
+		if kc.ID == "" {
+			return nil, errors.New("the id of token signing key is required")
+		}
+		if _, ok := ids[kc.ID]; ok {
+			return nil, errors.Errorf("duplicate token signing key id: %s", kc.ID)
+		}
+		ids[kc.ID] = struct{}{}
+
+		pem, err := os.ReadFile(kc.PrivateKey)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		signer, err := parsePrivateKeyFromPEM(pem)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "token signing key %s", kc.ID)
+		}
+
+		k, err := NewAccountTokenKey(kc.ID, signer, kc.NotBefore, kc.NotAfter)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+
+	return NewAccountTokenKeyRing(keys...), nil
+}
+
+// SigningKey returns the key in effect at the time
+func (r *AccountTokenKeyRing) SigningKey(t time.Time) (*AccountTokenKey, error) {
+	for _, k := range r.keys {
+		if k.IsSigning(t) {
+			return k, nil
+		}
+	}
+	return nil, errors.WithStack(ErrNoSigningKey)
+}
+
+// VerificationKey returns the published key by id
+func (r *AccountTokenKeyRing) VerificationKey(kid string, t time.Time) (*AccountTokenKey, error) {
+	for _, k := range r.keys {
+		if k.ID == kid && k.IsPublished(t, r.overlap) {
+			return k, nil
+		}
+	}
+	return nil, errors.WithStack(ErrUnknownSigningKey)
+}
+
+// JWKS returns the published keys in JSON Web Key Set format
+func (r *AccountTokenKeyRing) JWKS(t time.Time) []JWK {
+	keys := make([]JWK, 0, len(r.keys))
+	for _, k := range r.keys {
+		if k.IsPublished(t, r.overlap) {
+			keys = append(keys, k.JWK())
+		}
+	}
+	return keys
+}
+
+// Methods returns the signing methods of the keys
+func (r *AccountTokenKeyRing) Methods() []string {
+	methods := make([]string, 0, 2)
+	seen := make(map[string]struct{}, 2)
+	for _, k := range r.keys {
+		if _, ok := seen[k.Method.Alg()]; !ok {
+			seen[k.Method.Alg()] = struct{}{}
+			methods = append(methods, k.Method.Alg())
+		}
+	}
+	return methods
+}
+
+func parsePrivateKeyFromPEM(pem []byte) (crypto.Signer, error) {
+	if key, err := jwt.ParseRSAPrivateKeyFromPEM(pem); err == nil {
+		return key, nil
+	}
+
+	key, err := jwt.ParseEdPrivateKeyFromPEM(pem)
+	if err != nil {
+		return nil, errors.New("the private key must be a PEM encoded RSA or Ed25519 key")
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
@@ -2,46 +2,244 @@ package usecase
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
 	"go-scaffold/internal/app/service"
 )
 
+// ErrRefreshTokenReused a refresh token that has been exchanged is presented again,
+// the token family is revoked since the token may have been stolen
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
 var _ AccountUseCaseInterface = (*AccountUseCase)(nil)
 
 type AccountUseCaseInterface interface {
-	Login(ctx context.Context, user domain.User) (string, error)
-	Logout(ctx context.Context, user domain.User) error
+	Login(ctx context.Context, user domain.User, client domain.SessionClient) (*domain.AccountToken, error)
+	RefreshToken(ctx context.Context, refreshToken string, client domain.SessionClient) (*domain.AccountToken, error)
+	Logout(ctx context.Context, user domain.User, accessToken string) error
+	TouchSession(ctx context.Context, user domain.User, sessionID string) error
+	ListSessions(ctx context.Context, user domain.User) ([]*domain.Session, error)
+	RevokeSession(ctx context.Context, user domain.User, sessionID string) error
+	RevokeAllSessions(ctx context.Context, user domain.User) error
 }
 
 type AccountUseCase struct {
-	repo repository.UserRepositoryInterface
+	tokenService *service.AccountTokenService
+	repo         repository.UserRepositoryInterface
+	tokenRepo    repository.RefreshTokenRepositoryInterface
+	sessionRepo  repository.SessionRepositoryInterface
 }
 
 func NewAccountUseCase(
+	tokenService *service.AccountTokenService,
 	repo repository.UserRepositoryInterface,
+	tokenRepo repository.RefreshTokenRepositoryInterface,
+	sessionRepo repository.SessionRepositoryInterface,
 ) *AccountUseCase {
 	return &AccountUseCase{
-		repo: repo,
+		tokenService: tokenService,
+		repo:         repo,
+		tokenRepo:    tokenRepo,
+		sessionRepo:  sessionRepo,
 	}
 }
 
-func (c AccountUseCase) Login(ctx context.Context, user domain.User) (string, error) {
-	tokenExpire := domain.AccountTokenExpireDuration
+// Login start a new session on the client, and issue a token pair for it
+func (c AccountUseCase) Login(ctx context.Context, user domain.User, client domain.SessionClient) (*domain.AccountToken, error) {
+	token, refreshToken, err := c.issueToken(ctx, user, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
 
-	data := service.AccountTokenData{
-		UserID: user.ID,
+	session := domain.NewSession(refreshToken.FamilyID, user.ID, client, refreshToken.ExpiresAt)
+	if err := c.sessionRepo.Create(ctx, *session); err != nil {
+		return nil, err
 	}
-	return service.NewAccountTokenService(user.Salt).Generate(tokenExpire, data)
+
+	return token, nil
 }
 
-func (c AccountUseCase) Logout(ctx context.Context, user domain.User) error {
-	user.RefreshSalt()
+// RefreshToken exchange the refresh token for a new token pair of the same family,
+// the refresh token can only be exchanged once
+func (c AccountUseCase) RefreshToken(ctx context.Context, refreshToken string, client domain.SessionClient) (*domain.AccountToken, error) {
+	token, err := c.tokenRepo.FindOne(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.IsRotated() {
+		return nil, c.revokeReusedFamily(ctx, *token)
+	}
+
+	ok, err := c.tokenRepo.MarkRotated(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if !ok { // exchanged concurrently
+		return nil, c.revokeReusedFamily(ctx, *token)
+	}
+
+	session, err := c.sessionRepo.FindOne(ctx, token.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.repo.FindOne(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, next, err := c.issueToken(ctx, *user, token.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.SessionClient = client
+	session.LastSeenAt = time.Now()
+	session.ExpiresAt = next.ExpiresAt
+	if err := c.sessionRepo.Update(ctx, *session); err != nil {
+		return nil, err
+	}
 
-	if _, err := c.repo.Update(ctx, user); err != nil {
+	return ret, nil
+}
+
+// Logout revoke the session that the access token is issued for,
+// the sessions on other devices are not affected
+func (c AccountUseCase) Logout(ctx context.Context, user domain.User, accessToken string) error {
+	claims, err := service.ParseAccountTokenUnverified(accessToken)
+	if err != nil {
+		return err
+	}
+
+	if claims.Data.FamilyID == "" {
+		return nil
+	}
+
+	err = c.RevokeSession(ctx, user, claims.Data.FamilyID)
+	if repository.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// TouchSession ensure the session of the user is not revoked, and update its last seen time
+func (c AccountUseCase) TouchSession(ctx context.Context, user domain.User, sessionID string) error {
+	session, err := c.findSession(ctx, user, sessionID)
+	if err != nil {
 		return err
 	}
 
+	if !session.NeedsTouch() {
+		return nil
+	}
+
+	session.LastSeenAt = time.Now()
+	return c.sessionRepo.Update(ctx, *session)
+}
+
+// ListSessions list the active sessions of the user
+func (c AccountUseCase) ListSessions(ctx context.Context, user domain.User) ([]*domain.Session, error) {
+	return c.sessionRepo.FindListByUser(ctx, user.ID)
+}
+
+// RevokeSession delete the session of the user and revoke its refresh tokens,
+// the access tokens issued for it are rejected from then on
+func (c AccountUseCase) RevokeSession(ctx context.Context, user domain.User, sessionID string) error {
+	session, err := c.findSession(ctx, user, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.tokenRepo.RevokeFamily(ctx, session.ID); err != nil {
+		return err
+	}
+
+	return c.sessionRepo.Delete(ctx, *session)
+}
+
+// RevokeAllSessions revoke the sessions of the user on all the devices
+func (c AccountUseCase) RevokeAllSessions(ctx context.Context, user domain.User) error {
+	list, err := c.sessionRepo.FindListByUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range list {
+		if err := c.tokenRepo.RevokeFamily(ctx, session.ID); err != nil {
+			return err
+		}
+		if err := c.sessionRepo.Delete(ctx, *session); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// findSession the session of another user is treated as not exist
+func (c AccountUseCase) findSession(ctx context.Context, user domain.User, sessionID string) (*domain.Session, error) {
+	session, err := c.sessionRepo.FindOne(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.UserID != user.ID {
+		return nil, errors.WithStack(repository.ErrRecordNotFound)
+	}
+
+	return session, nil
+}
+
+func (c AccountUseCase) issueToken(ctx context.Context, user domain.User, familyID string) (*domain.AccountToken, *domain.RefreshToken, error) {
+	refreshToken, err := domain.NewRefreshToken(user.ID, familyID, domain.AccountRefreshTokenExpireDuration)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.tokenRepo.Create(ctx, *refreshToken); err != nil {
+		return nil, nil, err
+	}
+
+	data := service.AccountTokenData{
+		UserID:   user.ID,
+		FamilyID: familyID,
+		TenantID: user.TenantID,
+	}
+	accessTokenExpire := domain.AccountAccessTokenExpireDuration
+	accessToken, err := c.tokenService.Generate(accessTokenExpire, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &domain.AccountToken{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  time.Now().Add(accessTokenExpire),
+		RefreshToken:          refreshToken.Token,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, refreshToken, nil
+}
+
+// revokeReusedFamily revoke the session that the reused token belongs to
+func (c AccountUseCase) revokeReusedFamily(ctx context.Context, token domain.RefreshToken) error {
+	if err := c.tokenRepo.RevokeFamily(ctx, token.FamilyID); err != nil {
+		return err
+	}
+
+	session, err := c.sessionRepo.FindOne(ctx, token.FamilyID)
+	if err != nil && !repository.IsNotFound(err) {
+		return err
+	}
+	if session != nil {
+		if err := c.sessionRepo.Delete(ctx, *session); err != nil {
+			return err
+		}
+	}
+
+	return errors.WithStack(ErrRefreshTokenReused)
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/service"
+	"go-scaffold/internal/config"
+	"go-scaffold/internal/pkg/mail"
+)
+
+var (
+	// ErrAccountActionTokenInvalid the token is malformed, expired, used or replaced by a newer one
+	ErrAccountActionTokenInvalid = errors.New("invalid account action token")
+
+	// ErrEmailNotSet the user has not set the email
+	ErrEmailNotSet = errors.New("email is not set")
+
+	// ErrEmailAlreadyVerified the email of the user has been verified
+	ErrEmailAlreadyVerified = errors.New("email is already verified")
+)
+
+var _ AccountRecoveryUseCaseInterface = (*AccountRecoveryUseCase)(nil)
+
+type AccountRecoveryUseCaseInterface interface {
+	// ForgotPassword send the password reset mail to the verified email,
+	// nothing happens if there is no such user, so that the emails can not be enumerated
+	ForgotPassword(ctx context.Context, email string) error
+	// ResetPassword reset the password with the token in the password reset mail
+	ResetPassword(ctx context.Context, token string, password domain.Password) (*domain.User, error)
+	// SendEmailVerification send the verification mail to the email of the user
+	SendEmailVerification(ctx context.Context, user domain.User) error
+	// VerifyEmail verify the email with the token in the verification mail
+	VerifyEmail(ctx context.Context, token string) (*domain.User, error)
+}
+
+type AccountRecoveryUseCase struct {
+	appName      config.AppName
+	conf         config.App
+	tokenService *service.AccountTokenService
+	mailer       mail.Mailer
+	repo         repository.UserRepositoryInterface
+	actionRepo   repository.AccountActionRepositoryInterface
+}
+
+func NewAccountRecoveryUseCase(
+	appName config.AppName,
+	conf config.App,
+	tokenService *service.AccountTokenService,
+	mailer mail.Mailer,
+	repo repository.UserRepositoryInterface,
+	actionRepo repository.AccountActionRepositoryInterface,
+) *AccountRecoveryUseCase {
+	return &AccountRecoveryUseCase{
+		appName:      appName,
+		conf:         conf,
+		tokenService: tokenService,
+		mailer:       mailer,
+		repo:         repo,
+		actionRepo:   actionRepo,
+	}
+}
+
+func (c AccountRecoveryUseCase) ForgotPassword(ctx context.Context, email string) error {
+	user, err := c.repo.FindOneByEmail(ctx, email)
+	if repository.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// the password can only be reset by the email that the user has proved to own
+	if !user.EmailVerified() {
+		return nil
+	}
+
+	token, err := c.issue(ctx, domain.PasswordResetPurpose, *user, domain.PasswordResetTokenExpireDuration)
+	if err != nil {
+		return err
+	}
+
+	return c.mailer.Send(ctx, mail.Message{
+		To:      []string{user.Email},
+		Subject: fmt.Sprintf("[%s] Reset your password", c.appName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nSomeone requested a password reset for your account, "+
+				"use the following link in %d minutes to reset your password:\n\n%s\n\n"+
+				"If you did not request it, please ignore this mail, your password will not be changed.\n",
+			user.Nickname,
+			int(domain.PasswordResetTokenExpireDuration.Minutes()),
+			actionLink(c.conf.Mail.PasswordResetURL, token),
+		),
+	})
+}
+
+func (c AccountRecoveryUseCase) ResetPassword(ctx context.Context, token string, password domain.Password) (*domain.User, error) {
+	action, user, err := c.consume(ctx, token, domain.PasswordResetPurpose)
+	if err != nil {
+		return nil, err
+	}
+
+	// the email may have been changed after the mail was sent
+	if user.Email != action.Email || !user.EmailVerified() {
+		return nil, errors.WithStack(ErrAccountActionTokenInvalid)
+	}
+
+	user.Password = password
+
+	return c.repo.Update(ctx, *user)
+}
+
+func (c AccountRecoveryUseCase) SendEmailVerification(ctx context.Context, user domain.User) error {
+	if user.Email == "" {
+		return errors.WithStack(ErrEmailNotSet)
+	}
+	if user.EmailVerified() {
+		return errors.WithStack(ErrEmailAlreadyVerified)
+	}
+
+	token, err := c.issue(ctx, domain.EmailVerificationPurpose, user, domain.EmailVerificationTokenExpireDuration)
+	if err != nil {
+		return err
+	}
+
+	return c.mailer.Send(ctx, mail.Message{
+		To:      []string{user.Email},
+		Subject: fmt.Sprintf("[%s] Verify your email", c.appName),
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease use the following link in %d hours to verify your email:\n\n%s\n",
+			user.Nickname,
+			int(domain.EmailVerificationTokenExpireDuration.Hours()),
+			actionLink(c.conf.Mail.EmailVerificationURL, token),
+		),
+	})
+}
+
+func (c AccountRecoveryUseCase) VerifyEmail(ctx context.Context, token string) (*domain.User, error) {
+	action, user, err := c.consume(ctx, token, domain.EmailVerificationPurpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Email != action.Email {
+		return nil, errors.WithStack(ErrAccountActionTokenInvalid)
+	}
+	if user.EmailVerified() {
+		return user, nil
+	}
+
+	ok, err := c.repo.VerifyEmail(ctx, *user, action.Email)
+	if err != nil {
+		return nil, err
+	}
+	if !ok { // the email is changed concurrently
+		return nil, errors.WithStack(ErrAccountActionTokenInvalid)
+	}
+
+	return c.repo.FindOne(ctx, user.ID)
+}
+
+// issue record the action and sign the token for it, the previous token of the purpose is invalidated
+func (c AccountRecoveryUseCase) issue(ctx context.Context, purpose domain.AccountActionPurpose, user domain.User, expire time.Duration) (string, error) {
+	action := domain.NewAccountAction(purpose, user.ID, user.Email, expire)
+
+	token, err := c.tokenService.GenerateActionToken(*action)
+	if err != nil {
+		return "", err
+	}
+
+	if err := c.actionRepo.Create(ctx, *action); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// consume verify the token and consume the action, the token can only be used once
+func (c AccountRecoveryUseCase) consume(ctx context.Context, token string, purpose domain.AccountActionPurpose) (*domain.AccountAction, *domain.User, error) {
+	action, err := c.tokenService.ValidateActionToken(token, purpose)
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrAccountActionTokenInvalid, err.Error())
+	}
+
+	ok, err := c.actionRepo.Consume(ctx, *action)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, errors.WithStack(ErrAccountActionTokenInvalid)
+	}
+
+	user, err := c.repo.FindOne(ctx, action.UserID)
+	if repository.IsNotFound(err) {
+		return nil, nil, errors.WithStack(ErrAccountActionTokenInvalid)
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return action, user, nil
+}
+
+// actionLink the token is sent bare if the link is not configured
+func actionLink(link, token string) string {
+	if link == "" {
+		return token
+	}
+	return strings.ReplaceAll(link, "{token}", url.QueryEscape(token))
+}
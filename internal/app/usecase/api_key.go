@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+)
+
+var (
+	// ErrNotServiceAccount the API key can only be issued to and used by the service account
+	ErrNotServiceAccount = errors.New("the user is not a service account")
+
+	// ErrAPIKeyInvalid the API key does not exist, or does not match
+	ErrAPIKeyInvalid = errors.New("invalid API key")
+
+	// ErrAPIKeyExpired the API key has expired
+	ErrAPIKeyExpired = errors.New("API key has expired")
+
+	// ErrUnknownAPIKeyScope the scope is not a permission key
+	ErrUnknownAPIKeyScope = errors.New("unknown API key scope")
+)
+
+var _ APIKeyUseCaseInterface = (*APIKeyUseCase)(nil)
+
+type APIKeyUseCaseInterface interface {
+	// Create issue an API key to the service account, the plaintext key is only returned here
+	Create(ctx context.Context, user domain.User, name string, scopes []string, expiresAt int64) (*domain.APIKey, string, error)
+	List(ctx context.Context, user int64) ([]*domain.APIKey, error)
+	Delete(ctx context.Context, key domain.APIKey) error
+	// Authenticate returns the service account that the API key belongs to
+	Authenticate(ctx context.Context, key string) (*domain.User, *domain.APIKey, error)
+}
+
+type APIKeyUseCase struct {
+	repo           repository.APIKeyRepositoryInterface
+	userRepo       repository.UserRepositoryInterface
+	permissionRepo repository.PermissionRepositoryInterface
+}
+
+func NewAPIKeyUseCase(
+	repo repository.APIKeyRepositoryInterface,
+	userRepo repository.UserRepositoryInterface,
+	permissionRepo repository.PermissionRepositoryInterface,
+) *APIKeyUseCase {
+	return &APIKeyUseCase{
+		repo:           repo,
+		userRepo:       userRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+func (c *APIKeyUseCase) Create(ctx context.Context, user domain.User, name string, scopes []string, expiresAt int64) (*domain.APIKey, string, error) {
+	if !user.ServiceAccount {
+		return nil, "", errors.WithStack(ErrNotServiceAccount)
+	}
+
+	for _, scope := range scopes {
+		exist, err := c.permissionRepo.KeyExist(ctx, scope)
+		if err != nil {
+			return nil, "", err
+		}
+		if !exist {
+			return nil, "", errors.Wrap(ErrUnknownAPIKeyScope, scope)
+		}
+	}
+
+	e, key, err := domain.NewAPIKey(user.ID, name, scopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret, err := c.repo.Create(ctx, *e)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return ret, key, nil
+}
+
+func (c *APIKeyUseCase) List(ctx context.Context, user int64) ([]*domain.APIKey, error) {
+	return c.repo.Filter(ctx, repository.APIKeyFindListParam{
+		UserID: user,
+	})
+}
+
+func (c *APIKeyUseCase) Delete(ctx context.Context, key domain.APIKey) error {
+	return c.repo.Delete(ctx, key)
+}
+
+func (c *APIKeyUseCase) Authenticate(ctx context.Context, key string) (*domain.User, *domain.APIKey, error) {
+	prefix, err := domain.ParseAPIKeyPrefix(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(ErrAPIKeyInvalid, err.Error())
+	}
+
+	e, err := c.repo.FindOneByPrefix(ctx, prefix)
+	if repository.IsNotFound(err) {
+		return nil, nil, errors.WithStack(ErrAPIKeyInvalid)
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if !e.Verify(key) {
+		return nil, nil, errors.WithStack(ErrAPIKeyInvalid)
+	}
+
+	now := time.Now()
+	if e.IsExpired(now) {
+		return nil, nil, errors.WithStack(ErrAPIKeyExpired)
+	}
+
+	user, err := c.userRepo.FindOne(ctx, e.UserID)
+	if repository.IsNotFound(err) {
+		return nil, nil, errors.WithStack(ErrAPIKeyInvalid)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	if !user.ServiceAccount {
+		return nil, nil, errors.WithStack(ErrNotServiceAccount)
+	}
+
+	if e.NeedsTouch(now) {
+		if err := c.repo.Touch(ctx, *e, now); err != nil {
+			return nil, nil, err
+		}
+		e.LastUsedAt = now.Unix()
+	}
+
+	return user, e, nil
+}
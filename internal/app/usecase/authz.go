@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+)
+
+var _ AuthzUseCaseInterface = (*AuthzUseCase)(nil)
+
+type AuthzUseCaseInterface interface {
+	// Explain explain the decision on the permission of the user within the tenant that the request acts in,
+	// it is the same as the one made by the Permission middleware
+	Explain(ctx context.Context, user domain.User, permissionKey string) (*domain.PermissionExplanation, error)
+}
+
+type AuthzUseCase struct {
+	userRepo       repository.UserRepositoryInterface
+	permissionRepo repository.PermissionRepositoryInterface
+}
+
+func NewAuthzUseCase(
+	userRepo repository.UserRepositoryInterface,
+	permissionRepo repository.PermissionRepositoryInterface,
+) *AuthzUseCase {
+	return &AuthzUseCase{
+		userRepo:       userRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+func (c *AuthzUseCase) Explain(ctx context.Context, user domain.User, permissionKey string) (*domain.PermissionExplanation, error) {
+	permission, err := c.permissionRepo.FindOneByKey(ctx, permissionKey)
+	if repository.IsNotFound(err) {
+		return &domain.PermissionExplanation{
+			Policies: make([][]string, 0),
+			RolePath: make([]string, 0),
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	e, err := c.userRepo.ExplainPermission(ctx, domain.TenantFromContext(ctx), user.ID, permission.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	e.PermissionExist = true
+	e.Permission = permission
+
+	return e, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/config"
+)
+
+var _ DataScopeUseCaseInterface = (*DataScopeUseCase)(nil)
+
+type DataScopeUseCaseInterface interface {
+	// Resolve returns the rows that the user may see within the tenant that the user acts in,
+	// the super admin users and roles may see all the rows,
+	// the data scopes of the parent roles are not inherited
+	Resolve(ctx context.Context, user domain.UserProfile) (*domain.DataFilter, error)
+}
+
+type DataScopeUseCase struct {
+	userRepo   repository.UserRepositoryInterface
+	casbinConf config.Casbin
+}
+
+func NewDataScopeUseCase(
+	userRepo repository.UserRepositoryInterface,
+	casbinConf config.Casbin,
+) *DataScopeUseCase {
+	return &DataScopeUseCase{
+		userRepo:   userRepo,
+		casbinConf: casbinConf,
+	}
+}
+
+func (c *DataScopeUseCase) Resolve(ctx context.Context, user domain.UserProfile) (*domain.DataFilter, error) {
+	u, err := c.userRepo.FindOne(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := c.userRepo.GetRoles(ctx, user.TenantID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.NewDataFilter(u, roles)
+
+	superAdmin := lo.ContainsBy(roles, func(item *domain.Role) bool {
+		return lo.Contains(c.casbinConf.SuperAdmin.Roles, item.ID)
+	})
+	if superAdmin || lo.Contains(c.casbinConf.SuperAdmin.Users, user.ID) {
+		filter.All = true
+	}
+
+	return &filter, nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/service"
+)
+
+var (
+	// ErrImpersonationSessionRequired the impersonation is only started with the access token of a login session
+	ErrImpersonationSessionRequired = errors.New("a login session is required to impersonate")
+
+	// ErrAlreadyImpersonating the impersonation can not be nested
+	ErrAlreadyImpersonating = errors.New("already impersonating")
+
+	// ErrImpersonateSelf the actor can not impersonate itself
+	ErrImpersonateSelf = errors.New("can not impersonate yourself")
+
+	// ErrImpersonateServiceAccount the service account is not impersonated, use the API key instead
+	ErrImpersonateServiceAccount = errors.New("can not impersonate the service account")
+)
+
+var _ ImpersonationUseCaseInterface = (*ImpersonationUseCase)(nil)
+
+type ImpersonationUseCaseInterface interface {
+	// Impersonate issue an access token that acts as the user on behalf of the actor,
+	// the token is bound to the session that the actor token is issued for, and is not refreshable
+	Impersonate(ctx context.Context, actor domain.User, actorToken string, user domain.User, reason string, client domain.SessionClient) (*domain.AccountToken, error)
+	// Record persist the audit log of the impersonation
+	Record(ctx context.Context, log domain.AuditLog) error
+	ListAuditLogs(ctx context.Context, param repository.AuditLogFindListParam) ([]*domain.AuditLog, error)
+}
+
+type ImpersonationUseCase struct {
+	tokenService *service.AccountTokenService
+	auditRepo    repository.AuditLogRepositoryInterface
+}
+
+func NewImpersonationUseCase(
+	tokenService *service.AccountTokenService,
+	auditRepo repository.AuditLogRepositoryInterface,
+) *ImpersonationUseCase {
+	return &ImpersonationUseCase{
+		tokenService: tokenService,
+		auditRepo:    auditRepo,
+	}
+}
+
+func (c ImpersonationUseCase) Impersonate(
+	ctx context.Context,
+	actor domain.User,
+	actorToken string,
+	user domain.User,
+	reason string,
+	client domain.SessionClient,
+) (*domain.AccountToken, error) {
+	claims, err := service.ParseAccountTokenUnverified(actorToken)
+	if err != nil || claims.Data.FamilyID == "" {
+		return nil, errors.WithStack(ErrImpersonationSessionRequired)
+	}
+	if claims.Data.ActorID != 0 {
+		return nil, errors.WithStack(ErrAlreadyImpersonating)
+	}
+	if actor.ID == user.ID {
+		return nil, errors.WithStack(ErrImpersonateSelf)
+	}
+	if user.ServiceAccount {
+		return nil, errors.WithStack(ErrImpersonateServiceAccount)
+	}
+
+	// the audit log is recorded before the token is issued, the impersonation is never unaccounted for
+	err = c.Record(ctx, domain.AuditLog{
+		TenantID:  domain.TenantFromContext(ctx),
+		ActorID:   actor.ID,
+		UserID:    user.ID,
+		Action:    domain.AuditActionImpersonate,
+		IP:        client.IP,
+		UserAgent: client.UserAgent,
+		Detail:    reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expire := domain.ImpersonationTokenExpireDuration
+	token, err := c.tokenService.Generate(expire, service.AccountTokenData{
+		UserID:   user.ID,
+		FamilyID: claims.Data.FamilyID,
+		ActorID:  actor.ID,
+		TenantID: domain.TenantFromContext(ctx), // bound to the tenant that the actor acts in
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AccountToken{
+		AccessToken:          token,
+		AccessTokenExpiresAt: time.Now().Add(expire),
+	}, nil
+}
+
+func (c ImpersonationUseCase) Record(ctx context.Context, log domain.AuditLog) error {
+	_, err := c.auditRepo.Create(ctx, log)
+	return err
+}
+
+func (c ImpersonationUseCase) ListAuditLogs(ctx context.Context, param repository.AuditLogFindListParam) ([]*domain.AuditLog, error) {
+	return c.auditRepo.Filter(ctx, param)
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/config"
+)
+
+// ErrLoginThrottled the login is attempted too frequently after failures
+var ErrLoginThrottled = errors.New("login throttled")
+
+// LoginThrottledError the login is delayed or locked out
+type LoginThrottledError struct {
+	Locked     bool
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrLoginThrottled, e.RetryAfter)
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrLoginThrottled
+}
+
+// LoginFailure the result of recording a failed login
+type LoginFailure struct {
+	// Locked the username or the IP is locked out by the failure
+	Locked  bool
+	Subject domain.LoginAttemptSubject
+}
+
+var _ LoginThrottleUseCaseInterface = (*LoginThrottleUseCase)(nil)
+
+type LoginThrottleUseCaseInterface interface {
+	// Check returns *LoginThrottledError if the login is not allowed now
+	Check(ctx context.Context, username, ip string) error
+	Fail(ctx context.Context, username, ip string) (*LoginFailure, error)
+	Succeed(ctx context.Context, username string) error
+	Unlock(ctx context.Context, user domain.User) error
+}
+
+type LoginThrottleUseCase struct {
+	policy domain.LoginThrottlePolicy
+	repo   repository.LoginAttemptRepositoryInterface
+}
+
+func NewLoginThrottleUseCase(
+	conf config.App,
+	repo repository.LoginAttemptRepositoryInterface,
+) *LoginThrottleUseCase {
+	return &LoginThrottleUseCase{
+		policy: newLoginThrottlePolicy(conf.Login),
+		repo:   repo,
+	}
+}
+
+func newLoginThrottlePolicy(conf config.Login) domain.LoginThrottlePolicy {
+	policy := domain.LoginThrottlePolicy{
+		FreeAttempts:    3,
+		MaxAttempts:     10,
+		MaxIPAttempts:   100,
+		MaxDelay:        time.Minute,
+		Window:          time.Minute * 15,
+		LockoutDuration: time.Minute * 15,
+	}
+
+	if conf.FreeAttempts > 0 {
+		policy.FreeAttempts = conf.FreeAttempts
+	}
+	if conf.MaxAttempts > 0 {
+		policy.MaxAttempts = conf.MaxAttempts
+	}
+	if conf.MaxIPAttempts > 0 {
+		policy.MaxIPAttempts = conf.MaxIPAttempts
+	}
+	if conf.MaxDelay > 0 {
+		policy.MaxDelay = conf.MaxDelay * time.Second
+	}
+	if conf.Window > 0 {
+		policy.Window = conf.Window * time.Second
+	}
+	if conf.LockoutDuration > 0 {
+		policy.LockoutDuration = conf.LockoutDuration * time.Second
+	}
+
+	return policy
+}
+
+func (c LoginThrottleUseCase) Check(ctx context.Context, username, ip string) error {
+	now := time.Now()
+
+	var retryAfter time.Duration
+	locked := false
+
+	for _, subject := range c.subjects(username, ip) {
+		attempts, err := c.repo.FindOne(ctx, subject)
+		if err != nil {
+			return err
+		}
+
+		if d := attempts.RetryAfter(c.policy, now); d > retryAfter {
+			retryAfter = d
+			locked = attempts.IsLocked(now)
+		}
+	}
+
+	if retryAfter > 0 {
+		return errors.WithStack(&LoginThrottledError{Locked: locked, RetryAfter: retryAfter})
+	}
+	return nil
+}
+
+// Fail count the failure by the username and the IP, and lock out the one that exceeds the limit
+func (c LoginThrottleUseCase) Fail(ctx context.Context, username, ip string) (*LoginFailure, error) {
+	ret := new(LoginFailure)
+
+	for _, subject := range c.subjects(username, ip) {
+		attempts, err := c.repo.Fail(ctx, subject, c.policy.Window)
+		if err != nil {
+			return nil, err
+		}
+
+		limit := c.policy.MaxAttempts
+		if subject == domain.IPLoginAttemptSubject(ip) {
+			limit = c.policy.MaxIPAttempts
+		}
+
+		if attempts.Failures >= limit && !attempts.IsLocked(time.Now()) {
+			if err := c.repo.Lock(ctx, subject, time.Now().Add(c.policy.LockoutDuration)); err != nil {
+				return nil, err
+			}
+			ret.Locked = true
+			ret.Subject = subject
+		}
+	}
+
+	return ret, nil
+}
+
+// Succeed forget the failures of the username, the failures of the IP are kept
+func (c LoginThrottleUseCase) Succeed(ctx context.Context, username string) error {
+	return c.repo.Reset(ctx, domain.UsernameLoginAttemptSubject(username))
+}
+
+// Unlock lift the lockout of the user and forget the failures
+func (c LoginThrottleUseCase) Unlock(ctx context.Context, user domain.User) error {
+	return c.repo.Reset(ctx, domain.UsernameLoginAttemptSubject(user.Username))
+}
+
+func (c LoginThrottleUseCase) subjects(username, ip string) []domain.LoginAttemptSubject {
+	subjects := []domain.LoginAttemptSubject{domain.UsernameLoginAttemptSubject(username)}
+	if ip != "" {
+		subjects = append(subjects, domain.IPLoginAttemptSubject(ip))
+	}
+	return subjects
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/service"
+	"go-scaffold/internal/config"
+)
+
+var (
+	// ErrOIDCAuthorizationInvalid the state is unknown, expired, used, issued for another provider,
+	// or not bound to the browser that finishes the login
+	ErrOIDCAuthorizationInvalid = errors.New("invalid OIDC authorization")
+
+	// ErrOIDCLoginFailed the authorization code can not be exchanged, or the ID token is invalid
+	ErrOIDCLoginFailed = errors.New("OIDC login failed")
+)
+
+const (
+	// the lengths of the users table columns
+	maxUsernameLength = 32
+	maxNicknameLength = 64
+)
+
+var _ OIDCUseCaseInterface = (*OIDCUseCase)(nil)
+
+type OIDCUseCaseInterface interface {
+	// Authorize start the login, returns the URL of the provider that the user signs in at,
+	// and the authorization whose binding is kept by the browser until the callback
+	Authorize(ctx context.Context, provider string) (string, *domain.OIDCAuthorization, error)
+	// Callback finish the login with the authorization code,
+	// the user is provisioned on the first login, and the roles of the mappings are synchronized with the role claim
+	Callback(ctx context.Context, provider, state, binding, code string) (*domain.User, error)
+}
+
+type OIDCUseCase struct {
+	providers    *service.OIDCProviders
+	authRepo     repository.OIDCAuthorizationRepositoryInterface
+	identityRepo repository.UserIdentityRepositoryInterface
+	userRepo     repository.UserRepositoryInterface
+}
+
+func NewOIDCUseCase(
+	providers *service.OIDCProviders,
+	authRepo repository.OIDCAuthorizationRepositoryInterface,
+	identityRepo repository.UserIdentityRepositoryInterface,
+	userRepo repository.UserRepositoryInterface,
+) *OIDCUseCase {
+	return &OIDCUseCase{
+		providers:    providers,
+		authRepo:     authRepo,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (c OIDCUseCase) Authorize(ctx context.Context, provider string) (string, *domain.OIDCAuthorization, error) {
+	client, err := c.providers.Get(provider)
+	if err != nil {
+		return "", nil, err
+	}
+
+	auth, err := domain.NewOIDCAuthorization(provider)
+	if err != nil {
+		return "", nil, err
+	}
+
+	u, err := client.AuthCodeURL(ctx, *auth)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := c.authRepo.Create(ctx, *auth); err != nil {
+		return "", nil, err
+	}
+
+	return u, auth, nil
+}
+
+func (c OIDCUseCase) Callback(ctx context.Context, provider, state, binding, code string) (*domain.User, error) {
+	client, err := c.providers.Get(provider)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := c.authRepo.Consume(ctx, state)
+	if repository.IsNotFound(err) {
+		return nil, errors.WithStack(ErrOIDCAuthorizationInvalid)
+	} else if err != nil {
+		return nil, err
+	}
+	if auth.Provider != provider || !auth.Bound(binding) {
+		return nil, errors.WithStack(ErrOIDCAuthorizationInvalid)
+	}
+
+	identity, err := client.Exchange(ctx, *auth, code)
+	if err != nil {
+		return nil, errors.Wrap(ErrOIDCLoginFailed, err.Error())
+	}
+
+	user, err := c.findOrProvision(ctx, *identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if roles, ok := mapOIDCRoles(client.Config(), identity.Groups); ok {
+		managed := managedOIDCRoles(client.Config())
+		if err := c.userRepo.SyncRoles(ctx, user.TenantID, user.ID, managed, roles); err != nil {
+			return nil, err
+		}
+	}
+
+	return user, nil
+}
+
+// findOrProvision returns the user linked to the identity, the user is created on the first login
+func (c OIDCUseCase) findOrProvision(ctx context.Context, identity domain.OIDCIdentity) (*domain.User, error) {
+	linked, err := c.identityRepo.FindOneBySubject(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		return c.userRepo.FindOne(ctx, linked.UserID)
+	} else if !repository.IsNotFound(err) {
+		return nil, err
+	}
+
+	username, err := c.availableUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	user := domain.User{
+		TenantID: domain.DefaultTenantID,
+		Username: username,
+		Nickname: truncate(lo.Ternary(identity.Name != "", identity.Name, username), maxNicknameLength),
+	}
+
+	// the existing account is never linked by the email, which would let the provider take it over
+	if identity.Email != "" {
+		exist, err := c.userRepo.EmailExistExcludeID(ctx, identity.Email, 0)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			user.Email = identity.Email
+			if identity.EmailVerified {
+				user.EmailVerifiedAt = time.Now().Unix()
+			}
+		}
+	}
+
+	created, err := c.userRepo.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.identityRepo.Create(ctx, domain.UserIdentity{
+		UserID:   created.ID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	})
+	if err != nil {
+		// the identity may have been linked by the concurrent login
+		_ = c.userRepo.Delete(ctx, *created)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// availableUsername the username claim is preferred, a random suffix is appended if it is taken
+func (c OIDCUseCase) availableUsername(ctx context.Context, identity domain.OIDCIdentity) (string, error) {
+	base := identity.Username
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	if base == "" {
+		base = identity.Provider
+	}
+	base = truncate(base, maxUsernameLength-5)
+
+	username := base
+	for i := 0; i < 5; i++ {
+		exist, err := c.userRepo.UsernameExist(ctx, username)
+		if err != nil {
+			return "", err
+		}
+		if !exist {
+			return username, nil
+		}
+
+		suffix := make([]byte, 2)
+		if _, err := rand.Read(suffix); err != nil {
+			return "", errors.WithStack(err)
+		}
+		username = base + "_" + hex.EncodeToString(suffix)
+	}
+
+	return "", errors.Errorf("no available username for %q", base)
+}
+
+// mapOIDCRoles returns the roles mapped from the groups, false if the roles are not synchronized
+func mapOIDCRoles(conf config.OIDCProvider, groups []string) ([]int64, bool) {
+	if conf.RoleClaim == "" {
+		return nil, false
+	}
+
+	roles := make([]int64, 0, len(conf.RoleMappings))
+	for _, m := range conf.RoleMappings {
+		if lo.Contains(groups, m.Value) {
+			roles = append(roles, m.Roles...)
+		}
+	}
+
+	return lo.Uniq(roles), true
+}
+
+// managedOIDCRoles returns the roles of all the mappings, which are synchronized with the role claim
+func managedOIDCRoles(conf config.OIDCProvider) []int64 {
+	roles := make([]int64, 0, len(conf.RoleMappings))
+	for _, m := range conf.RoleMappings {
+		roles = append(roles, m.Roles...)
+	}
+	return lo.Uniq(roles)
+}
+
+// truncate cut the string to at most n characters
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
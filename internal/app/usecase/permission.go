@@ -2,11 +2,17 @@ package usecase
 
 import (
 	"context"
+	"sort"
+
+	"github.com/pkg/errors"
 
 	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
 )
 
+// ErrPermissionParentNotFound the parent permission does not exist
+var ErrPermissionParentNotFound = errors.New("parent permission not exist")
+
 var _ PermissionUseCaseInterface = (*PermissionUseCase)(nil)
 
 type PermissionUseCaseInterface interface {
@@ -14,7 +20,15 @@ type PermissionUseCaseInterface interface {
 	Update(ctx context.Context, product domain.Permission) error
 	Delete(ctx context.Context, product domain.Permission) error
 	Detail(ctx context.Context, id int64) (*domain.Permission, error)
-	List(ctx context.Context, param PermissionListParam) ([]*domain.Permission, error)
+	List(ctx context.Context, param PermissionListParam) (*domain.Page[*domain.Permission], error)
+	// Tree returns the top level permissions with their descendants
+	Tree(ctx context.Context) ([]*domain.PermissionNode, error)
+	// Move move the permission along with its descendants under the parent, 0 moves it to the top level
+	Move(ctx context.Context, permission domain.Permission, parentID int64) error
+	// Sync create the declared permissions that do not exist, the parents are created before their children,
+	// and update the names and descriptions of the existing ones, nothing is written if dryRun is true,
+	// the existing permissions are kept under their parents, the ones placed under other parents are reported
+	Sync(ctx context.Context, definitions []domain.PermissionDefinition, dryRun bool) (*domain.PermissionSyncResult, error)
 }
 
 type PermissionUseCase struct {
@@ -30,10 +44,16 @@ func NewPermissionUseCase(
 }
 
 func (c *PermissionUseCase) Create(ctx context.Context, product domain.Permission) error {
+	if err := c.validateParent(ctx, 0, product.ParentID); err != nil {
+		return err
+	}
 	return c.repo.Create(ctx, product)
 }
 
 func (c *PermissionUseCase) Update(ctx context.Context, product domain.Permission) error {
+	if err := c.validateParent(ctx, product.ID, product.ParentID); err != nil {
+		return err
+	}
 	return c.repo.Update(ctx, product)
 }
 
@@ -47,10 +67,183 @@ func (c *PermissionUseCase) Detail(ctx context.Context, id int64) (*domain.Permi
 
 type PermissionListParam struct {
 	Keyword string
+	Page    domain.Pagination
 }
 
-func (c *PermissionUseCase) List(ctx context.Context, param PermissionListParam) ([]*domain.Permission, error) {
-	return c.repo.Filter(ctx, repository.PermissionFindListParam{
+func (c *PermissionUseCase) List(ctx context.Context, param PermissionListParam) (*domain.Page[*domain.Permission], error) {
+	return c.repo.Paginate(ctx, repository.PermissionFindListParam{
 		Keyword: param.Keyword,
+	}, param.Page)
+}
+
+func (c *PermissionUseCase) Tree(ctx context.Context) ([]*domain.PermissionNode, error) {
+	tree, err := c.tree(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tree.Roots(), nil
+}
+
+func (c *PermissionUseCase) Move(ctx context.Context, permission domain.Permission, parentID int64) error {
+	permission.ParentID = parentID
+	return c.Update(ctx, permission)
+}
+
+func (c *PermissionUseCase) Sync(ctx context.Context, definitions []domain.PermissionDefinition, dryRun bool) (*domain.PermissionSyncResult, error) {
+	list, err := c.repo.Filter(ctx, repository.PermissionFindListParam{})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
 	})
+
+	existing := make(map[string]*domain.Permission, len(list))
+	parents := make(map[int64]struct{}, len(list))
+	for _, p := range list {
+		existing[p.Key] = p
+		parents[p.ParentID] = struct{}{}
+	}
+
+	declared := make(map[string]struct{}, len(definitions))
+	pending := make([]domain.PermissionDefinition, 0)
+	redeclared := make([]domain.PermissionDefinition, 0, len(definitions))
+	for _, d := range definitions {
+		if _, ok := declared[d.Key]; ok {
+			continue
+		}
+		declared[d.Key] = struct{}{}
+
+		if _, ok := existing[d.Key]; ok {
+			redeclared = append(redeclared, d)
+		} else {
+			pending = append(pending, d)
+		}
+	}
+
+	result := &domain.PermissionSyncResult{
+		Created:   make([]*domain.Permission, 0, len(pending)),
+		Updated:   make([]*domain.Permission, 0),
+		Misplaced: make([]*domain.MisplacedPermission, 0),
+		Stale:     make([]*domain.Permission, 0),
+	}
+
+	for len(pending) > 0 {
+		next := make([]domain.PermissionDefinition, 0, len(pending))
+
+		for _, d := range pending {
+			parent, ok := existing[d.Parent]
+			if d.Parent != "" && !ok {
+				// the parent is declared after the child, or is missing
+				next = append(next, d)
+				continue
+			}
+
+			p := &domain.Permission{
+				Key:  d.Key,
+				Name: d.Name,
+				Desc: d.Desc,
+			}
+			if p.Name == "" {
+				p.Name = d.Key
+			}
+			if parent != nil {
+				p.ParentID = parent.ID
+			}
+
+			if !dryRun {
+				if err := c.repo.Create(ctx, *p); err != nil {
+					return nil, err
+				}
+				if p, err = c.repo.FindOneByKey(ctx, d.Key); err != nil {
+					return nil, err
+				}
+			}
+
+			existing[d.Key] = p
+			result.Created = append(result.Created, p)
+		}
+
+		if len(next) == len(pending) {
+			return nil, errors.Wrap(ErrPermissionParentNotFound, next[0].Parent)
+		}
+		pending = next
+	}
+
+	keys := make(map[int64]string, len(list))
+	for _, p := range list {
+		keys[p.ID] = p.Key
+	}
+
+	// the parents of the existing permissions are kept, since they may have been moved
+	for _, d := range redeclared {
+		p := existing[d.Key]
+
+		if keys[p.ParentID] != d.Parent {
+			result.Misplaced = append(result.Misplaced, &domain.MisplacedPermission{Permission: p, Parent: d.Parent})
+		}
+
+		updated := *p
+		if d.Name != "" {
+			updated.Name = d.Name
+		}
+		if d.Desc != "" {
+			updated.Desc = d.Desc
+		}
+
+		if updated == *p {
+			continue
+		}
+
+		if !dryRun {
+			if err := c.Update(ctx, updated); err != nil {
+				return nil, err
+			}
+		}
+
+		result.Updated = append(result.Updated, &updated)
+	}
+
+	for _, p := range list {
+		_, isDeclared := declared[p.Key]
+		_, isParent := parents[p.ID]
+		if !isDeclared && !isParent {
+			result.Stale = append(result.Stale, p)
+		}
+	}
+
+	return result, nil
+}
+
+// validateParent the parent must exist, and must not be the permission itself or one of its descendants
+func (c *PermissionUseCase) validateParent(ctx context.Context, id, parentID int64) error {
+	if parentID == 0 {
+		return nil
+	}
+
+	tree, err := c.tree(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !tree.Exist(parentID) {
+		return errors.WithStack(ErrPermissionParentNotFound)
+	}
+
+	return tree.ValidateParent(id, parentID)
+}
+
+// tree build the tree of all the permissions, the siblings are ordered by the id
+func (c *PermissionUseCase) tree(ctx context.Context) (*domain.PermissionTree, error) {
+	list, err := c.repo.Filter(ctx, repository.PermissionFindListParam{})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return domain.NewPermissionTree(list), nil
 }
@@ -14,7 +14,7 @@ type ProductUseCaseInterface interface {
 	Update(ctx context.Context, product domain.Product) error
 	Delete(ctx context.Context, product domain.Product) error
 	Detail(ctx context.Context, id int64) (*domain.Product, error)
-	List(ctx context.Context, param ProductListParam) ([]*domain.Product, error)
+	List(ctx context.Context, param ProductListParam) (*domain.Page[*domain.Product], error)
 }
 
 type ProductUseCase struct {
@@ -29,7 +29,11 @@ func NewProductUseCase(
 	}
 }
 
+// Create the product is owned by the user that creates it
 func (c *ProductUseCase) Create(ctx context.Context, product domain.Product) error {
+	if filter, ok := domain.DataFilterFromContext(ctx); ok {
+		product.OwnerID, product.DepartmentID = filter.UserID, filter.DepartmentID
+	}
 	return c.repo.Create(ctx, product)
 }
 
@@ -47,10 +51,11 @@ func (c *ProductUseCase) Detail(ctx context.Context, id int64) (*domain.Product,
 
 type ProductListParam struct {
 	Keyword string
+	Page    domain.Pagination
 }
 
-func (c *ProductUseCase) List(ctx context.Context, param ProductListParam) ([]*domain.Product, error) {
-	return c.repo.Filter(ctx, repository.ProductFindListParam{
+func (c *ProductUseCase) List(ctx context.Context, param ProductListParam) (*domain.Page[*domain.Product], error) {
+	return c.repo.Paginate(ctx, repository.ProductFindListParam{
 		Keyword: param.Keyword,
-	})
+	}, param.Page)
 }
@@ -0,0 +1,421 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+)
+
+var _ RBACUseCaseInterface = (*RBACUseCase)(nil)
+
+type RBACUseCaseInterface interface {
+	// Export returns the roles of the tenant with their grants and parents, and the catalogue of the permissions
+	Export(ctx context.Context, tenant int64) (*domain.RBACSnapshot, error)
+	// Import create the roles of the snapshot within the tenant, and replace the data scopes, grants and parents of them,
+	// the permissions of the snapshot must have been created unless dryRun is true, the other roles are kept,
+	// the changes are made in one transaction only if the context runs in a unit of work
+	Import(ctx context.Context, tenant int64, snapshot domain.RBACSnapshot, dryRun bool) (*domain.RBACImportResult, error)
+}
+
+type RBACUseCase struct {
+	roleRepo       repository.RoleRepositoryInterface
+	permissionRepo repository.PermissionRepositoryInterface
+}
+
+func NewRBACUseCase(
+	roleRepo repository.RoleRepositoryInterface,
+	permissionRepo repository.PermissionRepositoryInterface,
+) *RBACUseCase {
+	return &RBACUseCase{
+		roleRepo:       roleRepo,
+		permissionRepo: permissionRepo,
+	}
+}
+
+func (c *RBACUseCase) Export(ctx context.Context, tenant int64) (*domain.RBACSnapshot, error) {
+	permissions, err := c.permissionRepo.Filter(ctx, repository.PermissionFindListParam{})
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[int64]string, len(permissions))
+	for _, p := range permissions {
+		keys[p.ID] = p.Key
+	}
+
+	roles, err := c.roleRepo.Filter(ctx, repository.RoleFindListParam{TenantID: tenant})
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string, len(roles))
+	for _, r := range roles {
+		names[r.ID] = r.Name
+	}
+
+	hierarchy, err := c.roleRepo.GetHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &domain.RBACSnapshot{
+		Permissions: make([]domain.PermissionDefinition, 0, len(permissions)),
+		Roles:       make([]domain.RBACRole, 0, len(roles)),
+	}
+
+	for _, p := range permissions {
+		snapshot.Permissions = append(snapshot.Permissions, domain.PermissionDefinition{
+			Key:    p.Key,
+			Name:   p.Name,
+			Desc:   p.Desc,
+			Parent: keys[p.ParentID],
+		})
+	}
+	sort.Slice(snapshot.Permissions, func(i, j int) bool {
+		return snapshot.Permissions[i].Key < snapshot.Permissions[j].Key
+	})
+
+	for _, r := range roles {
+		grants, err := c.grants(ctx, r.ID, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		parents := lo.FilterMap(hierarchy[r.ID], func(p int64, index int) (string, bool) {
+			name, ok := names[p]
+			return name, ok
+		})
+		sort.Strings(parents)
+
+		snapshot.Roles = append(snapshot.Roles, domain.RBACRole{
+			Name:                 r.Name,
+			DataScope:            r.DataScope,
+			DataScopeDepartments: r.DataScopeDepartments,
+			Parents:              parents,
+			Grants:               grants,
+		})
+	}
+	sort.Slice(snapshot.Roles, func(i, j int) bool {
+		return snapshot.Roles[i].Name < snapshot.Roles[j].Name
+	})
+
+	return snapshot, nil
+}
+
+// grants returns the permissions granted to the role directly, sorted by the permission key
+func (c *RBACUseCase) grants(ctx context.Context, role int64, keys map[int64]string) ([]domain.RBACGrant, error) {
+	permissions, err := c.roleRepo.GetPermissions(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, err := c.roleRepo.GetConditions(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	grants := lo.FilterMap(permissions, func(p *domain.Permission, index int) (domain.RBACGrant, bool) {
+		key, ok := keys[p.ID]
+		return domain.RBACGrant{Permission: key, Condition: conditions[p.ID]}, ok
+	})
+	sort.Slice(grants, func(i, j int) bool {
+		return grants[i].Permission < grants[j].Permission
+	})
+
+	return grants, nil
+}
+
+// rbacPlan the changes of the role of the snapshot
+type rbacPlan struct {
+	role           domain.RBACRole
+	current        *domain.Role // nil if the role will be created
+	scopeChanged   bool
+	grantsChanged  bool
+	parentsChanged bool
+}
+
+func (c *RBACUseCase) Import(ctx context.Context, tenant int64, snapshot domain.RBACSnapshot, dryRun bool) (*domain.RBACImportResult, error) {
+	permissions, err := c.permissionRepo.Filter(ctx, repository.PermissionFindListParam{})
+	if err != nil {
+		return nil, err
+	}
+	permissionIDs := make(map[string]int64, len(permissions))
+	keys := make(map[int64]string, len(permissions))
+	for _, p := range permissions {
+		permissionIDs[p.Key] = p.ID
+		keys[p.ID] = p.Key
+	}
+
+	roles, err := c.roleRepo.Filter(ctx, repository.RoleFindListParam{TenantID: tenant})
+	if err != nil {
+		return nil, err
+	}
+	existing := lo.KeyBy(roles, func(r *domain.Role) string {
+		return r.Name
+	})
+
+	hierarchy, err := c.roleRepo.GetHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the roles that will be created are given the temporary ids,
+	// so that the hierarchy is validated before any change is made
+	roleIDs := make(map[string]int64, len(roles)+len(snapshot.Roles))
+	roleNames := make(map[int64]string, len(roles)+len(snapshot.Roles))
+	for _, r := range roles {
+		roleIDs[r.Name], roleNames[r.ID] = r.ID, r.Name
+	}
+	for i, r := range snapshot.Roles {
+		if _, ok := roleIDs[r.Name]; !ok {
+			roleIDs[r.Name], roleNames[int64(-i-1)] = int64(-i-1), r.Name
+		}
+	}
+
+	// the permissions that will be created by the import are only missing in the dry run
+	declared := lo.SliceToMap(snapshot.Permissions, func(d domain.PermissionDefinition) (string, struct{}) {
+		return d.Key, struct{}{}
+	})
+
+	desired := make(domain.RoleHierarchy, len(hierarchy)+len(snapshot.Roles))
+	for r, ps := range hierarchy {
+		desired[r] = ps
+	}
+	for _, r := range snapshot.Roles {
+		for _, g := range r.Grants {
+			_, ok := permissionIDs[g.Permission]
+			_, isDeclared := declared[g.Permission]
+			if !ok && !(dryRun && isDeclared) {
+				return nil, errors.Wrap(domain.ErrRBACPermissionNotFound, g.Permission)
+			}
+		}
+
+		parents := make([]int64, 0, len(r.Parents))
+		for _, name := range r.Parents {
+			id, ok := roleIDs[name]
+			if !ok {
+				return nil, errors.Wrap(domain.ErrRBACRoleNotFound, name)
+			}
+			parents = append(parents, id)
+		}
+		desired[roleIDs[r.Name]] = parents
+	}
+	for _, r := range snapshot.Roles {
+		if err := desired.ValidateParents(roleIDs[r.Name], desired[roleIDs[r.Name]]); err != nil {
+			return nil, errors.Wrap(err, r.Name)
+		}
+	}
+
+	result := &domain.RBACImportResult{
+		Changes: make([]domain.RBACChange, 0),
+		Kept:    make([]string, 0),
+	}
+
+	plans := make([]*rbacPlan, 0, len(snapshot.Roles))
+	for _, r := range snapshot.Roles {
+		plan := &rbacPlan{role: r, current: existing[r.Name]}
+		plans = append(plans, plan)
+
+		current := make(map[string]string)
+		currentParents := make([]string, 0)
+		if plan.current == nil {
+			result.Changes = append(result.Changes, domain.RBACChange{
+				Action: domain.RBACActionCreateRole,
+				Role:   r.Name,
+				Detail: string(r.DataScope),
+			})
+		} else {
+			if plan.current.DataScope != r.DataScope ||
+				!slices.Equal(sortedIDs(plan.current.DataScopeDepartments), sortedIDs(r.DataScopeDepartments)) {
+				plan.scopeChanged = true
+				result.Changes = append(result.Changes, domain.RBACChange{
+					Action: domain.RBACActionUpdateRole,
+					Role:   r.Name,
+					Detail: string(r.DataScope),
+				})
+			}
+
+			grants, err := c.grants(ctx, plan.current.ID, keys)
+			if err != nil {
+				return nil, err
+			}
+			for _, g := range grants {
+				current[g.Permission] = g.Condition
+			}
+
+			currentParents = lo.FilterMap(hierarchy[plan.current.ID], func(p int64, index int) (string, bool) {
+				name, ok := roleNames[p]
+				return name, ok
+			})
+		}
+
+		changes := diffGrants(r.Name, current, r.Grants)
+		plan.grantsChanged = len(changes) > 0
+		result.Changes = append(result.Changes, changes...)
+
+		changes = diffParents(r.Name, currentParents, r.Parents)
+		plan.parentsChanged = len(changes) > 0
+		result.Changes = append(result.Changes, changes...)
+	}
+
+	for _, r := range roles {
+		if !lo.ContainsBy(snapshot.Roles, func(item domain.RBACRole) bool { return item.Name == r.Name }) {
+			result.Kept = append(result.Kept, r.Name)
+		}
+	}
+	sort.Strings(result.Kept)
+
+	if dryRun {
+		return result, nil
+	}
+
+	if err := c.apply(ctx, tenant, plans, permissionIDs, roleIDs); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// apply make the changes of the plans, the roles are created first so that they can be the parents of each other
+func (c *RBACUseCase) apply(ctx context.Context, tenant int64, plans []*rbacPlan, permissionIDs, roleIDs map[string]int64) error {
+	created := false
+	for _, plan := range plans {
+		if plan.current != nil {
+			continue
+		}
+		created = true
+
+		err := c.roleRepo.Create(ctx, domain.Role{
+			TenantID:             tenant,
+			Name:                 plan.role.Name,
+			DataScope:            plan.role.DataScope,
+			DataScopeDepartments: plan.role.DataScopeDepartments,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if created {
+		roles, err := c.roleRepo.Filter(ctx, repository.RoleFindListParam{TenantID: tenant})
+		if err != nil {
+			return err
+		}
+		for _, r := range roles {
+			roleIDs[r.Name] = r.ID
+		}
+	}
+
+	for _, plan := range plans {
+		id := roleIDs[plan.role.Name]
+
+		if plan.scopeChanged {
+			err := c.roleRepo.Update(ctx, domain.Role{
+				ID:                   id,
+				TenantID:             tenant,
+				Name:                 plan.role.Name,
+				DataScope:            plan.role.DataScope,
+				DataScopeDepartments: plan.role.DataScopeDepartments,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if plan.grantsChanged {
+			ps := make([]int64, 0, len(plan.role.Grants))
+			conditions := make(map[int64]string)
+			for _, g := range plan.role.Grants {
+				ps = append(ps, permissionIDs[g.Permission])
+				if g.Condition != "" {
+					conditions[permissionIDs[g.Permission]] = g.Condition
+				}
+			}
+
+			if err := c.roleRepo.GrantPermissions(ctx, id, ps, conditions); err != nil {
+				return err
+			}
+		}
+
+		if plan.parentsChanged {
+			parents := lo.Map(plan.role.Parents, func(name string, index int) int64 {
+				return roleIDs[name]
+			})
+
+			if err := c.roleRepo.SetParents(ctx, id, parents); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// diffGrants the changes from the current grants keyed by the permission key to the grants of the snapshot
+func diffGrants(role string, current map[string]string, grants []domain.RBACGrant) []domain.RBACChange {
+	changes := make([]domain.RBACChange, 0)
+
+	desired := make(map[string]string, len(grants))
+	for _, g := range grants {
+		desired[g.Permission] = g.Condition
+
+		condition, ok := current[g.Permission]
+		if !ok {
+			changes = append(changes, domain.RBACChange{
+				Action: domain.RBACActionGrant,
+				Role:   role,
+				Target: g.Permission,
+				Detail: g.Condition,
+			})
+		} else if condition != g.Condition {
+			changes = append(changes, domain.RBACChange{
+				Action: domain.RBACActionCondition,
+				Role:   role,
+				Target: g.Permission,
+				Detail: g.Condition,
+			})
+		}
+	}
+
+	revoked := lo.Filter(lo.Keys(current), func(key string, index int) bool {
+		_, ok := desired[key]
+		return !ok
+	})
+	sort.Strings(revoked)
+	for _, key := range revoked {
+		changes = append(changes, domain.RBACChange{
+			Action: domain.RBACActionRevoke,
+			Role:   role,
+			Target: key,
+		})
+	}
+
+	return changes
+}
+
+// diffParents the changes from the current parents to the parents of the snapshot
+func diffParents(role string, current, parents []string) []domain.RBACChange {
+	added, removed := lo.Difference(parents, current)
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	changes := make([]domain.RBACChange, 0, len(added)+len(removed))
+	for _, name := range added {
+		changes = append(changes, domain.RBACChange{Action: domain.RBACActionAddParent, Role: role, Target: name})
+	}
+	for _, name := range removed {
+		changes = append(changes, domain.RBACChange{Action: domain.RBACActionRemoveParent, Role: role, Target: name})
+	}
+
+	return changes
+}
+
+func sortedIDs(ids []int64) []int64 {
+	ids = slices.Clone(ids)
+	slices.Sort(ids)
+	return ids
+}
@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 
+	"github.com/samber/lo"
+
 	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
 )
@@ -14,20 +16,29 @@ type RoleUseCaseInterface interface {
 	Update(ctx context.Context, product domain.Role) error
 	Delete(ctx context.Context, product domain.Role) error
 	Detail(ctx context.Context, id int64) (*domain.Role, error)
-	List(ctx context.Context, param RoleListParam) ([]*domain.Role, error)
-	GrantPermissions(ctx context.Context, role int64, permissions []int64) error
-	GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error)
+	List(ctx context.Context, param RoleListParam) (*domain.Page[*domain.Role], error)
+	// GrantPermissions replace the permissions of the role and their conditions keyed by the permission id,
+	// the descendants of the permissions are granted as well if cascade is true, under the conditions of their ancestors
+	GrantPermissions(ctx context.Context, role int64, permissions []int64, conditions map[int64]string, cascade bool) error
+	// GetPermissions returns the effective permissions of the role, including the ones inherited from its ancestors
+	GetPermissions(ctx context.Context, id int64) ([]*domain.RolePermission, error)
+	// SetParents replace the parent roles that the role inherits
+	SetParents(ctx context.Context, role int64, parents []int64) error
+	GetParents(ctx context.Context, role int64) ([]*domain.Role, error)
 }
 
 type RoleUseCase struct {
-	repo repository.RoleRepositoryInterface
+	repo           repository.RoleRepositoryInterface
+	permissionRepo repository.PermissionRepositoryInterface
 }
 
 func NewRoleUseCase(
 	repo repository.RoleRepositoryInterface,
+	permissionRepo repository.PermissionRepositoryInterface,
 ) *RoleUseCase {
 	return &RoleUseCase{
-		repo: repo,
+		repo:           repo,
+		permissionRepo: permissionRepo,
 	}
 }
 
@@ -48,19 +59,102 @@ func (c *RoleUseCase) Detail(ctx context.Context, id int64) (*domain.Role, error
 }
 
 type RoleListParam struct {
-	Keyword string
+	TenantID int64
+	Keyword  string
+	Page     domain.Pagination
 }
 
-func (c *RoleUseCase) List(ctx context.Context, param RoleListParam) ([]*domain.Role, error) {
-	return c.repo.Filter(ctx, repository.RoleFindListParam{
-		Keyword: param.Keyword,
-	})
+func (c *RoleUseCase) List(ctx context.Context, param RoleListParam) (*domain.Page[*domain.Role], error) {
+	return c.repo.Paginate(ctx, repository.RoleFindListParam{
+		TenantID: param.TenantID,
+		Keyword:  param.Keyword,
+	}, param.Page)
 }
 
-func (c *RoleUseCase) GrantPermissions(ctx context.Context, role int64, permissions []int64) error {
-	return c.repo.GrantPermissions(ctx, role, permissions)
+func (c *RoleUseCase) GrantPermissions(ctx context.Context, role int64, permissions []int64, conditions map[int64]string, cascade bool) error {
+	if cascade {
+		list, err := c.permissionRepo.Filter(ctx, repository.PermissionFindListParam{})
+		if err != nil {
+			return err
+		}
+
+		tree := domain.NewPermissionTree(list)
+		ids := append([]int64(nil), permissions...)
+		inherited := make(map[int64]string)
+		for _, id := range permissions {
+			descendants := tree.Descendants(id)
+			ids = append(ids, descendants...)
+
+			// the condition of the permission that is granted explicitly takes precedence
+			for _, d := range descendants {
+				if _, ok := conditions[d]; !ok && conditions[id] != "" {
+					inherited[d] = conditions[id]
+				}
+			}
+		}
+		permissions = lo.Uniq(ids)
+		conditions = lo.Assign(inherited, conditions)
+	}
+
+	return c.repo.GrantPermissions(ctx, role, permissions, conditions)
 }
 
-func (c *RoleUseCase) GetPermissions(ctx context.Context, id int64) ([]*domain.Permission, error) {
-	return c.repo.GetPermissions(ctx, id)
+func (c *RoleUseCase) GetPermissions(ctx context.Context, id int64) ([]*domain.RolePermission, error) {
+	hierarchy, err := c.repo.GetHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nearest role wins when the permission is granted more than once
+	seen := make(map[int64]struct{})
+	list := make([]*domain.RolePermission, 0)
+	for _, role := range append([]int64{id}, hierarchy.Ancestors(id)...) {
+		ps, err := c.repo.GetPermissions(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+
+		conditions, err := c.repo.GetConditions(ctx, role)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range ps {
+			if _, ok := seen[p.ID]; ok {
+				continue
+			}
+			seen[p.ID] = struct{}{}
+			list = append(list, &domain.RolePermission{Permission: p, OriginRoleID: role, Condition: conditions[p.ID]})
+		}
+	}
+
+	return list, nil
+}
+
+func (c *RoleUseCase) SetParents(ctx context.Context, role int64, parents []int64) error {
+	hierarchy, err := c.repo.GetHierarchy(ctx)
+	if err != nil {
+		return err
+	}
+
+	parents = lo.Uniq(parents)
+	if err := hierarchy.ValidateParents(role, parents); err != nil {
+		return err
+	}
+
+	return c.repo.SetParents(ctx, role, parents)
+}
+
+func (c *RoleUseCase) GetParents(ctx context.Context, role int64) ([]*domain.Role, error) {
+	hierarchy, err := c.repo.GetHierarchy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parents := hierarchy[role]
+	if len(parents) == 0 {
+		return []*domain.Role{}, nil
+	}
+
+	return c.repo.FindList(ctx, parents)
 }
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/config"
+)
+
+var (
+	ErrTenantNotFound     = errors.New("tenant not exist")
+	ErrTenantAccessDenied = errors.New("the user is not a member of the tenant")
+)
+
+var _ TenantUseCaseInterface = (*TenantUseCase)(nil)
+
+type TenantUseCaseInterface interface {
+	// Resolve ensure the user can act in the tenant,
+	// the user is a member of the tenant that it belongs to, or that it has any role within,
+	// the super admin users can act in all the tenants
+	Resolve(ctx context.Context, user domain.UserProfile, tenant int64) error
+}
+
+type TenantUseCase struct {
+	repo       repository.TenantRepositoryInterface
+	userRepo   repository.UserRepositoryInterface
+	casbinConf config.Casbin
+}
+
+func NewTenantUseCase(
+	repo repository.TenantRepositoryInterface,
+	userRepo repository.UserRepositoryInterface,
+	casbinConf config.Casbin,
+) *TenantUseCase {
+	return &TenantUseCase{
+		repo:       repo,
+		userRepo:   userRepo,
+		casbinConf: casbinConf,
+	}
+}
+
+func (c *TenantUseCase) Resolve(ctx context.Context, user domain.UserProfile, tenant int64) error {
+	exist, err := c.repo.Exist(ctx, tenant)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.WithStack(ErrTenantNotFound)
+	}
+
+	if lo.Contains(c.casbinConf.SuperAdmin.Users, user.ID) {
+		return nil
+	}
+
+	u, err := c.userRepo.FindOne(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if u.TenantID == tenant {
+		return nil
+	}
+
+	roles, err := c.userRepo.GetRoles(ctx, tenant, user.ID)
+	if err != nil {
+		return err
+	}
+	if len(roles) == 0 {
+		return errors.WithStack(ErrTenantAccessDenied)
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+
+	"go-scaffold/internal/app/domain"
+	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/config"
+)
+
+var (
+	// ErrTOTPInvalidCode the TOTP code or recovery code is incorrect
+	ErrTOTPInvalidCode = errors.New("invalid TOTP code")
+
+	// ErrTOTPAlreadyEnabled the user has enabled TOTP
+	ErrTOTPAlreadyEnabled = errors.New("TOTP is already enabled")
+
+	// ErrTOTPNotEnabled the user has not enabled TOTP
+	ErrTOTPNotEnabled = errors.New("TOTP is not enabled")
+
+	// ErrTOTPNotEnrolled the user activates TOTP before enrolling
+	ErrTOTPNotEnrolled = errors.New("TOTP is not enrolled")
+
+	// ErrTOTPRequired the user is required to use TOTP by the roles
+	ErrTOTPRequired = errors.New("TOTP is required by the roles")
+
+	// ErrLoginChallengeAttemptsExceeded the challenge is revoked after too many failed attempts
+	ErrLoginChallengeAttemptsExceeded = errors.New("too many login challenge attempts")
+)
+
+var _ TwoFactorUseCaseInterface = (*TwoFactorUseCase)(nil)
+
+type TwoFactorUseCaseInterface interface {
+	// Challenge returns a login challenge if the second factor is required for the user, otherwise nil
+	Challenge(ctx context.Context, user domain.User, client domain.SessionClient) (*domain.LoginChallenge, error)
+	// FindChallenge returns the pending login challenge
+	FindChallenge(ctx context.Context, challengeToken string) (*domain.LoginChallenge, error)
+	// EnrollChallenge enroll TOTP for the user of the challenge which requires enrollment
+	EnrollChallenge(ctx context.Context, challengeToken string) (*domain.TOTPEnrollment, error)
+	// VerifyChallenge verify the second factor of the challenge,
+	// the recovery codes are returned if TOTP is activated during the verification
+	VerifyChallenge(ctx context.Context, challengeToken, code, recoveryCode string) (*domain.User, *domain.LoginChallenge, []string, error)
+	Enroll(ctx context.Context, user domain.User) (*domain.TOTPEnrollment, error)
+	Activate(ctx context.Context, user domain.User, code string) ([]string, error)
+	Disable(ctx context.Context, user domain.User, code, recoveryCode string) error
+	RegenerateRecoveryCodes(ctx context.Context, user domain.User, code string) ([]string, error)
+}
+
+type TwoFactorUseCase struct {
+	appName       config.AppName
+	conf          config.App
+	repo          repository.UserRepositoryInterface
+	roleRepo      repository.RoleRepositoryInterface
+	challengeRepo repository.LoginChallengeRepositoryInterface
+}
+
+func NewTwoFactorUseCase(
+	appName config.AppName,
+	conf config.App,
+	repo repository.UserRepositoryInterface,
+	roleRepo repository.RoleRepositoryInterface,
+	challengeRepo repository.LoginChallengeRepositoryInterface,
+) *TwoFactorUseCase {
+	return &TwoFactorUseCase{
+		appName:       appName,
+		conf:          conf,
+		repo:          repo,
+		roleRepo:      roleRepo,
+		challengeRepo: challengeRepo,
+	}
+}
+
+func (c TwoFactorUseCase) Challenge(ctx context.Context, user domain.User, client domain.SessionClient) (*domain.LoginChallenge, error) {
+	enrollRequired := false
+
+	if !user.TOTPEnabled() {
+		required, err := c.requiredByRoles(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		if !required {
+			return nil, nil
+		}
+		enrollRequired = true
+	}
+
+	challenge, err := domain.NewLoginChallenge(user.ID, client, enrollRequired, domain.LoginChallengeExpireDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.challengeRepo.Create(ctx, *challenge); err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+func (c TwoFactorUseCase) FindChallenge(ctx context.Context, challengeToken string) (*domain.LoginChallenge, error) {
+	return c.challengeRepo.FindOne(ctx, challengeToken)
+}
+
+func (c TwoFactorUseCase) EnrollChallenge(ctx context.Context, challengeToken string) (*domain.TOTPEnrollment, error) {
+	challenge, err := c.challengeRepo.FindOne(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if !challenge.EnrollRequired {
+		return nil, errors.WithStack(ErrTOTPAlreadyEnabled)
+	}
+
+	user, err := c.repo.FindOne(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Enroll(ctx, *user)
+}
+
+func (c TwoFactorUseCase) VerifyChallenge(ctx context.Context, challengeToken, code, recoveryCode string) (*domain.User, *domain.LoginChallenge, []string, error) {
+	challenge, err := c.challengeRepo.FindOne(ctx, challengeToken)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	attempts, err := c.challengeRepo.IncrAttempts(ctx, challengeToken)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if attempts > domain.LoginChallengeMaxAttempts {
+		if err := c.challengeRepo.Delete(ctx, challengeToken); err != nil {
+			return nil, nil, nil, err
+		}
+		return nil, nil, nil, errors.WithStack(ErrLoginChallengeAttemptsExceeded)
+	}
+
+	user, err := c.repo.FindOne(ctx, challenge.UserID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var recoveryCodes []string
+	if challenge.EnrollRequired {
+		recoveryCodes, err = c.Activate(ctx, *user, code)
+	} else {
+		err = c.verify(ctx, user, code, recoveryCode)
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// the challenge can only be exchanged once
+	if err := c.challengeRepo.Delete(ctx, challengeToken); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return user, challenge, recoveryCodes, nil
+}
+
+// Enroll generate a pending TOTP secret, which takes effect after activation
+func (c TwoFactorUseCase) Enroll(ctx context.Context, user domain.User) (*domain.TOTPEnrollment, error) {
+	if user.TOTPEnabled() {
+		return nil, errors.WithStack(ErrTOTPAlreadyEnabled)
+	}
+
+	secret, err := domain.NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPSecret = secret
+	if err := c.repo.UpdateTOTP(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return &domain.TOTPEnrollment{
+		Secret: secret,
+		URI:    domain.TOTPURI(c.issuer(), user.Username, secret),
+	}, nil
+}
+
+// Activate enable TOTP once the code of the pending secret is verified
+func (c TwoFactorUseCase) Activate(ctx context.Context, user domain.User, code string) ([]string, error) {
+	if user.TOTPEnabled() {
+		return nil, errors.WithStack(ErrTOTPAlreadyEnabled)
+	}
+	if user.TOTPSecret == "" {
+		return nil, errors.WithStack(ErrTOTPNotEnrolled)
+	}
+
+	step, ok := domain.MatchTOTP(user.TOTPSecret, code, time.Now())
+	if !ok {
+		return nil, errors.WithStack(ErrTOTPInvalidCode)
+	}
+
+	codes, digests, err := domain.NewRecoveryCodes(domain.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPEnabledAt = time.Now().Unix()
+	user.TOTPLastStep = step
+	user.TOTPRecoveryCodes = digests
+	if err := c.repo.UpdateTOTP(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (c TwoFactorUseCase) Disable(ctx context.Context, user domain.User, code, recoveryCode string) error {
+	if !user.TOTPEnabled() {
+		return errors.WithStack(ErrTOTPNotEnabled)
+	}
+
+	required, err := c.requiredByRoles(ctx, user)
+	if err != nil {
+		return err
+	}
+	if required {
+		return errors.WithStack(ErrTOTPRequired)
+	}
+
+	if err := c.verify(ctx, &user, code, recoveryCode); err != nil {
+		return err
+	}
+
+	user.DisableTOTP()
+	return c.repo.UpdateTOTP(ctx, user)
+}
+
+// RegenerateRecoveryCodes replace all the recovery codes
+func (c TwoFactorUseCase) RegenerateRecoveryCodes(ctx context.Context, user domain.User, code string) ([]string, error) {
+	if !user.TOTPEnabled() {
+		return nil, errors.WithStack(ErrTOTPNotEnabled)
+	}
+
+	if code == "" {
+		return nil, errors.WithStack(ErrTOTPInvalidCode)
+	}
+	if err := c.verify(ctx, &user, code, ""); err != nil {
+		return nil, err
+	}
+
+	codes, digests, err := domain.NewRecoveryCodes(domain.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPRecoveryCodes = digests
+	if err := c.repo.UpdateTOTP(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// verify verify the TOTP code, or consume the recovery code if the code is not provided.
+// The code is accepted only once, the time step of it is recorded and kept in the user
+// so that the later UpdateTOTP does not roll it back
+func (c TwoFactorUseCase) verify(ctx context.Context, user *domain.User, code, recoveryCode string) error {
+	if code != "" {
+		step, ok := domain.MatchTOTP(user.TOTPSecret, code, time.Now())
+		if !ok {
+			return errors.WithStack(ErrTOTPInvalidCode)
+		}
+
+		ok, err := c.repo.UseTOTPStep(ctx, *user, step)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Wrap(ErrTOTPInvalidCode, "the code has been used")
+		}
+
+		user.TOTPLastStep = step
+		return nil
+	}
+
+	ok, err := c.repo.UseRecoveryCode(ctx, *user, recoveryCode)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.WithStack(ErrTOTPInvalidCode)
+	}
+	return nil
+}
+
+func (c TwoFactorUseCase) requiredByRoles(ctx context.Context, user domain.User) (bool, error) {
+	if len(c.conf.TwoFactor.RequiredRoles) == 0 {
+		return false, nil
+	}
+
+	// the roles of all the tenants, the second factor is required by any of them
+	roles, err := c.repo.GetRoles(ctx, 0, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// the roles inherited from the ancestors are in effect as well
+	hierarchy, err := c.roleRepo.GetHierarchy(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, r := range roles {
+		if lo.Contains(c.conf.TwoFactor.RequiredRoles, r.ID) ||
+			lo.Some(hierarchy.Ancestors(r.ID), c.conf.TwoFactor.RequiredRoles) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (c TwoFactorUseCase) issuer() string {
+	if c.conf.TwoFactor.Issuer != "" {
+		return c.conf.TwoFactor.Issuer
+	}
+	return c.appName.String()
+}
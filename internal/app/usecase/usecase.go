@@ -6,8 +6,18 @@ import (
 
 var ProviderSet = wire.NewSet(
 	wire.NewSet(wire.Bind(new(AccountUseCaseInterface), new(*AccountUseCase)), NewAccountUseCase),
+	wire.NewSet(wire.Bind(new(TwoFactorUseCaseInterface), new(*TwoFactorUseCase)), NewTwoFactorUseCase),
+	wire.NewSet(wire.Bind(new(LoginThrottleUseCaseInterface), new(*LoginThrottleUseCase)), NewLoginThrottleUseCase),
+	wire.NewSet(wire.Bind(new(AccountRecoveryUseCaseInterface), new(*AccountRecoveryUseCase)), NewAccountRecoveryUseCase),
+	wire.NewSet(wire.Bind(new(APIKeyUseCaseInterface), new(*APIKeyUseCase)), NewAPIKeyUseCase),
+	wire.NewSet(wire.Bind(new(OIDCUseCaseInterface), new(*OIDCUseCase)), NewOIDCUseCase),
+	wire.NewSet(wire.Bind(new(ImpersonationUseCaseInterface), new(*ImpersonationUseCase)), NewImpersonationUseCase),
+	wire.NewSet(wire.Bind(new(TenantUseCaseInterface), new(*TenantUseCase)), NewTenantUseCase),
+	wire.NewSet(wire.Bind(new(DataScopeUseCaseInterface), new(*DataScopeUseCase)), NewDataScopeUseCase),
+	wire.NewSet(wire.Bind(new(AuthzUseCaseInterface), new(*AuthzUseCase)), NewAuthzUseCase),
 	wire.NewSet(wire.Bind(new(UserUseCaseInterface), new(*UserUseCase)), NewUserUseCase),
 	wire.NewSet(wire.Bind(new(RoleUseCaseInterface), new(*RoleUseCase)), NewRoleUseCase),
 	wire.NewSet(wire.Bind(new(PermissionUseCaseInterface), new(*PermissionUseCase)), NewPermissionUseCase),
+	wire.NewSet(wire.Bind(new(RBACUseCaseInterface), new(*RBACUseCase)), NewRBACUseCase),
 	wire.NewSet(wire.Bind(new(ProductUseCaseInterface), new(*ProductUseCase)), NewProductUseCase),
 )
@@ -2,11 +2,18 @@ package usecase
 
 import (
 	"context"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"go-scaffold/internal/app/domain"
 	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/config"
 )
 
+// ErrNoSuperAdminRole no super admin role is configured
+var ErrNoSuperAdminRole = errors.New("no super admin role is configured")
+
 var _ UserUseCaseInterface = (*UserUseCase)(nil)
 
 type UserUseCaseInterface interface {
@@ -14,21 +21,35 @@ type UserUseCaseInterface interface {
 	Update(ctx context.Context, user domain.User) (*domain.User, error)
 	Delete(ctx context.Context, user domain.User) error
 	Detail(ctx context.Context, id int64) (*domain.User, error)
-	List(ctx context.Context, param UserListParam) ([]*domain.User, error)
-	AssignRoles(ctx context.Context, user int64, roles []int64) error
-	GetRoles(ctx context.Context, user int64) ([]*domain.Role, error)
-	GetPermissions(ctx context.Context, user int64) ([]*domain.Permission, error)
+	List(ctx context.Context, param UserListParam) (*domain.Page[*domain.User], error)
+	AssignRoles(ctx context.Context, tenant, user int64, grants []domain.RoleGrant) error
+	// GetRoles returns the role grants of the user with their validity windows
+	GetRoles(ctx context.Context, tenant, user int64) ([]*domain.RoleGrant, error)
+	// SweepRoleGrants bring the scheduled role grants into effect, and revoke the lapsed ones
+	SweepRoleGrants(ctx context.Context) (*domain.RoleGrantSweepResult, error)
+	GetPermissions(ctx context.Context, tenant, user int64) ([]*domain.Permission, error)
+	// GrantSuperuser grant the first configured super admin role to the user, returns the role
+	GrantSuperuser(ctx context.Context, user domain.User) (int64, error)
 }
 
 type UserUseCase struct {
-	repo repository.UserRepositoryInterface
+	repo         repository.UserRepositoryInterface
+	apiKeyRepo   repository.APIKeyRepositoryInterface
+	identityRepo repository.UserIdentityRepositoryInterface
+	casbinConf   config.Casbin
 }
 
 func NewUserUseCase(
 	repo repository.UserRepositoryInterface,
+	apiKeyRepo repository.APIKeyRepositoryInterface,
+	identityRepo repository.UserIdentityRepositoryInterface,
+	casbinConf config.Casbin,
 ) *UserUseCase {
 	return &UserUseCase{
-		repo: repo,
+		repo:         repo,
+		apiKeyRepo:   apiKeyRepo,
+		identityRepo: identityRepo,
+		casbinConf:   casbinConf,
 	}
 }
 
@@ -41,6 +62,12 @@ func (c *UserUseCase) Update(ctx context.Context, user domain.User) (*domain.Use
 }
 
 func (c *UserUseCase) Delete(ctx context.Context, user domain.User) error {
+	if err := c.apiKeyRepo.DeleteByUser(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := c.identityRepo.DeleteByUser(ctx, user.ID); err != nil {
+		return err
+	}
 	return c.repo.Delete(ctx, user)
 }
 
@@ -49,23 +76,43 @@ func (c *UserUseCase) Detail(ctx context.Context, id int64) (*domain.User, error
 }
 
 type UserListParam struct {
-	Keyword string
+	TenantID int64
+	Keyword  string
+	Page     domain.Pagination
+}
+
+func (c *UserUseCase) List(ctx context.Context, param UserListParam) (*domain.Page[*domain.User], error) {
+	return c.repo.Paginate(ctx, repository.UserFindListParam{
+		TenantID: param.TenantID,
+		Keyword:  param.Keyword,
+	}, param.Page)
 }
 
-func (c *UserUseCase) List(ctx context.Context, param UserListParam) ([]*domain.User, error) {
-	return c.repo.Filter(ctx, repository.UserFindListParam{
-		Keyword: param.Keyword,
-	})
+func (c *UserUseCase) AssignRoles(ctx context.Context, tenant, user int64, grants []domain.RoleGrant) error {
+	return c.repo.AssignRoles(ctx, tenant, user, grants)
 }
 
-func (c *UserUseCase) AssignRoles(ctx context.Context, user int64, roles []int64) error {
-	return c.repo.AssignRoles(ctx, user, roles)
+func (c *UserUseCase) GetRoles(ctx context.Context, tenant, user int64) ([]*domain.RoleGrant, error) {
+	return c.repo.GetRoleGrants(ctx, tenant, user)
 }
 
-func (c *UserUseCase) GetRoles(ctx context.Context, user int64) ([]*domain.Role, error) {
-	return c.repo.GetRoles(ctx, user)
+func (c *UserUseCase) SweepRoleGrants(ctx context.Context) (*domain.RoleGrantSweepResult, error) {
+	return c.repo.SweepRoleGrants(ctx, time.Now())
 }
 
-func (c *UserUseCase) GetPermissions(ctx context.Context, user int64) ([]*domain.Permission, error) {
-	return c.repo.GetPermissions(ctx, user)
+func (c *UserUseCase) GetPermissions(ctx context.Context, tenant, user int64) ([]*domain.Permission, error) {
+	return c.repo.GetPermissions(ctx, tenant, user)
+}
+
+func (c *UserUseCase) GrantSuperuser(ctx context.Context, user domain.User) (int64, error) {
+	if len(c.casbinConf.SuperAdmin.Roles) == 0 {
+		return 0, errors.WithStack(ErrNoSuperAdminRole)
+	}
+
+	role := c.casbinConf.SuperAdmin.Roles[0]
+	if err := c.repo.AddRole(ctx, user.ID, role); err != nil {
+		return 0, err
+	}
+
+	return role, nil
 }
@@ -0,0 +1,68 @@
+package command
+
+import "github.com/spf13/cobra"
+
+type adminCmd struct {
+	*baseCmd
+}
+
+func newAdminCmd() *adminCmd {
+	c := &adminCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "admin",
+		Short: "administration",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Usage(); err != nil {
+				panic(err)
+			}
+		},
+	}
+
+	addRemoteConfigFlag(c.cmd, false)
+	addLoggerFlag(c.cmd, true)
+
+	c.addCommands(
+		newGrantSuperuserCmd(),
+	)
+
+	return c
+}
+
+type grantSuperuserCmd struct {
+	*baseCmd
+}
+
+func newGrantSuperuserCmd() *grantSuperuserCmd {
+	c := &grantSuperuserCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "grant-superuser <username>",
+		Short: "grant the super admin role configured in http.casbin.superAdmin.roles to the user",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c.initRuntime(cmd)
+			c.initLogger(cmd)
+			defer c.closeLogger()
+
+			c.initConfig(cmd)
+			defer c.closeConfig()
+
+			c.run(cmd, args[0])
+		},
+	}
+
+	return c
+}
+
+func (c *grantSuperuserCmd) run(cmd *cobra.Command, username string) {
+	script, cleanup, err := newAdminScript(cmd.Context(), c.appName, c.appEnv, c.logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	if err := script.GrantSuperuser(cmd, username); err != nil {
+		panic(err)
+	}
+}
@@ -44,7 +44,7 @@ func newCronCmd() *cronCmd {
 func (c *cronCmd) run(ctx context.Context) {
 	c.logger.Info("cron server starting...")
 
-	cron, cleanup, err := initCron(ctx, c.appName, c.appEnv, c.logger)
+	cron, cleanup, err := initCron(ctx, c.appName, c.appEnv, c.logger, c.trace)
 	if err != nil {
 		panic(err)
 	}
@@ -27,6 +27,11 @@ var (
 	flagMigrationDir           = flag{"migration", "m", "migrations", "migration directory"}
 	flagMigrationDBGroup       = flag{"db-group", "", "default", "migration database group"}
 	flagMigrationIgnoreUnknown = flag{"ignore-unknown", "", false, "whether to skip checking the database for migrations that are not in the migration source"}
+
+	flagDryRun = flag{"dry-run", "", false, "report the changes without writing them"}
+
+	flagTenant = flag{"tenant", "t", int64(1), "the tenant id"}
+	flagOutput = flag{"output", "o", "", "output file path, the standard output if empty"}
 )
 
 type flag struct {
@@ -74,6 +79,18 @@ func addMigrationFlag(cmd *cobra.Command, persistent bool) {
 	getFlags(cmd, persistent).BoolP(flagMigrationIgnoreUnknown.name, flagMigrationIgnoreUnknown.shortName, flagMigrationIgnoreUnknown.defaultValue.(bool), flagMigrationIgnoreUnknown.usage)
 }
 
+func addDryRunFlag(cmd *cobra.Command, persistent bool) {
+	getFlags(cmd, persistent).BoolP(flagDryRun.name, flagDryRun.shortName, flagDryRun.defaultValue.(bool), flagDryRun.usage)
+}
+
+func addTenantFlag(cmd *cobra.Command, persistent bool) {
+	getFlags(cmd, persistent).Int64P(flagTenant.name, flagTenant.shortName, flagTenant.defaultValue.(int64), flagTenant.usage)
+}
+
+func addOutputFlag(cmd *cobra.Command, persistent bool) {
+	getFlags(cmd, persistent).StringP(flagOutput.name, flagOutput.shortName, flagOutput.defaultValue.(string), flagOutput.usage)
+}
+
 func getAppName(cmd *cobra.Command) config.AppName {
 	return config.AppName(cmd.Flag(flagAppName.name).Value.String())
 }
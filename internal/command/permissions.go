@@ -0,0 +1,75 @@
+package command
+
+import "github.com/spf13/cobra"
+
+type permissionsCmd struct {
+	*baseCmd
+}
+
+func newPermissionsCmd() *permissionsCmd {
+	c := &permissionsCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "permissions",
+		Short: "permission catalogue",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Usage(); err != nil {
+				panic(err)
+			}
+		},
+	}
+
+	addRemoteConfigFlag(c.cmd, false)
+	addLoggerFlag(c.cmd, true)
+
+	c.addCommands(
+		newPermissionsSyncCmd(),
+	)
+
+	return c
+}
+
+type permissionsSyncCmd struct {
+	*baseCmd
+}
+
+func newPermissionsSyncCmd() *permissionsSyncCmd {
+	c := &permissionsSyncCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "sync",
+		Short: "create the permissions of the registered HTTP routes and gRPC operations, update the changed ones, and report the misplaced and stale ones",
+		Run: func(cmd *cobra.Command, args []string) {
+			c.initRuntime(cmd)
+			c.initLogger(cmd)
+			defer c.closeLogger()
+
+			c.initConfig(cmd)
+			defer c.closeConfig()
+
+			c.run(cmd)
+		},
+	}
+
+	addDryRunFlag(c.cmd, false)
+
+	return c
+}
+
+func (c *permissionsSyncCmd) run(cmd *cobra.Command) {
+	dryRun, err := cmd.Flags().GetBool(flagDryRun.name)
+	if err != nil {
+		panic(err)
+	}
+
+	// the routes are registered only, the tracing is not required
+	script, cleanup, err := newPermissionsScript(cmd.Context(), c.appName, c.appEnv, c.logger, nil)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	if err := script.Sync(cmd, dryRun); err != nil {
+		panic(err)
+	}
+}
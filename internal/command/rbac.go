@@ -0,0 +1,131 @@
+package command
+
+import "github.com/spf13/cobra"
+
+type rbacCmd struct {
+	*baseCmd
+}
+
+func newRBACCmd() *rbacCmd {
+	c := &rbacCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "rbac",
+		Short: "roles and permission grants",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Usage(); err != nil {
+				panic(err)
+			}
+		},
+	}
+
+	addRemoteConfigFlag(c.cmd, false)
+	addLoggerFlag(c.cmd, true)
+	addTenantFlag(c.cmd, true)
+
+	c.addCommands(
+		newRBACExportCmd(),
+		newRBACImportCmd(),
+	)
+
+	return c
+}
+
+type rbacExportCmd struct {
+	*baseCmd
+}
+
+func newRBACExportCmd() *rbacExportCmd {
+	c := &rbacExportCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "export",
+		Short: "export the roles of the tenant with their grants and parents as YAML, keyed by the names and the permission keys",
+		Run: func(cmd *cobra.Command, args []string) {
+			c.initRuntime(cmd)
+			c.initLogger(cmd)
+			defer c.closeLogger()
+
+			c.initConfig(cmd)
+			defer c.closeConfig()
+
+			c.run(cmd)
+		},
+	}
+
+	addOutputFlag(c.cmd, false)
+
+	return c
+}
+
+func (c *rbacExportCmd) run(cmd *cobra.Command) {
+	tenant, err := cmd.Flags().GetInt64(flagTenant.name)
+	if err != nil {
+		panic(err)
+	}
+
+	output, err := cmd.Flags().GetString(flagOutput.name)
+	if err != nil {
+		panic(err)
+	}
+
+	script, cleanup, err := newRBACScript(cmd.Context(), c.appName, c.appEnv, c.logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	if err := script.Export(cmd, tenant, output); err != nil {
+		panic(err)
+	}
+}
+
+type rbacImportCmd struct {
+	*baseCmd
+}
+
+func newRBACImportCmd() *rbacImportCmd {
+	c := &rbacImportCmd{new(baseCmd)}
+
+	c.cmd = &cobra.Command{
+		Use:   "import <file>",
+		Short: "create the permissions and roles of the exported YAML that do not exist, update the changed permissions, and replace the data scopes, grants and parents of the roles",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c.initRuntime(cmd)
+			c.initLogger(cmd)
+			defer c.closeLogger()
+
+			c.initConfig(cmd)
+			defer c.closeConfig()
+
+			c.run(cmd, args[0])
+		},
+	}
+
+	addDryRunFlag(c.cmd, false)
+
+	return c
+}
+
+func (c *rbacImportCmd) run(cmd *cobra.Command, file string) {
+	tenant, err := cmd.Flags().GetInt64(flagTenant.name)
+	if err != nil {
+		panic(err)
+	}
+
+	dryRun, err := cmd.Flags().GetBool(flagDryRun.name)
+	if err != nil {
+		panic(err)
+	}
+
+	script, cleanup, err := newRBACScript(cmd.Context(), c.appName, c.appEnv, c.logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	if err := script.Import(cmd, tenant, file, dryRun); err != nil {
+		panic(err)
+	}
+}
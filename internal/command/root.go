@@ -29,6 +29,9 @@ func newRootCmd() *rootCmd {
 		newMigrateCmd(),
 		newKafkaCmd(),
 		newScriptCmd(),
+		newAdminCmd(),
+		newPermissionsCmd(),
+		newRBACCmd(),
 	)
 
 	return c
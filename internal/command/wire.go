@@ -39,11 +39,12 @@ func initCron(
 	config.AppName,
 	config.Env,
 	*slog.Logger,
+	*trace.Trace,
 ) (*cron.Cron, func(), error) {
 	panic(wire.Build(
 		config.ProviderSet,
 		app.ProviderSet,
-		// pkg.ProviderSet,
+		pkg.ProviderSet,
 	))
 }
 
@@ -82,3 +83,43 @@ func newExampleScript(
 		// pkg.ProviderSet,
 	))
 }
+
+func newAdminScript(
+	context.Context,
+	config.AppName,
+	config.Env,
+	*slog.Logger,
+) (*scripts.AdminCmd, func(), error) {
+	panic(wire.Build(
+		config.ProviderSet,
+		app.ProviderSet,
+		pkg.ProviderSet,
+	))
+}
+
+func newPermissionsScript(
+	context.Context,
+	config.AppName,
+	config.Env,
+	*slog.Logger,
+	*trace.Trace,
+) (*scripts.PermissionsCmd, func(), error) {
+	panic(wire.Build(
+		config.ProviderSet,
+		app.ProviderSet,
+		pkg.ProviderSet,
+	))
+}
+
+func newRBACScript(
+	context.Context,
+	config.AppName,
+	config.Env,
+	*slog.Logger,
+) (*scripts.RBACCmd, func(), error) {
+	panic(wire.Build(
+		config.ProviderSet,
+		app.ProviderSet,
+		pkg.ProviderSet,
+	))
+}
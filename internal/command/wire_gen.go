@@ -25,6 +25,7 @@ import (
 	"go-scaffold/internal/app/facade/server/http/handler/v1"
 	"go-scaffold/internal/app/facade/server/http/router"
 	"go-scaffold/internal/app/repository"
+	"go-scaffold/internal/app/service"
 	"go-scaffold/internal/app/usecase"
 	"go-scaffold/internal/config"
 	"go-scaffold/internal/pkg/casbin"
@@ -32,6 +33,8 @@ import (
 	"go-scaffold/internal/pkg/db"
 	"go-scaffold/internal/pkg/ent"
 	"go-scaffold/internal/pkg/gorm"
+	"go-scaffold/internal/pkg/mail"
+	"go-scaffold/internal/pkg/redis"
 	"go-scaffold/pkg/trace"
 	"log/slog"
 )
@@ -43,12 +46,26 @@ func initServer(contextContext context.Context, appName config.AppName, env conf
 	if err != nil {
 		return nil, nil, err
 	}
+	app, err := config.GetApp()
+	if err != nil {
+		return nil, nil, err
+	}
+	accountTokenKeyRing, err := service.NewAccountTokenKeyRingFromConfig(logger, app)
+	if err != nil {
+		return nil, nil, err
+	}
+	accountTokenService := service.NewAccountTokenService(accountTokenKeyRing)
 	database, err := config.GetDefaultDatabase()
 	if err != nil {
 		return nil, nil, err
 	}
-	entClient, cleanup, err := ent.ProvideDefault(contextContext, env, database, logger)
+	defaultDB, cleanup, err := db.ProvideDefault(contextContext, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	entClient, err := ent.ProvideDefault(env, database, logger, defaultDB)
 	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	configCasbin, err := config.GetHTTPCasbin()
@@ -56,27 +73,79 @@ func initServer(contextContext context.Context, appName config.AppName, env conf
 		cleanup()
 		return nil, nil, err
 	}
-	db, cleanup2, err := gorm.ProvideDefault(contextContext, database, logger)
+	gormDB, cleanup2, err := gorm.ProvideDefault(contextContext, database, logger)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
-	enforcer, err := casbin.Provide(contextContext, env, configCasbin, database, logger, db)
+	configRedis, err := config.GetDefaultRedis()
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	userRepository := repository.NewUserRepository(entClient, enforcer)
-	accountUseCase := usecase.NewAccountUseCase(userRepository)
-	accountTokenController := controller.NewAccountTokenController(accountUseCase, userRepository)
-	roleRepository := repository.NewRoleRepository(entClient, enforcer)
-	permissionRepository := repository.NewPermissionRepository(entClient, enforcer)
-	accountPermissionController := controller.NewAccountPermissionController(roleRepository, permissionRepository, enforcer)
+	redisClient, cleanup3, err := redis.ProvideDefault(contextContext, configRedis)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	watcher, cleanup4, err := casbin.ProvideWatcher(contextContext, configCasbin, logger, redisClient)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	decisionCache, err := casbin.ProvideDecisionCache(configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	syncedEnforcer, err := casbin.Provide(env, configCasbin, database, logger, gormDB, defaultDB, watcher, decisionCache)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	unitOfWork := repository.NewUnitOfWork(database, entClient, syncedEnforcer, watcher, decisionCache)
+	userRepository := repository.NewUserRepository(entClient, syncedEnforcer, unitOfWork)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(redisClient)
+	sessionRepository := repository.NewSessionRepository(redisClient)
+	accountUseCase := usecase.NewAccountUseCase(accountTokenService, userRepository, refreshTokenRepository, sessionRepository)
+	accountTokenController := controller.NewAccountTokenController(accountTokenService, accountUseCase, userRepository)
+	apiKeyRepository := repository.NewAPIKeyRepository(entClient)
+	permissionRepository, err := repository.NewPermissionRepository(entClient, syncedEnforcer, unitOfWork, configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiKeyUseCase := usecase.NewAPIKeyUseCase(apiKeyRepository, userRepository, permissionRepository)
+	apiKeyController := controller.NewAPIKeyController(logger, apiKeyUseCase, apiKeyRepository, userRepository)
+	auditLogRepository := repository.NewAuditLogRepository(entClient)
+	impersonationUseCase := usecase.NewImpersonationUseCase(accountTokenService, auditLogRepository)
+	impersonationController := controller.NewImpersonationController(logger, impersonationUseCase, userRepository)
+	roleRepository := repository.NewRoleRepository(entClient, syncedEnforcer, unitOfWork)
+	accountPermissionController := controller.NewAccountPermissionController(roleRepository, permissionRepository, syncedEnforcer, decisionCache)
+	tenantRepository := repository.NewTenantRepository(entClient)
+	tenantUseCase := usecase.NewTenantUseCase(tenantRepository, userRepository, configCasbin)
+	tenantController := controller.NewTenantController(tenantUseCase)
+	dataScopeUseCase := usecase.NewDataScopeUseCase(userRepository, configCasbin)
+	dataScopeController := controller.NewDataScopeController(dataScopeUseCase)
 	greetController := controller.NewGreetController()
 	greetHandler := v1.NewGreetHandler(greetController)
 	services, err := config.GetServices()
 	if err != nil {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
@@ -85,33 +154,83 @@ func initServer(contextContext context.Context, appName config.AppName, env conf
 	traceHandler := v1.NewTraceHandler(logger, services, httpServer, traceTrace, clientGRPC)
 	kafka, err := config.GetExampleKafka()
 	if err != nil {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
 	producerController := controller.NewProducerController(kafka)
 	producerHandler := v1.NewProducerHandler(producerController)
-	userUseCase := usecase.NewUserUseCase(userRepository)
-	accountController := controller.NewAccountController(accountUseCase, userUseCase, userRepository)
+	passwordHasher, err := service.NewPasswordHasher(app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginChallengeRepository := repository.NewLoginChallengeRepository(redisClient)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(appName, app, userRepository, roleRepository, loginChallengeRepository)
+	loginAttemptRepository := repository.NewLoginAttemptRepository(redisClient)
+	loginThrottleUseCase := usecase.NewLoginThrottleUseCase(app, loginAttemptRepository)
+	mailer, err := mail.Provide(logger, env, app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountActionRepository := repository.NewAccountActionRepository(redisClient)
+	accountRecoveryUseCase := usecase.NewAccountRecoveryUseCase(appName, app, accountTokenService, mailer, userRepository, accountActionRepository)
+	oidcProviders, err := service.NewOIDCProviders(app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	oidcAuthorizationRepository := repository.NewOIDCAuthorizationRepository(redisClient)
+	userIdentityRepository := repository.NewUserIdentityRepository(entClient)
+	oidcUseCase := usecase.NewOIDCUseCase(oidcProviders, oidcAuthorizationRepository, userIdentityRepository, userRepository)
+	userUseCase := usecase.NewUserUseCase(userRepository, apiKeyRepository, userIdentityRepository, configCasbin)
+	accountController := controller.NewAccountController(logger, passwordHasher, accountUseCase, twoFactorUseCase, loginThrottleUseCase, accountRecoveryUseCase, oidcUseCase, userUseCase, userRepository)
 	accountHandler := v1.NewAccountHandler(accountController)
-	userController := controller.NewUserController(userUseCase, userRepository, roleRepository)
+	userController := controller.NewUserController(logger, passwordHasher, userUseCase, accountUseCase, loginThrottleUseCase, userRepository, roleRepository)
 	userHandler := v1.NewUserHandler(userController)
-	roleUseCase := usecase.NewRoleUseCase(roleRepository)
+	apiKeyHandler := v1.NewAPIKeyHandler(apiKeyController)
+	impersonationHandler := v1.NewImpersonationHandler(impersonationController)
+	roleUseCase := usecase.NewRoleUseCase(roleRepository, permissionRepository)
 	roleController := controller.NewRoleController(roleUseCase, roleRepository, permissionRepository)
 	roleHandler := v1.NewRoleHandler(roleController)
 	permissionUseCase := usecase.NewPermissionUseCase(permissionRepository)
 	permissionController := controller.NewPermissionController(permissionUseCase, permissionRepository)
 	permissionHandler := v1.NewPermissionHandler(permissionController)
+	authzUseCase := usecase.NewAuthzUseCase(userRepository, permissionRepository)
+	authzController := controller.NewAuthzController(authzUseCase, userRepository, permissionRepository, decisionCache)
+	authzHandler := v1.NewAuthzHandler(authzController)
 	productRepository := repository.NewProductRepository(entClient)
 	productUseCase := usecase.NewProductUseCase(productRepository)
 	productController := controller.NewProductController(productUseCase, productRepository)
 	productHandler := v1.NewProductHandler(productController)
-	apiV1Group := router.NewAPIV1Group(accountTokenController, accountPermissionController, greetHandler, traceHandler, producerHandler, accountHandler, userHandler, roleHandler, permissionHandler, productHandler)
+	permissions := router.NewPermissions()
+	apiV1Group := router.NewAPIV1Group(accountTokenController, apiKeyController, impersonationController, accountPermissionController, tenantController, dataScopeController, greetHandler, traceHandler, producerHandler, accountHandler, userHandler, apiKeyHandler, impersonationHandler, roleHandler, permissionHandler, authzHandler, productHandler, permissions)
 	apiGroup := router.NewAPIGroup(env, logger, httpServer, apiV1Group)
-	handler := router.New(logger, appName, env, httpServer, apiGroup)
+	handler, err := router.New(logger, appName, env, httpServer, accountTokenController, apiGroup)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	server2 := http.New(httpServer, handler)
 	grpcServer, err := config.GetGRPCServer()
 	if err != nil {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
@@ -121,27 +240,104 @@ func initServer(contextContext context.Context, appName config.AppName, env conf
 	v1RoleHandler := v1_2.NewRoleHandler(logger, roleController)
 	v1PermissionHandler := v1_2.NewPermissionHandler(logger, permissionController)
 	v1ProductHandler := v1_2.NewProductHandler(logger, productController)
-	routerRouter := router2.New(v1GreetHandler, v1UserHandler, v1RoleHandler, v1PermissionHandler, v1ProductHandler)
-	server3 := grpc.New(grpcServer, routerRouter)
-	serverServer := server.New(contextContext, appName, server2, server3)
+	v1AccountHandler := v1_2.NewAccountHandler(logger, accountController)
+	routerRouter := router2.New(v1GreetHandler, v1UserHandler, v1RoleHandler, v1PermissionHandler, v1ProductHandler, v1AccountHandler)
+	server3 := grpc.New(grpcServer, routerRouter, accountTokenController, apiKeyController, accountPermissionController, tenantController, dataScopeController, impersonationController)
+	roleGrantSweepJob := job.NewRoleGrantSweepJob(logger, userUseCase)
+	roleGrantSweeper := server.NewRoleGrantSweeper(roleGrantSweepJob)
+	serverServer := server.New(contextContext, appName, server2, server3, roleGrantSweeper)
 	return serverServer, func() {
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
 }
 
-func initCron(contextContext context.Context, appName config.AppName, env config.Env, logger *slog.Logger) (*cron.Cron, func(), error) {
+func initCron(contextContext context.Context, appName config.AppName, env config.Env, logger *slog.Logger, traceTrace *trace.Trace) (*cron.Cron, func(), error) {
 	app, err := config.GetApp()
 	if err != nil {
 		return nil, nil, err
 	}
 	exampleJob := job.NewExampleJob(logger)
-	schedulerScheduler := scheduler.New(app, exampleJob)
+	database, err := config.GetDefaultDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultDB, cleanup, err := db.ProvideDefault(contextContext, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	entClient, err := ent.ProvideDefault(env, database, logger, defaultDB)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configCasbin, err := config.GetHTTPCasbin()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	gormDB, cleanup2, err := gorm.ProvideDefault(contextContext, database, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configRedis, err := config.GetDefaultRedis()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	redisClient, cleanup3, err := redis.ProvideDefault(contextContext, configRedis)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	watcher, cleanup4, err := casbin.ProvideWatcher(contextContext, configCasbin, logger, redisClient)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	decisionCache, err := casbin.ProvideDecisionCache(configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	syncedEnforcer, err := casbin.Provide(env, configCasbin, database, logger, gormDB, defaultDB, watcher, decisionCache)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	unitOfWork := repository.NewUnitOfWork(database, entClient, syncedEnforcer, watcher, decisionCache)
+	userRepository := repository.NewUserRepository(entClient, syncedEnforcer, unitOfWork)
+	apiKeyRepository := repository.NewAPIKeyRepository(entClient)
+	userIdentityRepository := repository.NewUserIdentityRepository(entClient)
+	userUseCase := usecase.NewUserUseCase(userRepository, apiKeyRepository, userIdentityRepository, configCasbin)
+	roleGrantSweepJob := job.NewRoleGrantSweepJob(logger, userUseCase)
+	schedulerScheduler := scheduler.New(app, exampleJob, roleGrantSweepJob)
 	cronCron, err := cron.New(logger, schedulerScheduler)
 	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
 		return nil, nil, err
 	}
 	return cronCron, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
 	}, nil
 }
 
@@ -176,3 +372,404 @@ func newExampleScript(contextContext context.Context, appName config.AppName, en
 	return scriptsExampleCmd, func() {
 	}, nil
 }
+
+func newAdminScript(contextContext context.Context, appName config.AppName, env config.Env, logger *slog.Logger) (*scripts.AdminCmd, func(), error) {
+	app, err := config.GetApp()
+	if err != nil {
+		return nil, nil, err
+	}
+	passwordHasher, err := service.NewPasswordHasher(app)
+	if err != nil {
+		return nil, nil, err
+	}
+	database, err := config.GetDefaultDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultDB, cleanup, err := db.ProvideDefault(contextContext, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	entClient, err := ent.ProvideDefault(env, database, logger, defaultDB)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configCasbin, err := config.GetHTTPCasbin()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	gormDB, cleanup2, err := gorm.ProvideDefault(contextContext, database, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configRedis, err := config.GetDefaultRedis()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	redisClient, cleanup3, err := redis.ProvideDefault(contextContext, configRedis)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	watcher, cleanup4, err := casbin.ProvideWatcher(contextContext, configCasbin, logger, redisClient)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	decisionCache, err := casbin.ProvideDecisionCache(configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	syncedEnforcer, err := casbin.Provide(env, configCasbin, database, logger, gormDB, defaultDB, watcher, decisionCache)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	unitOfWork := repository.NewUnitOfWork(database, entClient, syncedEnforcer, watcher, decisionCache)
+	userRepository := repository.NewUserRepository(entClient, syncedEnforcer, unitOfWork)
+	apiKeyRepository := repository.NewAPIKeyRepository(entClient)
+	userIdentityRepository := repository.NewUserIdentityRepository(entClient)
+	userUseCase := usecase.NewUserUseCase(userRepository, apiKeyRepository, userIdentityRepository, configCasbin)
+	accountTokenKeyRing, err := service.NewAccountTokenKeyRingFromConfig(logger, app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountTokenService := service.NewAccountTokenService(accountTokenKeyRing)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(redisClient)
+	sessionRepository := repository.NewSessionRepository(redisClient)
+	accountUseCase := usecase.NewAccountUseCase(accountTokenService, userRepository, refreshTokenRepository, sessionRepository)
+	loginAttemptRepository := repository.NewLoginAttemptRepository(redisClient)
+	loginThrottleUseCase := usecase.NewLoginThrottleUseCase(app, loginAttemptRepository)
+	roleRepository := repository.NewRoleRepository(entClient, syncedEnforcer, unitOfWork)
+	userController := controller.NewUserController(logger, passwordHasher, userUseCase, accountUseCase, loginThrottleUseCase, userRepository, roleRepository)
+	scriptsAdminCmd := scripts.NewAdminCmd(userController)
+	return scriptsAdminCmd, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func newPermissionsScript(contextContext context.Context, appName config.AppName, env config.Env, logger *slog.Logger, traceTrace *trace.Trace) (*scripts.PermissionsCmd, func(), error) {
+	database, err := config.GetDefaultDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultDB, cleanup, err := db.ProvideDefault(contextContext, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	entClient, err := ent.ProvideDefault(env, database, logger, defaultDB)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configCasbin, err := config.GetHTTPCasbin()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	gormDB, cleanup2, err := gorm.ProvideDefault(contextContext, database, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configRedis, err := config.GetDefaultRedis()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	redisClient, cleanup3, err := redis.ProvideDefault(contextContext, configRedis)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	watcher, cleanup4, err := casbin.ProvideWatcher(contextContext, configCasbin, logger, redisClient)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	decisionCache, err := casbin.ProvideDecisionCache(configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	syncedEnforcer, err := casbin.Provide(env, configCasbin, database, logger, gormDB, defaultDB, watcher, decisionCache)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	unitOfWork := repository.NewUnitOfWork(database, entClient, syncedEnforcer, watcher, decisionCache)
+	permissionRepository, err := repository.NewPermissionRepository(entClient, syncedEnforcer, unitOfWork, configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	permissionUseCase := usecase.NewPermissionUseCase(permissionRepository)
+	permissionController := controller.NewPermissionController(permissionUseCase, permissionRepository)
+	httpServer, err := config.GetHTTPServer()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	app, err := config.GetApp()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountTokenKeyRing, err := service.NewAccountTokenKeyRingFromConfig(logger, app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountTokenService := service.NewAccountTokenService(accountTokenKeyRing)
+	userRepository := repository.NewUserRepository(entClient, syncedEnforcer, unitOfWork)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(redisClient)
+	sessionRepository := repository.NewSessionRepository(redisClient)
+	accountUseCase := usecase.NewAccountUseCase(accountTokenService, userRepository, refreshTokenRepository, sessionRepository)
+	accountTokenController := controller.NewAccountTokenController(accountTokenService, accountUseCase, userRepository)
+	apiKeyRepository := repository.NewAPIKeyRepository(entClient)
+	apiKeyUseCase := usecase.NewAPIKeyUseCase(apiKeyRepository, userRepository, permissionRepository)
+	apiKeyController := controller.NewAPIKeyController(logger, apiKeyUseCase, apiKeyRepository, userRepository)
+	auditLogRepository := repository.NewAuditLogRepository(entClient)
+	impersonationUseCase := usecase.NewImpersonationUseCase(accountTokenService, auditLogRepository)
+	impersonationController := controller.NewImpersonationController(logger, impersonationUseCase, userRepository)
+	roleRepository := repository.NewRoleRepository(entClient, syncedEnforcer, unitOfWork)
+	accountPermissionController := controller.NewAccountPermissionController(roleRepository, permissionRepository, syncedEnforcer, decisionCache)
+	tenantRepository := repository.NewTenantRepository(entClient)
+	tenantUseCase := usecase.NewTenantUseCase(tenantRepository, userRepository, configCasbin)
+	tenantController := controller.NewTenantController(tenantUseCase)
+	dataScopeUseCase := usecase.NewDataScopeUseCase(userRepository, configCasbin)
+	dataScopeController := controller.NewDataScopeController(dataScopeUseCase)
+	greetController := controller.NewGreetController()
+	greetHandler := v1.NewGreetHandler(greetController)
+	services, err := config.GetServices()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	clientGRPC := client.ProvideGRPC()
+	traceHandler := v1.NewTraceHandler(logger, services, httpServer, traceTrace, clientGRPC)
+	configKafka, err := config.GetExampleKafka()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	producerController := controller.NewProducerController(configKafka)
+	producerHandler := v1.NewProducerHandler(producerController)
+	passwordHasher, err := service.NewPasswordHasher(app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginChallengeRepository := repository.NewLoginChallengeRepository(redisClient)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(appName, app, userRepository, roleRepository, loginChallengeRepository)
+	loginAttemptRepository := repository.NewLoginAttemptRepository(redisClient)
+	loginThrottleUseCase := usecase.NewLoginThrottleUseCase(app, loginAttemptRepository)
+	mailer, err := mail.Provide(logger, env, app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	accountActionRepository := repository.NewAccountActionRepository(redisClient)
+	accountRecoveryUseCase := usecase.NewAccountRecoveryUseCase(appName, app, accountTokenService, mailer, userRepository, accountActionRepository)
+	oidcProviders, err := service.NewOIDCProviders(app)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	oidcAuthorizationRepository := repository.NewOIDCAuthorizationRepository(redisClient)
+	userIdentityRepository := repository.NewUserIdentityRepository(entClient)
+	oidcUseCase := usecase.NewOIDCUseCase(oidcProviders, oidcAuthorizationRepository, userIdentityRepository, userRepository)
+	userUseCase := usecase.NewUserUseCase(userRepository, apiKeyRepository, userIdentityRepository, configCasbin)
+	accountController := controller.NewAccountController(logger, passwordHasher, accountUseCase, twoFactorUseCase, loginThrottleUseCase, accountRecoveryUseCase, oidcUseCase, userUseCase, userRepository)
+	accountHandler := v1.NewAccountHandler(accountController)
+	userController := controller.NewUserController(logger, passwordHasher, userUseCase, accountUseCase, loginThrottleUseCase, userRepository, roleRepository)
+	userHandler := v1.NewUserHandler(userController)
+	apiKeyHandler := v1.NewAPIKeyHandler(apiKeyController)
+	impersonationHandler := v1.NewImpersonationHandler(impersonationController)
+	roleUseCase := usecase.NewRoleUseCase(roleRepository, permissionRepository)
+	roleController := controller.NewRoleController(roleUseCase, roleRepository, permissionRepository)
+	roleHandler := v1.NewRoleHandler(roleController)
+	permissionHandler := v1.NewPermissionHandler(permissionController)
+	authzUseCase := usecase.NewAuthzUseCase(userRepository, permissionRepository)
+	authzController := controller.NewAuthzController(authzUseCase, userRepository, permissionRepository, decisionCache)
+	authzHandler := v1.NewAuthzHandler(authzController)
+	productRepository := repository.NewProductRepository(entClient)
+	productUseCase := usecase.NewProductUseCase(productRepository)
+	productController := controller.NewProductController(productUseCase, productRepository)
+	productHandler := v1.NewProductHandler(productController)
+	permissions := router.NewPermissions()
+	apiV1Group := router.NewAPIV1Group(accountTokenController, apiKeyController, impersonationController, accountPermissionController, tenantController, dataScopeController, greetHandler, traceHandler, producerHandler, accountHandler, userHandler, apiKeyHandler, impersonationHandler, roleHandler, permissionHandler, authzHandler, productHandler, permissions)
+	apiGroup := router.NewAPIGroup(env, logger, httpServer, apiV1Group)
+	httpHandler, err := router.New(logger, appName, env, httpServer, accountTokenController, apiGroup)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	grpcServer, err := config.GetGRPCServer()
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	v1GreetHandler := v1_2.NewGreetHandler(logger, greetController)
+	v1UserHandler := v1_2.NewUserHandler(logger, userController)
+	v1RoleHandler := v1_2.NewRoleHandler(logger, roleController)
+	v1PermissionHandler := v1_2.NewPermissionHandler(logger, permissionController)
+	v1ProductHandler := v1_2.NewProductHandler(logger, productController)
+	v1AccountHandler := v1_2.NewAccountHandler(logger, accountController)
+	routerRouter := router2.New(v1GreetHandler, v1UserHandler, v1RoleHandler, v1PermissionHandler, v1ProductHandler, v1AccountHandler)
+	server2 := grpc.New(grpcServer, routerRouter, accountTokenController, apiKeyController, accountPermissionController, tenantController, dataScopeController, impersonationController)
+	scriptsPermissionsCmd := scripts.NewPermissionsCmd(permissionController, httpHandler, permissions, server2)
+	return scriptsPermissionsCmd, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func newRBACScript(contextContext context.Context, appName config.AppName, env config.Env, logger *slog.Logger) (*scripts.RBACCmd, func(), error) {
+	database, err := config.GetDefaultDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultDB, cleanup, err := db.ProvideDefault(contextContext, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	entClient, err := ent.ProvideDefault(env, database, logger, defaultDB)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configCasbin, err := config.GetHTTPCasbin()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	gormDB, cleanup2, err := gorm.ProvideDefault(contextContext, database, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	configRedis, err := config.GetDefaultRedis()
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	redisClient, cleanup3, err := redis.ProvideDefault(contextContext, configRedis)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	watcher, cleanup4, err := casbin.ProvideWatcher(contextContext, configCasbin, logger, redisClient)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	decisionCache, err := casbin.ProvideDecisionCache(configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	syncedEnforcer, err := casbin.Provide(env, configCasbin, database, logger, gormDB, defaultDB, watcher, decisionCache)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	unitOfWork := repository.NewUnitOfWork(database, entClient, syncedEnforcer, watcher, decisionCache)
+	roleRepository := repository.NewRoleRepository(entClient, syncedEnforcer, unitOfWork)
+	permissionRepository, err := repository.NewPermissionRepository(entClient, syncedEnforcer, unitOfWork, configCasbin)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	rbacUseCase := usecase.NewRBACUseCase(roleRepository, permissionRepository)
+	permissionUseCase := usecase.NewPermissionUseCase(permissionRepository)
+	tenantRepository := repository.NewTenantRepository(entClient)
+	rbacController := controller.NewRBACController(rbacUseCase, permissionUseCase, tenantRepository, unitOfWork)
+	scriptsRBACCmd := scripts.NewRBACCmd(rbacController)
+	return scriptsRBACCmd, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+	}, nil
+}
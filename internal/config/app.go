@@ -10,13 +10,183 @@ var supportedEnvs = []Env{Dev, Test, Prod}
 
 // App application base config
 type App struct {
-	Timeout time.Duration `json:"timeout"`
+	Timeout   time.Duration `json:"timeout"`
+	Password  Password      `json:"password"`
+	Token     Token         `json:"token"`
+	TwoFactor TwoFactor     `json:"twoFactor"`
+	Login     Login         `json:"login"`
+	Mail      Mail          `json:"mail"`
+	OIDC      OIDC          `json:"oidc"`
 }
 
 func (App) GetName() string {
 	return "app"
 }
 
+// Password password hashing config
+type Password struct {
+	// Algorithm the algorithm used to hash new passwords (bcrypt, argon2id)
+	// if not specified，default: "argon2id"
+	Algorithm PasswordAlgorithm `json:"algorithm"`
+	Bcrypt    PasswordBcrypt    `json:"bcrypt"`
+	Argon2id  PasswordArgon2id  `json:"argon2id"`
+}
+
+// PasswordAlgorithm password hashing algorithm
+type PasswordAlgorithm string
+
+func (a PasswordAlgorithm) String() string {
+	return string(a)
+}
+
+// IsSupported check that the algorithm is supported
+func (a PasswordAlgorithm) IsSupported() bool {
+	return lo.Contains(supportedPasswordAlgorithms, a)
+}
+
+const (
+	Bcrypt   PasswordAlgorithm = "bcrypt"
+	Argon2id PasswordAlgorithm = "argon2id"
+)
+
+var supportedPasswordAlgorithms = []PasswordAlgorithm{Bcrypt, Argon2id}
+
+// PasswordBcrypt bcrypt config
+type PasswordBcrypt struct {
+	Cost int `json:"cost"`
+}
+
+// PasswordArgon2id argon2id config
+type PasswordArgon2id struct {
+	Memory      uint32 `json:"memory"` // KiB
+	Iterations  uint32 `json:"iterations"`
+	Parallelism uint8  `json:"parallelism"`
+}
+
+// Token access token signing config
+type Token struct {
+	// Keys the signing key ring, the key with the latest NotBefore that is in effect signs the new tokens,
+	// the retired keys are still published for verification until the tokens signed by them expire
+	// if not specified, an ephemeral key is generated on startup
+	Keys []TokenKey `json:"keys"`
+}
+
+// TokenKey access token signing key
+type TokenKey struct {
+	ID         string    `json:"id"`         // published as the kid header
+	PrivateKey string    `json:"privateKey"` // path of the PEM encoded RSA or Ed25519 private key
+	NotBefore  time.Time `json:"notBefore"`  // RFC 3339, the time the key starts signing
+	NotAfter   time.Time `json:"notAfter"`   // RFC 3339, the time the key stops signing
+}
+
+// TwoFactor two-factor authentication config
+type TwoFactor struct {
+	// Issuer the issuer shown in the authenticator apps
+	// if not specified，default: the application name
+	Issuer string `json:"issuer"`
+	// RequiredRoles the users of the roles are required to log in with TOTP
+	RequiredRoles []int64 `json:"requiredRoles"`
+}
+
+// Login login brute-force protection config
+type Login struct {
+	// FreeAttempts the failures allowed before the attempts are delayed progressively
+	// if not specified，default: 3
+	FreeAttempts int64 `json:"freeAttempts"`
+	// MaxAttempts the failures of a username before it is locked out
+	// if not specified，default: 10
+	MaxAttempts int64 `json:"maxAttempts"`
+	// MaxIPAttempts the failures of an IP before it is locked out
+	// if not specified，default: 100
+	MaxIPAttempts int64 `json:"maxIPAttempts"`
+	// MaxDelay the upper bound of the progressive delay, in seconds
+	// if not specified，default: 60
+	MaxDelay time.Duration `json:"maxDelay"`
+	// Window the failures are forgotten after the window since the last failure, in seconds
+	// if not specified，default: 900
+	Window time.Duration `json:"window"`
+	// LockoutDuration in seconds
+	// if not specified，default: 900
+	LockoutDuration time.Duration `json:"lockoutDuration"`
+}
+
+// Mail mail sending config
+type Mail struct {
+	// Driver the way the mails are sent (smtp, file, log)
+	// if not specified，default: "log", which is only allowed in the dev and test environments
+	Driver MailDriver `json:"driver"`
+	From   string     `json:"from"`
+	SMTP   MailSMTP   `json:"smtp"`
+	File   MailFile   `json:"file"`
+	// PasswordResetURL the link in the password reset mail, "{token}" is replaced with the reset token
+	// if not specified, the bare token is sent
+	PasswordResetURL string `json:"passwordResetURL"`
+	// EmailVerificationURL the link in the verification mail, "{token}" is replaced with the verification token
+	// if not specified, the bare token is sent
+	EmailVerificationURL string `json:"emailVerificationURL"`
+}
+
+// MailDriver mail sending driver
+type MailDriver string
+
+func (d MailDriver) String() string {
+	return string(d)
+}
+
+const (
+	SMTPMailDriver MailDriver = "smtp"
+	FileMailDriver MailDriver = "file"
+	LogMailDriver  MailDriver = "log"
+)
+
+// MailSMTP smtp config
+type MailSMTP struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// MailFile file mail config, every mail is written to a file in the directory
+type MailFile struct {
+	Dir string `json:"dir"`
+}
+
+// OIDC OpenID Connect login federation config
+type OIDC struct {
+	Providers []OIDCProvider `json:"providers"`
+}
+
+// OIDCProvider the identity provider that the users sign in through,
+// the authorization code flow with PKCE is used
+type OIDCProvider struct {
+	// Name identifies the provider in the login URL, /v1/oidc/{name}/...
+	Name         string `json:"name"`
+	Issuer       string `json:"issuer"` // the provider configuration is discovered from the issuer
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"` // empty for the public client
+	// RedirectURL the page that receives the authorization code and the state,
+	// it must be registered at the provider
+	RedirectURL string `json:"redirectURL"`
+	// Scopes if not specified，default: ["openid", "profile", "email"]
+	Scopes []string `json:"scopes"`
+	// UsernameClaim the claim that the username of the provisioned user is taken from
+	// if not specified，default: "preferred_username"
+	UsernameClaim string `json:"usernameClaim"`
+	// RoleClaim the claim that holds the groups of the user, nested claims are separated by dots, e.g. "realm_access.roles"
+	// the mapped roles are granted and the other roles of the mappings are revoked on every login,
+	// the roles out of the mappings are kept, e.g. the ones granted locally.
+	// if not specified, the roles are not synchronized
+	RoleClaim    string            `json:"roleClaim"`
+	RoleMappings []OIDCRoleMapping `json:"roleMappings"`
+}
+
+// OIDCRoleMapping the users with the value in the role claim are assigned the roles
+type OIDCRoleMapping struct {
+	Value string  `json:"value"`
+	Roles []int64 `json:"roles"`
+}
+
 // AppName application name
 type AppName string
 
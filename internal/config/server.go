@@ -18,6 +18,9 @@ type HTTPServer struct {
 	Addr         string        `json:"addr"`
 	Timeout      time.Duration `json:"timeout"`
 	ExternalAddr string        `json:"externalAddr"`
+	// TrustedProxies the CIDRs of the reverse proxies whose X-Forwarded-For header is trusted,
+	// the client ip is the remote address of the connection if not specified
+	TrustedProxies []string `json:"trustedProxies"`
 }
 
 func (HTTPServer) GetName() string {
@@ -26,8 +29,11 @@ func (HTTPServer) GetName() string {
 
 // Casbin casbin config
 type Casbin struct {
-	Model   CasbinModel   `json:"model"`
-	Adapter CasbinAdapter `json:"adapter"`
+	Model      CasbinModel      `json:"model"`
+	Adapter    CasbinAdapter    `json:"adapter"`
+	SuperAdmin CasbinSuperAdmin `json:"superAdmin"`
+	Watcher    *CasbinWatcher   `json:"watcher"`
+	Cache      CasbinCache      `json:"cache"`
 }
 
 func (Casbin) GetName() string {
@@ -39,6 +45,31 @@ type CasbinModel struct {
 	Path string `json:"path"`
 }
 
+// CasbinSuperAdmin the users and roles that are granted all the permissions,
+// the model matcher must call the isSuperAdmin function, e.g. "m = isSuperAdmin(r.sub, r.dom) || ..."
+type CasbinSuperAdmin struct {
+	Users []int64 `json:"users"`
+	Roles []int64 `json:"roles"` // the first role is granted by the "admin grant-superuser" command
+}
+
+// CasbinWatcher synchronize the policy changes among the replicas through the redis pub/sub,
+// the watcher is disabled if it is not configured
+type CasbinWatcher struct {
+	Channel string `json:"channel"` // default: "casbin:policy"
+}
+
+// CasbinCache the in-process LRU caches of the permission key lookups and the enforcement decisions,
+// the decisions are invalidated by the policy changes, the permissions are invalidated by the local permission changes
+// and expire after the ttl, which bounds the staleness of the changes made by the other replicas
+type CasbinCache struct {
+	// Size the maximum number of the entries of each cache
+	// if not specified，default: 10000, the caches are disabled if negative
+	Size int `json:"size"`
+	// TTL in seconds
+	// if not specified，default: 60
+	TTL time.Duration `json:"ttl"`
+}
+
 // CasbinFileAdapter casbin file adapter
 type (
 	// CasbinAdapter casbin adapter
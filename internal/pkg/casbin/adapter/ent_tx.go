@@ -0,0 +1,124 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/casbin/ent-adapter/ent"
+	"github.com/casbin/ent-adapter/ent/casbinrule"
+	"github.com/casbin/ent-adapter/ent/predicate"
+)
+
+var (
+	_ persist.Adapter      = (*EntTxAdapter)(nil)
+	_ persist.BatchAdapter = (*EntTxAdapter)(nil)
+)
+
+// ErrNotImplemented the policies are never loaded or saved through the EntTxAdapter,
+// the message is recognized by casbin
+var ErrNotImplemented = errors.New("not implemented")
+
+// EntTxAdapter write the policy changes in the transaction of the caller,
+// the table is the same as the one of the casbin ent adapter
+type EntTxAdapter struct {
+	ctx    context.Context
+	client *ent.Client
+}
+
+// NewEntTxAdapter build the adapter on the transaction, e.g. the transactional client of ent,
+// the transaction is committed or rolled back by the caller
+func NewEntTxAdapter(ctx context.Context, driver string, tx entsql.ExecQuerier) *EntTxAdapter {
+	return &EntTxAdapter{
+		ctx:    ctx,
+		client: ent.NewClient(ent.Driver(&txDriver{Conn: entsql.Conn{ExecQuerier: tx}, dialect: driver})),
+	}
+}
+
+func (a *EntTxAdapter) LoadPolicy(model.Model) error {
+	return ErrNotImplemented
+}
+
+func (a *EntTxAdapter) SavePolicy(model.Model) error {
+	return ErrNotImplemented
+}
+
+func (a *EntTxAdapter) AddPolicy(sec string, ptype string, rule []string) error {
+	return a.AddPolicies(sec, ptype, [][]string{rule})
+}
+
+func (a *EntTxAdapter) AddPolicies(sec string, ptype string, rules [][]string) error {
+	lines := make([]*ent.CasbinRuleCreate, 0, len(rules))
+	for _, rule := range rules {
+		line := a.client.CasbinRule.Create().SetPtype(ptype)
+		setters := []func(string) *ent.CasbinRuleCreate{line.SetV0, line.SetV1, line.SetV2, line.SetV3, line.SetV4, line.SetV5}
+		for i, v := range rule {
+			if i < len(setters) {
+				setters[i](v)
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	_, err := a.client.CasbinRule.CreateBulk(lines...).Save(a.ctx)
+	return err
+}
+
+func (a *EntTxAdapter) RemovePolicy(sec string, ptype string, rule []string) error {
+	return a.RemovePolicies(sec, ptype, [][]string{rule})
+}
+
+func (a *EntTxAdapter) RemovePolicies(sec string, ptype string, rules [][]string) error {
+	for _, rule := range rules {
+		// the fields out of the rule must be empty
+		values := make([]string, 6)
+		copy(values, rule)
+
+		if _, err := a.client.CasbinRule.Delete().
+			Where(ruleP(ptype, 0, values, true)...).
+			Exec(a.ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *EntTxAdapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	_, err := a.client.CasbinRule.Delete().
+		Where(ruleP(ptype, fieldIndex, fieldValues, false)...).
+		Exec(a.ctx)
+	return err
+}
+
+// ruleP the predicates of the rule, the empty values match any value unless exact is true
+func ruleP(ptype string, fieldIndex int, values []string, exact bool) []predicate.CasbinRule {
+	fields := []func(string) predicate.CasbinRule{
+		casbinrule.V0EQ, casbinrule.V1EQ, casbinrule.V2EQ,
+		casbinrule.V3EQ, casbinrule.V4EQ, casbinrule.V5EQ,
+	}
+
+	ps := []predicate.CasbinRule{casbinrule.PtypeEQ(ptype)}
+	for i, v := range values {
+		if fieldIndex+i >= len(fields) || (v == "" && !exact) {
+			continue
+		}
+		ps = append(ps, fields[fieldIndex+i](v))
+	}
+	return ps
+}
+
+// txDriver the ent driver on the transaction of the caller,
+// the transactions started by the client are no-op
+type txDriver struct {
+	entsql.Conn
+	dialect string
+}
+
+func (d *txDriver) Tx(context.Context) (dialect.Tx, error) { return dialect.NopTx(d), nil }
+
+func (d *txDriver) Close() error { return nil }
+
+func (d *txDriver) Dialect() string { return d.dialect }
@@ -0,0 +1,198 @@
+package casbin
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+
+	"go-scaffold/internal/config"
+	"go-scaffold/pkg/lru"
+)
+
+const (
+	defaultCacheSize = 10000
+	defaultCacheTTL  = time.Minute
+)
+
+// NewCache build the LRU cache configured by http.casbin.cache, it is nil if the caches are disabled
+func NewCache[K comparable, V any](conf config.CasbinCache) (*lru.Cache[K, V], error) {
+	size, ttl := conf.Size, conf.TTL*time.Second
+	if size < 0 {
+		return nil, nil
+	}
+	if size == 0 {
+		size = defaultCacheSize
+	}
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+
+	return lru.New[K, V](size, ttl)
+}
+
+// DecisionCache the LRU cache of the enforcement decisions, all the decisions are invalidated whenever the policies change.
+// The decisions on the objects that have the conditional policies within the domain are not cached,
+// since they depend on the environment of the request
+type DecisionCache struct {
+	decisions *lru.Cache[string, bool]
+
+	mu         sync.Mutex
+	generation atomic.Uint64 // increased by every invalidation, the decision made across an invalidation is not cached
+}
+
+// NewDecisionCache the decisions are not cached if the caches are disabled
+func NewDecisionCache(conf config.CasbinCache) (*DecisionCache, error) {
+	decisions, err := NewCache[string, bool](conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DecisionCache{decisions: decisions}, nil
+}
+
+// Enforce returns the cached decision on the request, or enforces the request and caches the decision
+func (c *DecisionCache) Enforce(ef *casbin.SyncedEnforcer, sub, dom, obj string, env Environment) (bool, error) {
+	if c.decisions == nil || hasConditions(ef, dom, obj) {
+		return ef.Enforce(sub, dom, obj, env)
+	}
+
+	key := strings.Join([]string{sub, dom, obj}, "\x00")
+	if allowed, ok := c.decisions.Get(key); ok {
+		return allowed, nil
+	}
+
+	generation := c.generation.Load()
+
+	allowed, err := ef.Enforce(sub, dom, obj, env)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	if c.generation.Load() == generation {
+		c.decisions.Add(key, allowed)
+	}
+	c.mu.Unlock()
+
+	return allowed, nil
+}
+
+// Invalidate remove all the decisions
+func (c *DecisionCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation.Add(1)
+	c.decisions.Purge()
+}
+
+// Stats returns the statistics of the decisions
+func (c *DecisionCache) Stats() lru.Stats {
+	return c.decisions.Stats()
+}
+
+// hasConditions reports whether there are the conditional policies of the object within the domain,
+// there is none if the model does not define the conditions
+func hasConditions(ef *casbin.SyncedEnforcer, dom, obj string) bool {
+	rules, err := ef.GetFilteredNamedPolicy(ConditionPolicyType, 1, dom, obj)
+	return err == nil && len(rules) > 0
+}
+
+var (
+	_ persist.WatcherEx        = (*invalidatingWatcher)(nil)
+	_ persist.UpdatableWatcher = (*invalidatingWatcher)(nil)
+)
+
+// invalidatingWatcher is notified by the enforcer of the policy changes made through it,
+// it invalidates the decisions and passes the changes to the watcher of the peers if there is one
+type invalidatingWatcher struct {
+	next      *Watcher
+	decisions *DecisionCache
+}
+
+func (w *invalidatingWatcher) SetUpdateCallback(callback func(string)) error {
+	if w.next == nil {
+		return nil
+	}
+	return w.next.SetUpdateCallback(callback)
+}
+
+func (w *invalidatingWatcher) Update() error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.Update()
+}
+
+// Close the watcher of the peers is closed by ProvideWatcher
+func (w *invalidatingWatcher) Close() {}
+
+func (w *invalidatingWatcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForAddPolicy(sec, ptype, params...)
+}
+
+func (w *invalidatingWatcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForRemovePolicy(sec, ptype, params...)
+}
+
+func (w *invalidatingWatcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForRemoveFilteredPolicy(sec, ptype, fieldIndex, fieldValues...)
+}
+
+func (w *invalidatingWatcher) UpdateForSavePolicy(m model.Model) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForSavePolicy(m)
+}
+
+func (w *invalidatingWatcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForAddPolicies(sec, ptype, rules...)
+}
+
+func (w *invalidatingWatcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForRemovePolicies(sec, ptype, rules...)
+}
+
+func (w *invalidatingWatcher) UpdateForUpdatePolicy(sec string, ptype string, oldRule, newRule []string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForUpdatePolicy(sec, ptype, oldRule, newRule)
+}
+
+func (w *invalidatingWatcher) UpdateForUpdatePolicies(sec string, ptype string, oldRules, newRules [][]string) error {
+	w.decisions.Invalidate()
+	if w.next == nil {
+		return nil
+	}
+	return w.next.UpdateForUpdatePolicies(sec, ptype, oldRules, newRules)
+}
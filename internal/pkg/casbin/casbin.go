@@ -20,7 +20,7 @@ func New(
 	logger *slog.Logger,
 	gdb *gorm.DB,
 	sdb *sql.DB,
-) (*casbin.Enforcer, error) {
+) (*casbin.SyncedEnforcer, error) {
 	mod, err := model.New(conf.Model)
 	if err != nil {
 		return nil, err
@@ -31,10 +31,27 @@ func New(
 		return nil, err
 	}
 
-	ef, err := casbin.NewEnforcer(mod, adp)
+	// the policies may be saved before the tenant domains are introduced
+	legacy, err := loadLegacyPolicies(adp)
 	if err != nil {
 		return nil, err
 	}
+	if legacy != nil {
+		if err := upgradeLegacyPolicies(mod, adp, legacy); err != nil {
+			return nil, err
+		}
+		logger.Warn("the casbin policies are upgraded into the domain of the default tenant", slog.String("domain", DefaultPolicyDomain))
+	}
+
+	// the policies are changed by the watcher and the requests concurrently
+	ef, err := casbin.NewSyncedEnforcer(mod, adp)
+	if err != nil {
+		return nil, err
+	}
+
+	// the matcher functions are called within Enforce, which holds the lock of the enforcer already
+	ef.AddFunction(SuperAdminFunctionName, superAdminFunction(ef.Enforcer, conf.SuperAdmin))
+	ef.AddFunction(ConditionFunctionName, conditionFunction(ef.Enforcer))
 
 	return ef, nil
 }
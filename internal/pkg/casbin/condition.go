@@ -0,0 +1,271 @@
+package casbin
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ConditionFunctionName the name of the matcher function that reports whether the conditions of the policy are met
+	ConditionFunctionName = "meetsCondition"
+
+	// ConditionPolicyType the policy type of the conditions, "p2, sub, dom, obj, cond",
+	// the policy "p, sub, dom, obj" is only in effect when all of its conditions are met
+	ConditionPolicyType = "p2"
+)
+
+// ErrInvalidCondition the condition expression can not be parsed
+var ErrInvalidCondition = errors.New("invalid condition")
+
+// Environment the attributes of the request that the conditions are evaluated against
+type Environment struct {
+	Time       time.Time
+	IP         string
+	Attributes map[string]string // e.g. "method", "path", "header.x-device-id"
+}
+
+type environmentContextKey struct{}
+
+// WithEnvironment returns a copy of the context that carries the environment of the request
+func WithEnvironment(ctx context.Context, env Environment) context.Context {
+	return context.WithValue(ctx, environmentContextKey{}, env)
+}
+
+// EnvironmentFromContext returns the environment of the request, the time is now if the context does not carry it
+func EnvironmentFromContext(ctx context.Context) Environment {
+	env, _ := ctx.Value(environmentContextKey{}).(Environment)
+	if env.Time.IsZero() {
+		env.Time = time.Now()
+	}
+	return env
+}
+
+// Condition the parsed condition expression, the clauses are separated by spaces and all of them must be met,
+// the alternatives of a clause are separated by "|", e.g.
+//
+//	time=09:00-18:00 weekday=mon|tue|wed|thu|fri tz=Asia/Shanghai ip=10.0.0.0/8|192.168.1.10 attr.method=GET
+//
+// the expression can not contain commas or double quotes, which are not escaped by the adapters
+type Condition struct {
+	windows    []timeWindow
+	weekdays   []time.Weekday
+	location   *time.Location
+	networks   []*net.IPNet
+	attributes map[string][]string
+}
+
+// timeWindow the minutes of the day, the window crosses midnight if start is after end
+type timeWindow struct {
+	start, end int
+}
+
+func (w timeWindow) contains(t time.Time) bool {
+	m := t.Hour()*60 + t.Minute()
+	if w.start < w.end {
+		return m >= w.start && m < w.end
+	}
+	return m >= w.start || m < w.end
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseCondition parse the condition expression
+func ParseCondition(expr string) (*Condition, error) {
+	if strings.ContainsAny(expr, `,"`) {
+		return nil, errors.Wrap(ErrInvalidCondition, "commas and double quotes are not allowed")
+	}
+
+	c := &Condition{
+		location:   time.Local,
+		attributes: make(map[string][]string),
+	}
+
+	seen := make(map[string]struct{})
+	for _, clause := range strings.Fields(expr) {
+		key, value, ok := strings.Cut(clause, "=")
+		if !ok || key == "" || value == "" {
+			return nil, errors.Wrapf(ErrInvalidCondition, "malformed clause %q", clause)
+		}
+		if _, ok := seen[key]; ok {
+			return nil, errors.Wrapf(ErrInvalidCondition, "duplicate clause %q", key)
+		}
+		seen[key] = struct{}{}
+
+		if err := c.parseClause(key, value); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Condition) parseClause(key, value string) error {
+	alternatives := strings.Split(value, "|")
+
+	switch {
+	case key == "time":
+		for _, s := range alternatives {
+			w, err := parseTimeWindow(s)
+			if err != nil {
+				return err
+			}
+			c.windows = append(c.windows, w)
+		}
+	case key == "weekday":
+		for _, s := range alternatives {
+			d, ok := weekdays[strings.ToLower(s)]
+			if !ok {
+				return errors.Wrapf(ErrInvalidCondition, "unknown weekday %q", s)
+			}
+			c.weekdays = append(c.weekdays, d)
+		}
+	case key == "tz":
+		loc, err := time.LoadLocation(value)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidCondition, "unknown time zone %q", value)
+		}
+		c.location = loc
+	case key == "ip":
+		for _, s := range alternatives {
+			cidr := s
+			if !strings.Contains(s, "/") {
+				if ip := net.ParseIP(s); ip != nil && ip.To4() != nil {
+					cidr += "/32"
+				} else {
+					cidr += "/128"
+				}
+			}
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return errors.Wrapf(ErrInvalidCondition, "malformed ip %q", s)
+			}
+			c.networks = append(c.networks, network)
+		}
+	case strings.HasPrefix(key, "attr.") && len(key) > len("attr."):
+		c.attributes[strings.TrimPrefix(key, "attr.")] = alternatives
+	default:
+		return errors.Wrapf(ErrInvalidCondition, "unknown clause %q", key)
+	}
+
+	return nil
+}
+
+// parseTimeWindow parse the window formatted as "09:00-18:00"
+func parseTimeWindow(s string) (timeWindow, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return timeWindow{}, errors.Wrapf(ErrInvalidCondition, "malformed time window %q", s)
+	}
+
+	minutes := func(v string) (int, error) {
+		t, err := time.Parse("15:04", v)
+		if err != nil {
+			return 0, errors.Wrapf(ErrInvalidCondition, "malformed time %q", v)
+		}
+		return t.Hour()*60 + t.Minute(), nil
+	}
+
+	w := timeWindow{}
+	var err error
+	if w.start, err = minutes(start); err != nil {
+		return w, err
+	}
+	if w.end, err = minutes(end); err != nil {
+		return w, err
+	}
+	if w.start == w.end {
+		return w, errors.Wrapf(ErrInvalidCondition, "empty time window %q", s)
+	}
+
+	return w, nil
+}
+
+// Meets reports whether the environment meets all the clauses of the condition
+func (c *Condition) Meets(env Environment) bool {
+	t := env.Time.In(c.location)
+
+	if len(c.windows) > 0 && !slices.ContainsFunc(c.windows, func(w timeWindow) bool { return w.contains(t) }) {
+		return false
+	}
+
+	if len(c.weekdays) > 0 && !slices.Contains(c.weekdays, t.Weekday()) {
+		return false
+	}
+
+	if len(c.networks) > 0 {
+		ip := net.ParseIP(env.IP)
+		if ip == nil || !slices.ContainsFunc(c.networks, func(n *net.IPNet) bool { return n.Contains(ip) }) {
+			return false
+		}
+	}
+
+	for name, values := range c.attributes {
+		v, ok := env.Attributes[name]
+		if !ok || !slices.Contains(values, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// conditionFunction returns the matcher function meetsCondition(p.sub, p.dom, p.obj, r.env),
+// the policy without conditions is always in effect
+func conditionFunction(ef *casbin.Enforcer) func(args ...any) (any, error) {
+	// the parsed conditions keyed by the expression
+	var parsed sync.Map
+
+	return func(args ...any) (any, error) {
+		if len(args) != 4 {
+			return false, errors.Errorf("%s expects 4 arguments, got %d", ConditionFunctionName, len(args))
+		}
+
+		fields := make([]string, 0, 3)
+		for _, arg := range args[:3] {
+			s, ok := arg.(string)
+			if !ok {
+				return false, errors.Errorf("%s expects the string fields of the policy", ConditionFunctionName)
+			}
+			fields = append(fields, s)
+		}
+
+		env, ok := args[3].(Environment)
+		if !ok {
+			return false, errors.Errorf("%s expects an environment", ConditionFunctionName)
+		}
+
+		rules, err := ef.GetFilteredNamedPolicy(ConditionPolicyType, 0, fields...)
+		if err != nil {
+			return false, errors.WithStack(err)
+		}
+
+		for _, rule := range rules {
+			expr := rule[len(rule)-1]
+
+			v, ok := parsed.Load(expr)
+			if !ok {
+				c, err := ParseCondition(expr)
+				if err != nil {
+					return false, err
+				}
+				v, _ = parsed.LoadOrStore(expr, c)
+			}
+
+			if !v.(*Condition).Meets(env) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+}
@@ -0,0 +1,145 @@
+package casbin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "empty", expr: ""},
+		{name: "all clauses", expr: "time=09:00-18:00 weekday=mon|tue|wed|thu|fri tz=Asia/Shanghai ip=10.0.0.0/8|192.168.1.10 attr.method=GET"},
+		{name: "midnight crossing window", expr: "time=22:00-06:00"},
+		{name: "uppercase weekday", expr: "weekday=MON"},
+		{name: "ipv6 address", expr: "ip=::1|2001:db8::/32"},
+		{name: "comma", expr: "weekday=mon,tue", wantErr: true},
+		{name: "double quote", expr: `attr.method="GET"`, wantErr: true},
+		{name: "missing value", expr: "time=", wantErr: true},
+		{name: "missing key", expr: "=GET", wantErr: true},
+		{name: "missing equal sign", expr: "weekday", wantErr: true},
+		{name: "duplicate clause", expr: "weekday=mon weekday=tue", wantErr: true},
+		{name: "unknown clause", expr: "month=jan", wantErr: true},
+		{name: "empty attribute name", expr: "attr.=GET", wantErr: true},
+		{name: "unknown weekday", expr: "weekday=mon|funday", wantErr: true},
+		{name: "unknown time zone", expr: "tz=Mars/Olympus", wantErr: true},
+		{name: "malformed ip", expr: "ip=10.0.0.256", wantErr: true},
+		{name: "malformed cidr", expr: "ip=10.0.0.0/33", wantErr: true},
+		{name: "malformed time window", expr: "time=09:00", wantErr: true},
+		{name: "malformed time", expr: "time=09:00-24:00", wantErr: true},
+		{name: "empty time window", expr: "time=09:00-09:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCondition(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCondition(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidCondition) {
+				t.Fatalf("ParseCondition(%q) error = %v, want ErrInvalidCondition", tt.expr, err)
+			}
+		})
+	}
+}
+
+func TestConditionMeets(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2026-10-19 is a Monday
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 10, 19, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		env  Environment
+		want bool
+	}{
+		{name: "empty condition", expr: "", env: Environment{Time: at(3, 0)}, want: true},
+
+		{name: "within window", expr: "time=09:00-18:00 tz=UTC", env: Environment{Time: at(9, 0)}, want: true},
+		{name: "end of window is excluded", expr: "time=09:00-18:00 tz=UTC", env: Environment{Time: at(18, 0)}, want: false},
+		{name: "before window", expr: "time=09:00-18:00 tz=UTC", env: Environment{Time: at(8, 59)}, want: false},
+		{name: "second window", expr: "time=09:00-12:00|13:00-18:00 tz=UTC", env: Environment{Time: at(13, 30)}, want: true},
+		{name: "between windows", expr: "time=09:00-12:00|13:00-18:00 tz=UTC", env: Environment{Time: at(12, 30)}, want: false},
+
+		{name: "midnight crossing before midnight", expr: "time=22:00-06:00 tz=UTC", env: Environment{Time: at(23, 30)}, want: true},
+		{name: "midnight crossing after midnight", expr: "time=22:00-06:00 tz=UTC", env: Environment{Time: at(0, 0)}, want: true},
+		{name: "midnight crossing early morning", expr: "time=22:00-06:00 tz=UTC", env: Environment{Time: at(5, 59)}, want: true},
+		{name: "midnight crossing end is excluded", expr: "time=22:00-06:00 tz=UTC", env: Environment{Time: at(6, 0)}, want: false},
+		{name: "midnight crossing daytime", expr: "time=22:00-06:00 tz=UTC", env: Environment{Time: at(12, 0)}, want: false},
+
+		{name: "window in time zone", expr: "time=09:00-18:00 tz=Asia/Shanghai", env: Environment{Time: at(2, 0)}, want: true},
+		{name: "window out of time zone", expr: "time=09:00-18:00 tz=Asia/Shanghai", env: Environment{Time: at(12, 0)}, want: false},
+		{name: "time of environment in another zone", expr: "time=09:00-18:00 tz=UTC", env: Environment{Time: at(10, 0).In(shanghai)}, want: true},
+
+		{name: "weekday", expr: "weekday=mon|tue tz=UTC", env: Environment{Time: at(12, 0)}, want: true},
+		{name: "other weekday", expr: "weekday=sat|sun tz=UTC", env: Environment{Time: at(12, 0)}, want: false},
+		{name: "weekday in time zone", expr: "weekday=tue tz=Asia/Shanghai", env: Environment{Time: at(20, 0)}, want: true},
+
+		{name: "ip in network", expr: "ip=10.0.0.0/8", env: Environment{IP: "10.1.2.3"}, want: true},
+		{name: "ip out of network", expr: "ip=10.0.0.0/8", env: Environment{IP: "11.1.2.3"}, want: false},
+		{name: "single ip", expr: "ip=10.0.0.0/8|192.168.1.10", env: Environment{IP: "192.168.1.10"}, want: true},
+		{name: "other single ip", expr: "ip=192.168.1.10", env: Environment{IP: "192.168.1.11"}, want: false},
+		{name: "ipv6", expr: "ip=2001:db8::/32", env: Environment{IP: "2001:db8::1"}, want: true},
+		{name: "missing ip", expr: "ip=10.0.0.0/8", env: Environment{}, want: false},
+		{name: "malformed ip", expr: "ip=10.0.0.0/8", env: Environment{IP: "10.1.2.3:8080"}, want: false},
+
+		{name: "attribute", expr: "attr.method=GET|HEAD", env: Environment{Attributes: map[string]string{"method": "HEAD"}}, want: true},
+		{name: "other attribute value", expr: "attr.method=GET", env: Environment{Attributes: map[string]string{"method": "POST"}}, want: false},
+		{name: "missing attribute", expr: "attr.header.x-device-id=a1", env: Environment{}, want: false},
+
+		{
+			name: "all clauses met",
+			expr: "time=09:00-18:00 weekday=mon tz=UTC ip=10.0.0.0/8 attr.method=GET",
+			env:  Environment{Time: at(10, 0), IP: "10.0.0.1", Attributes: map[string]string{"method": "GET"}},
+			want: true,
+		},
+		{
+			name: "one clause unmet",
+			expr: "time=09:00-18:00 weekday=mon tz=UTC ip=10.0.0.0/8 attr.method=GET",
+			env:  Environment{Time: at(10, 0), IP: "10.0.0.1", Attributes: map[string]string{"method": "POST"}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCondition(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCondition(%q) error = %v", tt.expr, err)
+			}
+			if got := c.Meets(tt.env); got != tt.want {
+				t.Fatalf("Meets(%+v) = %v, want %v", tt.env, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvironmentFromContext(t *testing.T) {
+	env := EnvironmentFromContext(context.Background())
+	if env.Time.IsZero() {
+		t.Fatal("the time of the environment is zero, want now")
+	}
+
+	want := Environment{
+		Time:       time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+		IP:         "10.0.0.1",
+		Attributes: map[string]string{"method": "GET"},
+	}
+	got := EnvironmentFromContext(WithEnvironment(context.Background(), want))
+	if !got.Time.Equal(want.Time) || got.IP != want.IP || got.Attributes["method"] != "GET" {
+		t.Fatalf("EnvironmentFromContext() = %+v, want %+v", got, want)
+	}
+}
@@ -9,21 +9,57 @@ import (
 
 	"go-scaffold/internal/config"
 	"go-scaffold/internal/pkg/db"
+	"go-scaffold/internal/pkg/redis"
 )
 
 // Provide casbin
 func Provide(
-	ctx context.Context,
 	env config.Env,
 	conf config.Casbin,
 	dbConf config.DefaultDatabase,
 	logger *slog.Logger,
 	gdb *gorm.DB,
-) (*casbin.Enforcer, error) {
-	sdb, err := db.New(ctx, dbConf.DatabaseConn)
+	sdb *db.DefaultDB,
+	watcher *Watcher,
+	decisions *DecisionCache,
+) (*casbin.SyncedEnforcer, error) {
+	ef, err := New(env, conf, dbConf.DatabaseConn, logger, gdb, sdb.DB)
 	if err != nil {
 		return nil, err
 	}
 
-	return New(env, conf, dbConf.DatabaseConn, logger, gdb, sdb)
+	// the decisions are invalidated by the policy changes made through the enforcer
+	if err := ef.SetWatcher(&invalidatingWatcher{next: watcher, decisions: decisions}); err != nil {
+		return nil, err
+	}
+
+	if watcher == nil {
+		return ef, nil
+	}
+
+	// the callback of the persist.WatcherEx is not set by the enforcer
+	if err := watcher.SetUpdateCallback(newWatcherCallback(ef, decisions, logger)); err != nil {
+		return nil, err
+	}
+
+	return ef, nil
+}
+
+// ProvideDecisionCache the decisions are not cached if the caches are disabled
+func ProvideDecisionCache(conf config.Casbin) (*DecisionCache, error) {
+	return NewDecisionCache(conf.Cache)
+}
+
+// ProvideWatcher the watcher is nil if it is not configured
+func ProvideWatcher(ctx context.Context, conf config.Casbin, logger *slog.Logger, rdb *redis.DefaultRedis) (*Watcher, func(), error) {
+	if conf.Watcher == nil {
+		return nil, func() {}, nil
+	}
+
+	watcher, err := NewWatcher(ctx, conf.Watcher.Channel, logger, rdb)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return watcher, watcher.Close, nil
 }
@@ -0,0 +1,61 @@
+package casbin
+
+import (
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/config"
+)
+
+// SuperAdminFunctionName the name of the matcher function that reports whether the subject is a super admin
+const SuperAdminFunctionName = "isSuperAdmin"
+
+// superAdminFunction returns the matcher function isSuperAdmin(r.sub, r.dom),
+// the subject is a super admin of all the domains if it is one of the users,
+// or a super admin of the domain if it has one of the roles within the domain,
+// the subjects are formatted as the policy subjects of the repository, e.g. "user_1" and "role_1"
+func superAdminFunction(ef *casbin.Enforcer, conf config.CasbinSuperAdmin) func(args ...any) (any, error) {
+	users := make(map[string]struct{}, len(conf.Users))
+	for _, id := range conf.Users {
+		users[fmt.Sprintf("user_%d", id)] = struct{}{}
+	}
+
+	roles := make([]string, 0, len(conf.Roles))
+	for _, id := range conf.Roles {
+		roles = append(roles, fmt.Sprintf("role_%d", id))
+	}
+
+	return func(args ...any) (any, error) {
+		if len(args) != 2 {
+			return false, errors.Errorf("%s expects 2 arguments, got %d", SuperAdminFunctionName, len(args))
+		}
+
+		sub, ok := args[0].(string)
+		if !ok {
+			return false, errors.Errorf("%s expects a string subject", SuperAdminFunctionName)
+		}
+
+		dom, ok := args[1].(string)
+		if !ok {
+			return false, errors.Errorf("%s expects a string domain", SuperAdminFunctionName)
+		}
+
+		if _, ok := users[sub]; ok {
+			return true, nil
+		}
+
+		for _, role := range roles {
+			ok, err := ef.GetRoleManager().HasLink(sub, role, dom)
+			if err != nil {
+				return false, errors.WithStack(err)
+			}
+			if ok {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+}
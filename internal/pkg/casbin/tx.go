@@ -0,0 +1,37 @@
+package casbin
+
+import (
+	"context"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/casbin/casbin/v2"
+	entadapter "github.com/casbin/ent-adapter"
+
+	"go-scaffold/internal/pkg/casbin/adapter"
+)
+
+// NewTxEnforcer build an enforcer that writes the policy changes in the transaction,
+// it works on a copy of the model of ef, so the changes are not seen by ef until it reloads the policy,
+// ok is false if the policies of ef are not stored by the ent adapter, which shares the database of the transaction
+func NewTxEnforcer(ctx context.Context, ef *casbin.SyncedEnforcer, driver string, tx entsql.ExecQuerier) (tef *casbin.Enforcer, ok bool, err error) {
+	if _, ok := ef.GetAdapter().(*entadapter.Adapter); !ok {
+		return nil, false, nil
+	}
+
+	ef.GetLock().RLock()
+	mod := ef.GetModel().Copy()
+	ef.GetLock().RUnlock()
+
+	tef, err = casbin.NewEnforcer(mod)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tef.SetAdapter(adapter.NewEntTxAdapter(ctx, driver, tx))
+
+	if err := tef.BuildRoleLinks(); err != nil {
+		return nil, false, err
+	}
+
+	return tef, true, nil
+}
@@ -0,0 +1,130 @@
+package casbin
+
+import (
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/pkg/errors"
+)
+
+// DefaultPolicyDomain the domain of the default tenant,
+// the policies saved before the tenant domains are introduced are upgraded into it
+const DefaultPolicyDomain = "tenant_1"
+
+// legacyRuleSize the field count of the rules "p, sub, obj" and "g, user, role" saved by the legacy model
+const legacyRuleSize = 2
+
+// legacyModel the model before the tenant domains are introduced, it is only used to load the legacy policies,
+// the "g" rules of the domains and the "p2" rules are loaded as well, so that they are kept by the upgrade
+const legacyModel = `
+[request_definition]
+r = sub, obj
+
+[policy_definition]
+p = sub, obj
+p2 = sub, dom, obj, cond
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub) && r.obj == p.obj
+`
+
+// loadLegacyPolicies returns the policies loaded by the legacy model if any "p" or "g" rule is saved by it,
+// the rules are told by the field counts, nil is returned if all the rules are saved by the current model.
+// some adapters skip the rules that do not fit the model instead of failing,
+// so the legacy rules are never told by loading the policies with the current model
+func loadLegacyPolicies(adp persist.Adapter) (model.Model, error) {
+	legacy, err := model.NewModelFromString(legacyModel)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// the adapters that fail on the rules that do not fit the model fail on the current rules here,
+	// any error of the current rules is reported when the enforcer loads them
+	if err := adp.LoadPolicy(legacy); err != nil {
+		return nil, nil
+	}
+
+	if !hasLegacyRules(legacy) {
+		return nil, nil
+	}
+
+	return legacy, nil
+}
+
+// hasLegacyRules reports whether any "p" or "g" rule has the field count of the legacy model
+func hasLegacyRules(m model.Model) bool {
+	for _, sec := range []string{"p", "g"} {
+		for _, rule := range m[sec][sec].Policy {
+			if len(rule) == legacyRuleSize {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// upgradeLegacyPolicies rewrite the policies "p, sub, obj" and "g, user, role" loaded by the legacy model
+// into "p, sub, dom, obj" and "g, user, role, dom" of the default tenant,
+// the rules that are already saved by the current model are kept
+func upgradeLegacyPolicies(mod model.Model, adp persist.Adapter, legacy model.Model) error {
+	upgraded := mod.Copy()
+	upgraded.ClearPolicy()
+
+	// the current rules skipped by the legacy model, e.g. "p, sub, dom, obj"
+	current := mod.Copy()
+	current.ClearPolicy()
+	if err := adp.LoadPolicy(current); err == nil {
+		for _, sec := range []string{"p", "g"} {
+			for ptype, ast := range current[sec] {
+				for _, rule := range ast.Policy {
+					if err := addUpgradedRule(upgraded, sec, ptype, rule); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	for _, rule := range legacy["p"]["p"].Policy {
+		if len(rule) == legacyRuleSize {
+			rule = []string{rule[0], DefaultPolicyDomain, rule[1]}
+		}
+		if err := addUpgradedRule(upgraded, "p", "p", rule); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range legacy["p"]["p2"].Policy {
+		if err := addUpgradedRule(upgraded, "p", "p2", rule); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range legacy["g"]["g"].Policy {
+		if len(rule) == legacyRuleSize {
+			rule = []string{rule[0], rule[1], DefaultPolicyDomain}
+		}
+		if err := addUpgradedRule(upgraded, "g", "g", rule); err != nil {
+			return err
+		}
+	}
+
+	return errors.WithStack(adp.SavePolicy(upgraded))
+}
+
+// addUpgradedRule add the rule that is not added yet
+func addUpgradedRule(m model.Model, sec, ptype string, rule []string) error {
+	ok, err := m.HasPolicyEx(sec, ptype, rule)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if ok {
+		return nil
+	}
+	return errors.WithStack(m.AddPolicy(sec, ptype, rule))
+}
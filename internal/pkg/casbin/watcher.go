@@ -0,0 +1,275 @@
+package casbin
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// DefaultWatcherChannel the redis channel that the policy changes are broadcast to
+const DefaultWatcherChannel = "casbin:policy"
+
+var (
+	_ persist.WatcherEx        = (*Watcher)(nil)
+	_ persist.UpdatableWatcher = (*Watcher)(nil)
+)
+
+// the methods of the watcher messages
+const (
+	watcherMethodUpdate               = "Update"
+	watcherMethodAddPolicies          = "AddPolicies"
+	watcherMethodRemovePolicies       = "RemovePolicies"
+	watcherMethodRemoveFilteredPolicy = "RemoveFilteredPolicy"
+	watcherMethodUpdatePolicies       = "UpdatePolicies"
+	watcherMethodSavePolicy           = "SavePolicy"
+)
+
+// watcherMessage the policy change that is broadcast to the peers
+type watcherMessage struct {
+	ID          string     `json:"id"` // the replica that makes the change
+	Method      string     `json:"method"`
+	Sec         string     `json:"sec,omitempty"`
+	Ptype       string     `json:"ptype,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+	NewRules    [][]string `json:"newRules,omitempty"` // the rules that replace Rules, for watcherMethodUpdatePolicies
+	FieldIndex  int        `json:"fieldIndex,omitempty"`
+	FieldValues []string   `json:"fieldValues,omitempty"`
+}
+
+// Watcher synchronize the policy changes among the replicas through the redis pub/sub,
+// the peers apply the changes to their models incrementally without persisting them,
+// since the changes have been persisted by the replica that makes them
+type Watcher struct {
+	id      string
+	channel string
+	logger  *slog.Logger
+	rdb     *redis.Client
+	pubsub  *redis.PubSub
+
+	mu       sync.RWMutex
+	callback func(string)
+}
+
+// NewWatcher subscribe the channel, the messages are dispatched to the callback set by SetUpdateCallback
+func NewWatcher(ctx context.Context, channel string, logger *slog.Logger, rdb *redis.Client) (*Watcher, error) {
+	if channel == "" {
+		channel = DefaultWatcherChannel
+	}
+
+	pubsub := rdb.Subscribe(ctx, channel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, errors.WithStack(err)
+	}
+
+	w := &Watcher{
+		id:      uuid.New().String(),
+		channel: channel,
+		logger:  logger,
+		rdb:     rdb,
+		pubsub:  pubsub,
+	}
+
+	go w.subscribe()
+
+	return w, nil
+}
+
+func (w *Watcher) subscribe() {
+	for msg := range w.pubsub.Channel() {
+		var m watcherMessage
+		if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+			w.logger.Error("decode casbin watcher message error", slog.Any("error", err))
+			continue
+		}
+
+		if m.ID == w.id {
+			continue
+		}
+
+		w.mu.RLock()
+		callback := w.callback
+		w.mu.RUnlock()
+
+		if callback != nil {
+			callback(msg.Payload)
+		}
+	}
+}
+
+// SetUpdateCallback the callback is called with the message published by the peers
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.callback = callback
+	return nil
+}
+
+// Update the peers reload the whole policy
+func (w *Watcher) Update() error {
+	return w.publish(watcherMessage{Method: watcherMethodUpdate})
+}
+
+func (w *Watcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForAddPolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.UpdateForRemovePolicies(sec, ptype, params)
+}
+
+func (w *Watcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.publish(watcherMessage{
+		Method:      watcherMethodRemoveFilteredPolicy,
+		Sec:         sec,
+		Ptype:       ptype,
+		FieldIndex:  fieldIndex,
+		FieldValues: fieldValues,
+	})
+}
+
+// UpdateForSavePolicy the peers reload the whole policy
+func (w *Watcher) UpdateForSavePolicy(model.Model) error {
+	return w.publish(watcherMessage{Method: watcherMethodSavePolicy})
+}
+
+func (w *Watcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(watcherMessage{
+		Method: watcherMethodAddPolicies,
+		Sec:    sec,
+		Ptype:  ptype,
+		Rules:  rules,
+	})
+}
+
+func (w *Watcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(watcherMessage{
+		Method: watcherMethodRemovePolicies,
+		Sec:    sec,
+		Ptype:  ptype,
+		Rules:  rules,
+	})
+}
+
+func (w *Watcher) UpdateForUpdatePolicy(sec string, ptype string, oldRule, newRule []string) error {
+	return w.UpdateForUpdatePolicies(sec, ptype, [][]string{oldRule}, [][]string{newRule})
+}
+
+func (w *Watcher) UpdateForUpdatePolicies(sec string, ptype string, oldRules, newRules [][]string) error {
+	return w.publish(watcherMessage{
+		Method:   watcherMethodUpdatePolicies,
+		Sec:      sec,
+		Ptype:    ptype,
+		Rules:    oldRules,
+		NewRules: newRules,
+	})
+}
+
+// Close stop receiving the messages of the peers
+func (w *Watcher) Close() {
+	if err := w.pubsub.Close(); err != nil {
+		w.logger.Error("close casbin watcher error", slog.Any("error", err))
+	}
+}
+
+// publish broadcast the change to the peers, the failure is logged
+// rather than failing the change, which has been persisted already
+func (w *Watcher) publish(m watcherMessage) error {
+	m.ID = w.id
+
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := w.rdb.Publish(context.Background(), w.channel, payload).Err(); err != nil {
+		w.logger.Error("publish casbin policy change error", slog.String("method", m.Method), slog.Any("error", err))
+	}
+
+	return nil
+}
+
+// newWatcherCallback apply the changes of the peers to the enforcer,
+// the whole policy is reloaded if the change can not be applied incrementally
+func newWatcherCallback(ef *casbin.SyncedEnforcer, decisions *DecisionCache, logger *slog.Logger) func(string) {
+	return func(payload string) {
+		var m watcherMessage
+		if err := json.Unmarshal([]byte(payload), &m); err != nil {
+			logger.Error("decode casbin watcher message error", slog.Any("error", err))
+			return
+		}
+
+		// the change is applied to the model directly, the enforcer does not notify the watcher
+		defer decisions.Invalidate()
+
+		if err := applyWatcherMessageLocked(ef, m); err != nil {
+			logger.Warn("apply casbin policy change error, reload the policy", slog.String("method", m.Method), slog.Any("error", err))
+
+			if err := ef.LoadPolicy(); err != nil {
+				logger.Error("reload casbin policy error", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// applyWatcherMessageLocked the model is changed under the lock of the enforcer, so that it is not enforced halfway
+func applyWatcherMessageLocked(ef *casbin.SyncedEnforcer, m watcherMessage) error {
+	ef.GetLock().Lock()
+	defer ef.GetLock().Unlock()
+
+	return applyWatcherMessage(ef.Enforcer, m)
+}
+
+func applyWatcherMessage(ef *casbin.Enforcer, m watcherMessage) error {
+	mod := ef.GetModel()
+
+	switch m.Method {
+	case watcherMethodAddPolicies:
+		affected, err := mod.AddPoliciesWithAffected(m.Sec, m.Ptype, m.Rules)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return buildIncrementalRoleLinks(ef, m.Sec, model.PolicyAdd, m.Ptype, affected)
+	case watcherMethodRemovePolicies:
+		affected, err := mod.RemovePoliciesWithAffected(m.Sec, m.Ptype, m.Rules)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return buildIncrementalRoleLinks(ef, m.Sec, model.PolicyRemove, m.Ptype, affected)
+	case watcherMethodRemoveFilteredPolicy:
+		_, affected, err := mod.RemoveFilteredPolicy(m.Sec, m.Ptype, m.FieldIndex, m.FieldValues...)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		return buildIncrementalRoleLinks(ef, m.Sec, model.PolicyRemove, m.Ptype, affected)
+	case watcherMethodUpdatePolicies:
+		if _, err := mod.UpdatePolicies(m.Sec, m.Ptype, m.Rules, m.NewRules); err != nil {
+			return errors.WithStack(err)
+		}
+		if err := buildIncrementalRoleLinks(ef, m.Sec, model.PolicyRemove, m.Ptype, m.Rules); err != nil {
+			return err
+		}
+		return buildIncrementalRoleLinks(ef, m.Sec, model.PolicyAdd, m.Ptype, m.NewRules)
+	case watcherMethodUpdate, watcherMethodSavePolicy:
+		return errors.WithStack(ef.LoadPolicy())
+	default:
+		return errors.Errorf("unknown casbin watcher method %q", m.Method)
+	}
+}
+
+// buildIncrementalRoleLinks the role links are maintained for the grouping policies only
+func buildIncrementalRoleLinks(ef *casbin.Enforcer, sec string, op model.PolicyOp, ptype string, rules [][]string) error {
+	if sec != "g" || len(rules) == 0 {
+		return nil
+	}
+	return errors.WithStack(ef.BuildIncrementalRoleLinks(op, ptype, rules))
+}
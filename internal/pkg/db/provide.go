@@ -7,6 +7,13 @@ import (
 	"go-scaffold/internal/config"
 )
 
+// DefaultDB the connection of the default database,
+// it is shared by the ent client and the casbin adapter,
+// so that their writes can be made in the same transaction
+type DefaultDB struct {
+	*sql.DB
+}
+
 // Provide database connection
 func Provide(ctx context.Context, conf config.DatabaseConn) (db *sql.DB, cleanup func(), err error) {
 	db, err = New(ctx, conf)
@@ -22,3 +29,13 @@ func Provide(ctx context.Context, conf config.DatabaseConn) (db *sql.DB, cleanup
 
 	return
 }
+
+// ProvideDefault default database connection
+func ProvideDefault(ctx context.Context, conf config.DefaultDatabase) (*DefaultDB, func(), error) {
+	db, cleanup, err := Provide(ctx, conf.DatabaseConn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &DefaultDB{db}, cleanup, nil
+}
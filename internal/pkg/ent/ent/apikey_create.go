@@ -0,0 +1,441 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/apikey"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyCreate is the builder for creating a APIKey entity.
+type APIKeyCreate struct {
+	config
+	mutation *APIKeyMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (akc *APIKeyCreate) SetCreatedAt(tt types.UnixTimestamp) *APIKeyCreate {
+	akc.mutation.SetCreatedAt(tt)
+	return akc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableCreatedAt(tt *types.UnixTimestamp) *APIKeyCreate {
+	if tt != nil {
+		akc.SetCreatedAt(*tt)
+	}
+	return akc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (akc *APIKeyCreate) SetUpdatedAt(tt types.UnixTimestamp) *APIKeyCreate {
+	akc.mutation.SetUpdatedAt(tt)
+	return akc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableUpdatedAt(tt *types.UnixTimestamp) *APIKeyCreate {
+	if tt != nil {
+		akc.SetUpdatedAt(*tt)
+	}
+	return akc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (akc *APIKeyCreate) SetDeletedAt(tt types.UnixTimestamp) *APIKeyCreate {
+	akc.mutation.SetDeletedAt(tt)
+	return akc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableDeletedAt(tt *types.UnixTimestamp) *APIKeyCreate {
+	if tt != nil {
+		akc.SetDeletedAt(*tt)
+	}
+	return akc
+}
+
+// SetUserID sets the "user_id" field.
+func (akc *APIKeyCreate) SetUserID(i int64) *APIKeyCreate {
+	akc.mutation.SetUserID(i)
+	return akc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableUserID(i *int64) *APIKeyCreate {
+	if i != nil {
+		akc.SetUserID(*i)
+	}
+	return akc
+}
+
+// SetName sets the "name" field.
+func (akc *APIKeyCreate) SetName(s string) *APIKeyCreate {
+	akc.mutation.SetName(s)
+	return akc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableName(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetName(*s)
+	}
+	return akc
+}
+
+// SetPrefix sets the "prefix" field.
+func (akc *APIKeyCreate) SetPrefix(s string) *APIKeyCreate {
+	akc.mutation.SetPrefix(s)
+	return akc
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillablePrefix(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetPrefix(*s)
+	}
+	return akc
+}
+
+// SetHash sets the "hash" field.
+func (akc *APIKeyCreate) SetHash(s string) *APIKeyCreate {
+	akc.mutation.SetHash(s)
+	return akc
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableHash(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetHash(*s)
+	}
+	return akc
+}
+
+// SetScopes sets the "scopes" field.
+func (akc *APIKeyCreate) SetScopes(s string) *APIKeyCreate {
+	akc.mutation.SetScopes(s)
+	return akc
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableScopes(s *string) *APIKeyCreate {
+	if s != nil {
+		akc.SetScopes(*s)
+	}
+	return akc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akc *APIKeyCreate) SetExpiresAt(i int64) *APIKeyCreate {
+	akc.mutation.SetExpiresAt(i)
+	return akc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableExpiresAt(i *int64) *APIKeyCreate {
+	if i != nil {
+		akc.SetExpiresAt(*i)
+	}
+	return akc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akc *APIKeyCreate) SetLastUsedAt(i int64) *APIKeyCreate {
+	akc.mutation.SetLastUsedAt(i)
+	return akc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akc *APIKeyCreate) SetNillableLastUsedAt(i *int64) *APIKeyCreate {
+	if i != nil {
+		akc.SetLastUsedAt(*i)
+	}
+	return akc
+}
+
+// SetID sets the "id" field.
+func (akc *APIKeyCreate) SetID(i int64) *APIKeyCreate {
+	akc.mutation.SetID(i)
+	return akc
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (akc *APIKeyCreate) Mutation() *APIKeyMutation {
+	return akc.mutation
+}
+
+// Save creates the APIKey in the database.
+func (akc *APIKeyCreate) Save(ctx context.Context) (*APIKey, error) {
+	if err := akc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, akc.sqlSave, akc.mutation, akc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (akc *APIKeyCreate) SaveX(ctx context.Context) *APIKey {
+	v, err := akc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akc *APIKeyCreate) Exec(ctx context.Context) error {
+	_, err := akc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akc *APIKeyCreate) ExecX(ctx context.Context) {
+	if err := akc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akc *APIKeyCreate) defaults() error {
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		if apikey.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized apikey.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := apikey.DefaultCreatedAt()
+		akc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := akc.mutation.UpdatedAt(); !ok {
+		if apikey.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized apikey.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := apikey.DefaultUpdatedAt()
+		akc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := akc.mutation.UserID(); !ok {
+		v := apikey.DefaultUserID
+		akc.mutation.SetUserID(v)
+	}
+	if _, ok := akc.mutation.Name(); !ok {
+		v := apikey.DefaultName
+		akc.mutation.SetName(v)
+	}
+	if _, ok := akc.mutation.Prefix(); !ok {
+		v := apikey.DefaultPrefix
+		akc.mutation.SetPrefix(v)
+	}
+	if _, ok := akc.mutation.Hash(); !ok {
+		v := apikey.DefaultHash
+		akc.mutation.SetHash(v)
+	}
+	if _, ok := akc.mutation.Scopes(); !ok {
+		v := apikey.DefaultScopes
+		akc.mutation.SetScopes(v)
+	}
+	if _, ok := akc.mutation.ExpiresAt(); !ok {
+		v := apikey.DefaultExpiresAt
+		akc.mutation.SetExpiresAt(v)
+	}
+	if _, ok := akc.mutation.LastUsedAt(); !ok {
+		v := apikey.DefaultLastUsedAt
+		akc.mutation.SetLastUsedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akc *APIKeyCreate) check() error {
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "APIKey.created_at"`)}
+	}
+	if _, ok := akc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "APIKey.updated_at"`)}
+	}
+	if _, ok := akc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "APIKey.user_id"`)}
+	}
+	if _, ok := akc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "APIKey.name"`)}
+	}
+	if _, ok := akc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "APIKey.prefix"`)}
+	}
+	if _, ok := akc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "APIKey.hash"`)}
+	}
+	if _, ok := akc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "APIKey.scopes"`)}
+	}
+	if _, ok := akc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "APIKey.expires_at"`)}
+	}
+	if _, ok := akc.mutation.LastUsedAt(); !ok {
+		return &ValidationError{Name: "last_used_at", err: errors.New(`ent: missing required field "APIKey.last_used_at"`)}
+	}
+	return nil
+}
+
+func (akc *APIKeyCreate) sqlSave(ctx context.Context) (*APIKey, error) {
+	if err := akc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := akc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, akc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	akc.mutation.id = &_node.ID
+	akc.mutation.done = true
+	return _node, nil
+}
+
+func (akc *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &APIKey{config: akc.config}
+		_spec = sqlgraph.NewCreateSpec(apikey.Table, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt64))
+	)
+	if id, ok := akc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := akc.mutation.CreatedAt(); ok {
+		_spec.SetField(apikey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := akc.mutation.UpdatedAt(); ok {
+		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := akc.mutation.DeletedAt(); ok {
+		_spec.SetField(apikey.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	if value, ok := akc.mutation.UserID(); ok {
+		_spec.SetField(apikey.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := akc.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := akc.mutation.Prefix(); ok {
+		_spec.SetField(apikey.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := akc.mutation.Hash(); ok {
+		_spec.SetField(apikey.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := akc.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeString, value)
+		_node.Scopes = value
+	}
+	if value, ok := akc.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := akc.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeInt64, value)
+		_node.LastUsedAt = value
+	}
+	return _node, _spec
+}
+
+// APIKeyCreateBulk is the builder for creating many APIKey entities in bulk.
+type APIKeyCreateBulk struct {
+	config
+	err      error
+	builders []*APIKeyCreate
+}
+
+// Save creates the APIKey entities in the database.
+func (akcb *APIKeyCreateBulk) Save(ctx context.Context) ([]*APIKey, error) {
+	if akcb.err != nil {
+		return nil, akcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(akcb.builders))
+	nodes := make([]*APIKey, len(akcb.builders))
+	mutators := make([]Mutator, len(akcb.builders))
+	for i := range akcb.builders {
+		func(i int, root context.Context) {
+			builder := akcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*APIKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, akcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, akcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, akcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akcb *APIKeyCreateBulk) SaveX(ctx context.Context) []*APIKey {
+	v, err := akcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akcb *APIKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := akcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akcb *APIKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := akcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/apikey"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKeyUpdate is the builder for updating APIKey entities.
+type APIKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *APIKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (aku *APIKeyUpdate) Where(ps ...predicate.APIKey) *APIKeyUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aku *APIKeyUpdate) SetDeletedAt(tt types.UnixTimestamp) *APIKeyUpdate {
+	aku.mutation.SetDeletedAt(tt)
+	return aku
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableDeletedAt(tt *types.UnixTimestamp) *APIKeyUpdate {
+	if tt != nil {
+		aku.SetDeletedAt(*tt)
+	}
+	return aku
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aku *APIKeyUpdate) ClearDeletedAt() *APIKeyUpdate {
+	aku.mutation.ClearDeletedAt()
+	return aku
+}
+
+// SetUserID sets the "user_id" field.
+func (aku *APIKeyUpdate) SetUserID(i int64) *APIKeyUpdate {
+	aku.mutation.ResetUserID()
+	aku.mutation.SetUserID(i)
+	return aku
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableUserID(i *int64) *APIKeyUpdate {
+	if i != nil {
+		aku.SetUserID(*i)
+	}
+	return aku
+}
+
+// AddUserID adds i to the "user_id" field.
+func (aku *APIKeyUpdate) AddUserID(i int64) *APIKeyUpdate {
+	aku.mutation.AddUserID(i)
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *APIKeyUpdate) SetName(s string) *APIKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableName(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetName(*s)
+	}
+	return aku
+}
+
+// SetPrefix sets the "prefix" field.
+func (aku *APIKeyUpdate) SetPrefix(s string) *APIKeyUpdate {
+	aku.mutation.SetPrefix(s)
+	return aku
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillablePrefix(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetPrefix(*s)
+	}
+	return aku
+}
+
+// SetHash sets the "hash" field.
+func (aku *APIKeyUpdate) SetHash(s string) *APIKeyUpdate {
+	aku.mutation.SetHash(s)
+	return aku
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableHash(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetHash(*s)
+	}
+	return aku
+}
+
+// SetScopes sets the "scopes" field.
+func (aku *APIKeyUpdate) SetScopes(s string) *APIKeyUpdate {
+	aku.mutation.SetScopes(s)
+	return aku
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableScopes(s *string) *APIKeyUpdate {
+	if s != nil {
+		aku.SetScopes(*s)
+	}
+	return aku
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (aku *APIKeyUpdate) SetExpiresAt(i int64) *APIKeyUpdate {
+	aku.mutation.ResetExpiresAt()
+	aku.mutation.SetExpiresAt(i)
+	return aku
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableExpiresAt(i *int64) *APIKeyUpdate {
+	if i != nil {
+		aku.SetExpiresAt(*i)
+	}
+	return aku
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (aku *APIKeyUpdate) AddExpiresAt(i int64) *APIKeyUpdate {
+	aku.mutation.AddExpiresAt(i)
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *APIKeyUpdate) SetLastUsedAt(i int64) *APIKeyUpdate {
+	aku.mutation.ResetLastUsedAt()
+	aku.mutation.SetLastUsedAt(i)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *APIKeyUpdate) SetNillableLastUsedAt(i *int64) *APIKeyUpdate {
+	if i != nil {
+		aku.SetLastUsedAt(*i)
+	}
+	return aku
+}
+
+// AddLastUsedAt adds i to the "last_used_at" field.
+func (aku *APIKeyUpdate) AddLastUsedAt(i int64) *APIKeyUpdate {
+	aku.mutation.AddLastUsedAt(i)
+	return aku
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (aku *APIKeyUpdate) Mutation() *APIKeyMutation {
+	return aku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *APIKeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := aku.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, aku.sqlSave, aku.mutation, aku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *APIKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *APIKeyUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *APIKeyUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aku *APIKeyUpdate) defaults() error {
+	if _, ok := aku.mutation.UpdatedAt(); !ok {
+		if apikey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized apikey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := apikey.UpdateDefaultUpdatedAt()
+		aku.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aku *APIKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIKeyUpdate {
+	aku.modifiers = append(aku.modifiers, modifiers...)
+	return aku
+}
+
+func (aku *APIKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt64))
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.UpdatedAt(); ok {
+		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aku.mutation.DeletedAt(); ok {
+		_spec.SetField(apikey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aku.mutation.DeletedAtCleared() {
+		_spec.ClearField(apikey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.UserID(); ok {
+		_spec.SetField(apikey.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := aku.mutation.AddedUserID(); ok {
+		_spec.AddField(apikey.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.Prefix(); ok {
+		_spec.SetField(apikey.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.Hash(); ok {
+		_spec.SetField(apikey.FieldHash, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := aku.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(apikey.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeInt64, value)
+	}
+	if value, ok := aku.mutation.AddedLastUsedAt(); ok {
+		_spec.AddField(apikey.FieldLastUsedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(aku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aku.mutation.done = true
+	return n, nil
+}
+
+// APIKeyUpdateOne is the builder for updating a single APIKey entity.
+type APIKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *APIKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (akuo *APIKeyUpdateOne) SetDeletedAt(tt types.UnixTimestamp) *APIKeyUpdateOne {
+	akuo.mutation.SetDeletedAt(tt)
+	return akuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableDeletedAt(tt *types.UnixTimestamp) *APIKeyUpdateOne {
+	if tt != nil {
+		akuo.SetDeletedAt(*tt)
+	}
+	return akuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (akuo *APIKeyUpdateOne) ClearDeletedAt() *APIKeyUpdateOne {
+	akuo.mutation.ClearDeletedAt()
+	return akuo
+}
+
+// SetUserID sets the "user_id" field.
+func (akuo *APIKeyUpdateOne) SetUserID(i int64) *APIKeyUpdateOne {
+	akuo.mutation.ResetUserID()
+	akuo.mutation.SetUserID(i)
+	return akuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableUserID(i *int64) *APIKeyUpdateOne {
+	if i != nil {
+		akuo.SetUserID(*i)
+	}
+	return akuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (akuo *APIKeyUpdateOne) AddUserID(i int64) *APIKeyUpdateOne {
+	akuo.mutation.AddUserID(i)
+	return akuo
+}
+
+// SetName sets the "name" field.
+func (akuo *APIKeyUpdateOne) SetName(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableName(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetName(*s)
+	}
+	return akuo
+}
+
+// SetPrefix sets the "prefix" field.
+func (akuo *APIKeyUpdateOne) SetPrefix(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetPrefix(s)
+	return akuo
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillablePrefix(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetPrefix(*s)
+	}
+	return akuo
+}
+
+// SetHash sets the "hash" field.
+func (akuo *APIKeyUpdateOne) SetHash(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetHash(s)
+	return akuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableHash(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetHash(*s)
+	}
+	return akuo
+}
+
+// SetScopes sets the "scopes" field.
+func (akuo *APIKeyUpdateOne) SetScopes(s string) *APIKeyUpdateOne {
+	akuo.mutation.SetScopes(s)
+	return akuo
+}
+
+// SetNillableScopes sets the "scopes" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableScopes(s *string) *APIKeyUpdateOne {
+	if s != nil {
+		akuo.SetScopes(*s)
+	}
+	return akuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (akuo *APIKeyUpdateOne) SetExpiresAt(i int64) *APIKeyUpdateOne {
+	akuo.mutation.ResetExpiresAt()
+	akuo.mutation.SetExpiresAt(i)
+	return akuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableExpiresAt(i *int64) *APIKeyUpdateOne {
+	if i != nil {
+		akuo.SetExpiresAt(*i)
+	}
+	return akuo
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (akuo *APIKeyUpdateOne) AddExpiresAt(i int64) *APIKeyUpdateOne {
+	akuo.mutation.AddExpiresAt(i)
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) SetLastUsedAt(i int64) *APIKeyUpdateOne {
+	akuo.mutation.ResetLastUsedAt()
+	akuo.mutation.SetLastUsedAt(i)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *APIKeyUpdateOne) SetNillableLastUsedAt(i *int64) *APIKeyUpdateOne {
+	if i != nil {
+		akuo.SetLastUsedAt(*i)
+	}
+	return akuo
+}
+
+// AddLastUsedAt adds i to the "last_used_at" field.
+func (akuo *APIKeyUpdateOne) AddLastUsedAt(i int64) *APIKeyUpdateOne {
+	akuo.mutation.AddLastUsedAt(i)
+	return akuo
+}
+
+// Mutation returns the APIKeyMutation object of the builder.
+func (akuo *APIKeyUpdateOne) Mutation() *APIKeyMutation {
+	return akuo.mutation
+}
+
+// Where appends a list predicates to the APIKeyUpdate builder.
+func (akuo *APIKeyUpdateOne) Where(ps ...predicate.APIKey) *APIKeyUpdateOne {
+	akuo.mutation.Where(ps...)
+	return akuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *APIKeyUpdateOne) Select(field string, fields ...string) *APIKeyUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated APIKey entity.
+func (akuo *APIKeyUpdateOne) Save(ctx context.Context) (*APIKey, error) {
+	if err := akuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, akuo.sqlSave, akuo.mutation, akuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) SaveX(ctx context.Context) *APIKey {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *APIKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *APIKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akuo *APIKeyUpdateOne) defaults() error {
+	if _, ok := akuo.mutation.UpdatedAt(); !ok {
+		if apikey.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized apikey.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := apikey.UpdateDefaultUpdatedAt()
+		akuo.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (akuo *APIKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *APIKeyUpdateOne {
+	akuo.modifiers = append(akuo.modifiers, modifiers...)
+	return akuo
+}
+
+func (akuo *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeInt64))
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "APIKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(apikey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := akuo.mutation.DeletedAt(); ok {
+		_spec.SetField(apikey.FieldDeletedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(apikey.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.UserID(); ok {
+		_spec.SetField(apikey.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := akuo.mutation.AddedUserID(); ok {
+		_spec.AddField(apikey.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.Prefix(); ok {
+		_spec.SetField(apikey.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.Hash(); ok {
+		_spec.SetField(apikey.FieldHash, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(apikey.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := akuo.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(apikey.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeInt64, value)
+	}
+	if value, ok := akuo.mutation.AddedLastUsedAt(); ok {
+		_spec.AddField(apikey.FieldLastUsedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(akuo.modifiers...)
+	_node = &APIKey{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	akuo.mutation.done = true
+	return _node, nil
+}
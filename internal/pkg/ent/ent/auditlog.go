@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/auditlog"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt types.UnixTimestamp `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt types.UnixTimestamp `json:"updated_at,omitempty"`
+	// 租户 id
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// 操作人 id
+	ActorID int64 `json:"actor_id,omitempty"`
+	// 被模拟的用户 id
+	UserID int64 `json:"user_id,omitempty"`
+	// 操作
+	Action string `json:"action,omitempty"`
+	// 请求方法
+	Method string `json:"method,omitempty"`
+	// 请求路径
+	Path string `json:"path,omitempty"`
+	// 响应状态码
+	Status int `json:"status,omitempty"`
+	// 客户端 IP
+	IP string `json:"ip,omitempty"`
+	// 客户端 User-Agent
+	UserAgent string `json:"user_agent,omitempty"`
+	// 详情
+	Detail       string `json:"detail,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID, auditlog.FieldTenantID, auditlog.FieldActorID, auditlog.FieldUserID, auditlog.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldAction, auditlog.FieldMethod, auditlog.FieldPath, auditlog.FieldIP, auditlog.FieldUserAgent, auditlog.FieldDetail:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreatedAt, auditlog.FieldUpdatedAt:
+			values[i] = new(types.UnixTimestamp)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			al.ID = int64(value.Int64)
+		case auditlog.FieldCreatedAt:
+			if value, ok := values[i].(*types.UnixTimestamp); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value != nil {
+				al.CreatedAt = *value
+			}
+		case auditlog.FieldUpdatedAt:
+			if value, ok := values[i].(*types.UnixTimestamp); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value != nil {
+				al.UpdatedAt = *value
+			}
+		case auditlog.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				al.TenantID = value.Int64
+			}
+		case auditlog.FieldActorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field actor_id", values[i])
+			} else if value.Valid {
+				al.ActorID = value.Int64
+			}
+		case auditlog.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				al.UserID = value.Int64
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case auditlog.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				al.Method = value.String
+			}
+		case auditlog.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				al.Path = value.String
+			}
+		case auditlog.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				al.Status = int(value.Int64)
+			}
+		case auditlog.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				al.IP = value.String
+			}
+		case auditlog.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				al.UserAgent = value.String
+			}
+		case auditlog.FieldDetail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field detail", values[i])
+			} else if value.Valid {
+				al.Detail = value.String
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", al.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", al.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("actor_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.ActorID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(al.Method)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(al.Path)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", al.Status))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(al.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(al.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("detail=")
+	builder.WriteString(al.Detail)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
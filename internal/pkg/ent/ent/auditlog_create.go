@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/auditlog"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(tt types.UnixTimestamp) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(tt)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(tt *types.UnixTimestamp) *AuditLogCreate {
+	if tt != nil {
+		alc.SetCreatedAt(*tt)
+	}
+	return alc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (alc *AuditLogCreate) SetUpdatedAt(tt types.UnixTimestamp) *AuditLogCreate {
+	alc.mutation.SetUpdatedAt(tt)
+	return alc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUpdatedAt(tt *types.UnixTimestamp) *AuditLogCreate {
+	if tt != nil {
+		alc.SetUpdatedAt(*tt)
+	}
+	return alc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alc *AuditLogCreate) SetTenantID(i int64) *AuditLogCreate {
+	alc.mutation.SetTenantID(i)
+	return alc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTenantID(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetTenantID(*i)
+	}
+	return alc
+}
+
+// SetActorID sets the "actor_id" field.
+func (alc *AuditLogCreate) SetActorID(i int64) *AuditLogCreate {
+	alc.mutation.SetActorID(i)
+	return alc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorID(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetActorID(*i)
+	}
+	return alc
+}
+
+// SetUserID sets the "user_id" field.
+func (alc *AuditLogCreate) SetUserID(i int64) *AuditLogCreate {
+	alc.mutation.SetUserID(i)
+	return alc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserID(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetUserID(*i)
+	}
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableAction(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetAction(*s)
+	}
+	return alc
+}
+
+// SetMethod sets the "method" field.
+func (alc *AuditLogCreate) SetMethod(s string) *AuditLogCreate {
+	alc.mutation.SetMethod(s)
+	return alc
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableMethod(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetMethod(*s)
+	}
+	return alc
+}
+
+// SetPath sets the "path" field.
+func (alc *AuditLogCreate) SetPath(s string) *AuditLogCreate {
+	alc.mutation.SetPath(s)
+	return alc
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillablePath(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetPath(*s)
+	}
+	return alc
+}
+
+// SetStatus sets the "status" field.
+func (alc *AuditLogCreate) SetStatus(i int) *AuditLogCreate {
+	alc.mutation.SetStatus(i)
+	return alc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableStatus(i *int) *AuditLogCreate {
+	if i != nil {
+		alc.SetStatus(*i)
+	}
+	return alc
+}
+
+// SetIP sets the "ip" field.
+func (alc *AuditLogCreate) SetIP(s string) *AuditLogCreate {
+	alc.mutation.SetIP(s)
+	return alc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableIP(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetIP(*s)
+	}
+	return alc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserAgent(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetUserAgent(*s)
+	}
+	return alc
+}
+
+// SetDetail sets the "detail" field.
+func (alc *AuditLogCreate) SetDetail(s string) *AuditLogCreate {
+	alc.mutation.SetDetail(s)
+	return alc
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableDetail(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetDetail(*s)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(i int64) *AuditLogCreate {
+	alc.mutation.SetID(i)
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := alc.mutation.UpdatedAt(); !ok {
+		v := auditlog.DefaultUpdatedAt()
+		alc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := alc.mutation.TenantID(); !ok {
+		v := auditlog.DefaultTenantID
+		alc.mutation.SetTenantID(v)
+	}
+	if _, ok := alc.mutation.ActorID(); !ok {
+		v := auditlog.DefaultActorID
+		alc.mutation.SetActorID(v)
+	}
+	if _, ok := alc.mutation.UserID(); !ok {
+		v := auditlog.DefaultUserID
+		alc.mutation.SetUserID(v)
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		v := auditlog.DefaultAction
+		alc.mutation.SetAction(v)
+	}
+	if _, ok := alc.mutation.Method(); !ok {
+		v := auditlog.DefaultMethod
+		alc.mutation.SetMethod(v)
+	}
+	if _, ok := alc.mutation.Path(); !ok {
+		v := auditlog.DefaultPath
+		alc.mutation.SetPath(v)
+	}
+	if _, ok := alc.mutation.Status(); !ok {
+		v := auditlog.DefaultStatus
+		alc.mutation.SetStatus(v)
+	}
+	if _, ok := alc.mutation.IP(); !ok {
+		v := auditlog.DefaultIP
+		alc.mutation.SetIP(v)
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		v := auditlog.DefaultUserAgent
+		alc.mutation.SetUserAgent(v)
+	}
+	if _, ok := alc.mutation.Detail(); !ok {
+		v := auditlog.DefaultDetail
+		alc.mutation.SetDetail(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditLog.created_at"`)}
+	}
+	if _, ok := alc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AuditLog.updated_at"`)}
+	}
+	if _, ok := alc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "AuditLog.tenant_id"`)}
+	}
+	if _, ok := alc.mutation.ActorID(); !ok {
+		return &ValidationError{Name: "actor_id", err: errors.New(`ent: missing required field "AuditLog.actor_id"`)}
+	}
+	if _, ok := alc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "AuditLog.user_id"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditLog.action"`)}
+	}
+	if _, ok := alc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "AuditLog.method"`)}
+	}
+	if _, ok := alc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "AuditLog.path"`)}
+	}
+	if _, ok := alc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AuditLog.status"`)}
+	}
+	if _, ok := alc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AuditLog.ip"`)}
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AuditLog.user_agent"`)}
+	}
+	if _, ok := alc.mutation.Detail(); !ok {
+		return &ValidationError{Name: "detail", err: errors.New(`ent: missing required field "AuditLog.detail"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt64))
+	)
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := alc.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := alc.mutation.TenantID(); ok {
+		_spec.SetField(auditlog.FieldTenantID, field.TypeInt64, value)
+		_node.TenantID = value
+	}
+	if value, ok := alc.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt64, value)
+		_node.ActorID = value
+	}
+	if value, ok := alc.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.Method(); ok {
+		_spec.SetField(auditlog.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := alc.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := alc.mutation.Status(); ok {
+		_spec.SetField(auditlog.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := alc.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := alc.mutation.Detail(); ok {
+		_spec.SetField(auditlog.FieldDetail, field.TypeString, value)
+		_node.Detail = value
+	}
+	return _node, _spec
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
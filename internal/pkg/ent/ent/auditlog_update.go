@@ -0,0 +1,635 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/pkg/ent/ent/auditlog"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (alu *AuditLogUpdate) SetTenantID(i int64) *AuditLogUpdate {
+	alu.mutation.ResetTenantID()
+	alu.mutation.SetTenantID(i)
+	return alu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTenantID(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetTenantID(*i)
+	}
+	return alu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (alu *AuditLogUpdate) AddTenantID(i int64) *AuditLogUpdate {
+	alu.mutation.AddTenantID(i)
+	return alu
+}
+
+// SetActorID sets the "actor_id" field.
+func (alu *AuditLogUpdate) SetActorID(i int64) *AuditLogUpdate {
+	alu.mutation.ResetActorID()
+	alu.mutation.SetActorID(i)
+	return alu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActorID(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetActorID(*i)
+	}
+	return alu
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (alu *AuditLogUpdate) AddActorID(i int64) *AuditLogUpdate {
+	alu.mutation.AddActorID(i)
+	return alu
+}
+
+// SetUserID sets the "user_id" field.
+func (alu *AuditLogUpdate) SetUserID(i int64) *AuditLogUpdate {
+	alu.mutation.ResetUserID()
+	alu.mutation.SetUserID(i)
+	return alu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserID(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetUserID(*i)
+	}
+	return alu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (alu *AuditLogUpdate) AddUserID(i int64) *AuditLogUpdate {
+	alu.mutation.AddUserID(i)
+	return alu
+}
+
+// SetAction sets the "action" field.
+func (alu *AuditLogUpdate) SetAction(s string) *AuditLogUpdate {
+	alu.mutation.SetAction(s)
+	return alu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableAction(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetAction(*s)
+	}
+	return alu
+}
+
+// SetMethod sets the "method" field.
+func (alu *AuditLogUpdate) SetMethod(s string) *AuditLogUpdate {
+	alu.mutation.SetMethod(s)
+	return alu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableMethod(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetMethod(*s)
+	}
+	return alu
+}
+
+// SetPath sets the "path" field.
+func (alu *AuditLogUpdate) SetPath(s string) *AuditLogUpdate {
+	alu.mutation.SetPath(s)
+	return alu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillablePath(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetPath(*s)
+	}
+	return alu
+}
+
+// SetStatus sets the "status" field.
+func (alu *AuditLogUpdate) SetStatus(i int) *AuditLogUpdate {
+	alu.mutation.ResetStatus()
+	alu.mutation.SetStatus(i)
+	return alu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableStatus(i *int) *AuditLogUpdate {
+	if i != nil {
+		alu.SetStatus(*i)
+	}
+	return alu
+}
+
+// AddStatus adds i to the "status" field.
+func (alu *AuditLogUpdate) AddStatus(i int) *AuditLogUpdate {
+	alu.mutation.AddStatus(i)
+	return alu
+}
+
+// SetIP sets the "ip" field.
+func (alu *AuditLogUpdate) SetIP(s string) *AuditLogUpdate {
+	alu.mutation.SetIP(s)
+	return alu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableIP(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetIP(*s)
+	}
+	return alu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alu *AuditLogUpdate) SetUserAgent(s string) *AuditLogUpdate {
+	alu.mutation.SetUserAgent(s)
+	return alu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserAgent(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetUserAgent(*s)
+	}
+	return alu
+}
+
+// SetDetail sets the "detail" field.
+func (alu *AuditLogUpdate) SetDetail(s string) *AuditLogUpdate {
+	alu.mutation.SetDetail(s)
+	return alu
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableDetail(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetDetail(*s)
+	}
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	alu.defaults()
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alu *AuditLogUpdate) defaults() {
+	if _, ok := alu.mutation.UpdatedAt(); !ok {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		alu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (alu *AuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdate {
+	alu.modifiers = append(alu.modifiers, modifiers...)
+	return alu
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt64))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := alu.mutation.TenantID(); ok {
+		_spec.SetField(auditlog.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.AddedTenantID(); ok {
+		_spec.AddField(auditlog.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.AddedUserID(); ok {
+		_spec.AddField(auditlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Method(); ok {
+		_spec.SetField(auditlog.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Status(); ok {
+		_spec.SetField(auditlog.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := alu.mutation.AddedStatus(); ok {
+		_spec.AddField(auditlog.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := alu.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Detail(); ok {
+		_spec.SetField(auditlog.FieldDetail, field.TypeString, value)
+	}
+	_spec.AddModifiers(alu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) SetTenantID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetTenantID()
+	aluo.mutation.SetTenantID(i)
+	return aluo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTenantID(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetTenantID(*i)
+	}
+	return aluo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (aluo *AuditLogUpdateOne) AddTenantID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddTenantID(i)
+	return aluo
+}
+
+// SetActorID sets the "actor_id" field.
+func (aluo *AuditLogUpdateOne) SetActorID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetActorID()
+	aluo.mutation.SetActorID(i)
+	return aluo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActorID(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetActorID(*i)
+	}
+	return aluo
+}
+
+// AddActorID adds i to the "actor_id" field.
+func (aluo *AuditLogUpdateOne) AddActorID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddActorID(i)
+	return aluo
+}
+
+// SetUserID sets the "user_id" field.
+func (aluo *AuditLogUpdateOne) SetUserID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetUserID()
+	aluo.mutation.SetUserID(i)
+	return aluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserID(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetUserID(*i)
+	}
+	return aluo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (aluo *AuditLogUpdateOne) AddUserID(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddUserID(i)
+	return aluo
+}
+
+// SetAction sets the "action" field.
+func (aluo *AuditLogUpdateOne) SetAction(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetAction(s)
+	return aluo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableAction(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetAction(*s)
+	}
+	return aluo
+}
+
+// SetMethod sets the "method" field.
+func (aluo *AuditLogUpdateOne) SetMethod(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetMethod(s)
+	return aluo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableMethod(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetMethod(*s)
+	}
+	return aluo
+}
+
+// SetPath sets the "path" field.
+func (aluo *AuditLogUpdateOne) SetPath(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetPath(s)
+	return aluo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillablePath(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetPath(*s)
+	}
+	return aluo
+}
+
+// SetStatus sets the "status" field.
+func (aluo *AuditLogUpdateOne) SetStatus(i int) *AuditLogUpdateOne {
+	aluo.mutation.ResetStatus()
+	aluo.mutation.SetStatus(i)
+	return aluo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableStatus(i *int) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetStatus(*i)
+	}
+	return aluo
+}
+
+// AddStatus adds i to the "status" field.
+func (aluo *AuditLogUpdateOne) AddStatus(i int) *AuditLogUpdateOne {
+	aluo.mutation.AddStatus(i)
+	return aluo
+}
+
+// SetIP sets the "ip" field.
+func (aluo *AuditLogUpdateOne) SetIP(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetIP(s)
+	return aluo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableIP(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetIP(*s)
+	}
+	return aluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aluo *AuditLogUpdateOne) SetUserAgent(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetUserAgent(s)
+	return aluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserAgent(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetUserAgent(*s)
+	}
+	return aluo
+}
+
+// SetDetail sets the "detail" field.
+func (aluo *AuditLogUpdateOne) SetDetail(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetDetail(s)
+	return aluo
+}
+
+// SetNillableDetail sets the "detail" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableDetail(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetDetail(*s)
+	}
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	aluo.defaults()
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aluo *AuditLogUpdateOne) defaults() {
+	if _, ok := aluo.mutation.UpdatedAt(); !ok {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		aluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aluo *AuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdateOne {
+	aluo.modifiers = append(aluo.modifiers, modifiers...)
+	return aluo
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeInt64))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aluo.mutation.TenantID(); ok {
+		_spec.SetField(auditlog.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.AddedTenantID(); ok {
+		_spec.AddField(auditlog.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.AddedActorID(); ok {
+		_spec.AddField(auditlog.FieldActorID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.AddedUserID(); ok {
+		_spec.AddField(auditlog.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Method(); ok {
+		_spec.SetField(auditlog.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Status(); ok {
+		_spec.SetField(auditlog.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := aluo.mutation.AddedStatus(); ok {
+		_spec.AddField(auditlog.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := aluo.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Detail(); ok {
+		_spec.SetField(auditlog.FieldDetail, field.TypeString, value)
+	}
+	_spec.AddModifiers(aluo.modifiers...)
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
@@ -6,10 +6,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go-scaffold/internal/pkg/ent/ent/apikey"
+	"go-scaffold/internal/pkg/ent/ent/auditlog"
 	"go-scaffold/internal/pkg/ent/ent/permission"
 	"go-scaffold/internal/pkg/ent/ent/product"
 	"go-scaffold/internal/pkg/ent/ent/role"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
+	"go-scaffold/internal/pkg/ent/ent/tenant"
 	"go-scaffold/internal/pkg/ent/ent/user"
+	"go-scaffold/internal/pkg/ent/ent/useridentity"
 	"reflect"
 	"sync"
 
@@ -76,10 +81,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			permission.Table: permission.ValidColumn,
-			product.Table:    product.ValidColumn,
-			role.Table:       role.ValidColumn,
-			user.Table:       user.ValidColumn,
+			apikey.Table:       apikey.ValidColumn,
+			auditlog.Table:     auditlog.ValidColumn,
+			permission.Table:   permission.ValidColumn,
+			product.Table:      product.ValidColumn,
+			role.Table:         role.ValidColumn,
+			rolegrant.Table:    rolegrant.ValidColumn,
+			tenant.Table:       tenant.ValidColumn,
+			user.Table:         user.ValidColumn,
+			useridentity.Table: useridentity.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -9,6 +9,82 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt64, Comment: "服务账号 id", Default: 0},
+		{Name: "name", Type: field.TypeString, Comment: "名称", Default: ""},
+		{Name: "prefix", Type: field.TypeString, Comment: "密钥前缀", Default: ""},
+		{Name: "hash", Type: field.TypeString, Comment: "密钥摘要", Default: ""},
+		{Name: "scopes", Type: field.TypeString, Comment: "授权范围", Default: ""},
+		{Name: "expires_at", Type: field.TypeInt64, Comment: "过期时间", Default: 0},
+		{Name: "last_used_at", Type: field.TypeInt64, Comment: "最后使用时间", Default: 0},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_prefix",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[6]},
+			},
+			{
+				Name:    "apikey_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[4]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户 id", Default: 1},
+		{Name: "actor_id", Type: field.TypeInt64, Comment: "操作人 id", Default: 0},
+		{Name: "user_id", Type: field.TypeInt64, Comment: "被模拟的用户 id", Default: 0},
+		{Name: "action", Type: field.TypeString, Comment: "操作", Default: ""},
+		{Name: "method", Type: field.TypeString, Comment: "请求方法", Default: ""},
+		{Name: "path", Type: field.TypeString, Comment: "请求路径", Default: ""},
+		{Name: "status", Type: field.TypeInt, Comment: "响应状态码", Default: 0},
+		{Name: "ip", Type: field.TypeString, Comment: "客户端 IP", Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Comment: "客户端 User-Agent", Default: ""},
+		{Name: "detail", Type: field.TypeString, Comment: "详情", Default: ""},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3]},
+			},
+			{
+				Name:    "auditlog_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5]},
+			},
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+		},
+	}
 	// PermissionsColumns holds the columns for the "permissions" table.
 	PermissionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -39,9 +115,12 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "department_id", Type: field.TypeInt64, Comment: "所属部门 id", Default: 0},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户 id", Default: 1},
 		{Name: "name", Type: field.TypeString, Comment: "名称", Default: ""},
 		{Name: "desc", Type: field.TypeString, Comment: "描述", Default: ""},
 		{Name: "price", Type: field.TypeInt, Comment: "价格", Default: 0},
+		{Name: "owner_id", Type: field.TypeInt64, Comment: "创建人 id", Default: 0},
 	}
 	// ProductsTable holds the schema information for the "products" table.
 	ProductsTable = &schema.Table{
@@ -49,9 +128,24 @@ var (
 		Columns:    ProductsColumns,
 		PrimaryKey: []*schema.Column{ProductsColumns[0]},
 		Indexes: []*schema.Index{
+			{
+				Name:    "product_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[5]},
+			},
 			{
 				Name:    "product_name",
 				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[6]},
+			},
+			{
+				Name:    "product_owner_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProductsColumns[9]},
+			},
+			{
+				Name:    "product_department_id",
+				Unique:  false,
 				Columns: []*schema.Column{ProductsColumns[4]},
 			},
 		},
@@ -62,13 +156,72 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "name", Type: field.TypeString, Unique: true, Size: 32, Comment: "角色名称"},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户 id", Default: 1},
+		{Name: "name", Type: field.TypeString, Size: 32, Comment: "角色名称"},
+		{Name: "data_scope", Type: field.TypeString, Comment: "数据范围：all 全部，own 本人，department 本部门，custom 自定义部门", Default: "all"},
+		{Name: "data_scope_departments", Type: field.TypeString, Comment: "自定义数据范围的部门 id，逗号分隔", Default: ""},
 	}
 	// RolesTable holds the schema information for the "roles" table.
 	RolesTable = &schema.Table{
 		Name:       "roles",
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "role_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{RolesColumns[4], RolesColumns[5]},
+			},
+		},
+	}
+	// RoleGrantsColumns holds the columns for the "role_grants" table.
+	RoleGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "租户 id", Default: 0},
+		{Name: "user_id", Type: field.TypeInt64, Comment: "用户 id", Default: 0},
+		{Name: "role_id", Type: field.TypeInt64, Comment: "角色 id", Default: 0},
+		{Name: "starts_at", Type: field.TypeInt64, Comment: "生效时间", Default: 0},
+		{Name: "expires_at", Type: field.TypeInt64, Comment: "过期时间", Default: 0},
+		{Name: "activated_at", Type: field.TypeInt64, Comment: "策略写入时间，0 为尚未写入", Default: 0},
+	}
+	// RoleGrantsTable holds the schema information for the "role_grants" table.
+	RoleGrantsTable = &schema.Table{
+		Name:       "role_grants",
+		Columns:    RoleGrantsColumns,
+		PrimaryKey: []*schema.Column{RoleGrantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolegrant_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoleGrantsColumns[3], RoleGrantsColumns[4]},
+			},
+			{
+				Name:    "rolegrant_starts_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleGrantsColumns[6]},
+			},
+			{
+				Name:    "rolegrant_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RoleGrantsColumns[7]},
+			},
+		},
+	}
+	// TenantsColumns holds the columns for the "tenants" table.
+	TenantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 64, Comment: "租户名称"},
+	}
+	// TenantsTable holds the schema information for the "tenants" table.
+	TenantsTable = &schema.Table{
+		Name:       "tenants",
+		Columns:    TenantsColumns,
+		PrimaryKey: []*schema.Column{TenantsColumns[0]},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -76,11 +229,20 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "department_id", Type: field.TypeInt64, Comment: "所属部门 id", Default: 0},
+		{Name: "tenant_id", Type: field.TypeInt64, Comment: "所属租户 id", Default: 1},
 		{Name: "username", Type: field.TypeString, Comment: "用户名", Default: ""},
 		{Name: "password", Type: field.TypeString, Comment: "密码", Default: ""},
 		{Name: "nickname", Type: field.TypeString, Comment: "用户名", Default: ""},
 		{Name: "phone", Type: field.TypeString, Comment: "电话", Default: ""},
+		{Name: "email", Type: field.TypeString, Comment: "邮箱", Default: ""},
+		{Name: "email_verified_at", Type: field.TypeInt64, Comment: "邮箱验证时间", Default: 0},
+		{Name: "service_account", Type: field.TypeBool, Comment: "是否为服务账号", Default: false},
 		{Name: "salt", Type: field.TypeString, Comment: "盐值", Default: ""},
+		{Name: "totp_secret", Type: field.TypeString, Comment: "TOTP 密钥", Default: ""},
+		{Name: "totp_enabled_at", Type: field.TypeInt64, Comment: "TOTP 启用时间", Default: 0},
+		{Name: "totp_recovery_codes", Type: field.TypeString, Comment: "TOTP 恢复码摘要", Default: ""},
+		{Name: "totp_last_step", Type: field.TypeInt64, Comment: "最后使用的 TOTP 时间步", Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -89,27 +251,82 @@ var (
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 		Indexes: []*schema.Index{
 			{
-				Name:    "user_username",
+				Name:    "user_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[5]},
+			},
+			{
+				Name:    "user_department_id",
 				Unique:  false,
 				Columns: []*schema.Column{UsersColumns[4]},
 			},
+			{
+				Name:    "user_username",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[6]},
+			},
 			{
 				Name:    "user_phone",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[7]},
+				Columns: []*schema.Column{UsersColumns[9]},
+			},
+			{
+				Name:    "user_email",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[10]},
+			},
+		},
+	}
+	// UserIdentitiesColumns holds the columns for the "user_identities" table.
+	UserIdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64, Comment: "用户 id", Default: 0},
+		{Name: "provider", Type: field.TypeString, Comment: "身份提供方", Default: ""},
+		{Name: "subject", Type: field.TypeString, Comment: "身份提供方的用户标识", Default: ""},
+	}
+	// UserIdentitiesTable holds the schema information for the "user_identities" table.
+	UserIdentitiesTable = &schema.Table{
+		Name:       "user_identities",
+		Columns:    UserIdentitiesColumns,
+		PrimaryKey: []*schema.Column{UserIdentitiesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "useridentity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{UserIdentitiesColumns[4], UserIdentitiesColumns[5]},
+			},
+			{
+				Name:    "useridentity_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{UserIdentitiesColumns[3]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AuditLogsTable,
 		PermissionsTable,
 		ProductsTable,
 		RolesTable,
+		RoleGrantsTable,
+		TenantsTable,
 		UsersTable,
+		UserIdentitiesTable,
 	}
 )
 
 func init() {
+	APIKeysTable.Annotation = &entsql.Annotation{
+		Table:   "api_keys",
+		Options: "COMMENT='API 密钥表'",
+	}
+	AuditLogsTable.Annotation = &entsql.Annotation{
+		Table:   "audit_logs",
+		Options: "COMMENT='审计日志表'",
+	}
 	PermissionsTable.Annotation = &entsql.Annotation{
 		Table:   "permissions",
 		Options: "COMMENT='权限表'",
@@ -122,8 +339,20 @@ func init() {
 		Table:   "roles",
 		Options: "COMMENT='角色表'",
 	}
+	RoleGrantsTable.Annotation = &entsql.Annotation{
+		Table:   "role_grants",
+		Options: "COMMENT='角色授予表'",
+	}
+	TenantsTable.Annotation = &entsql.Annotation{
+		Table:   "tenants",
+		Options: "COMMENT='租户表'",
+	}
 	UsersTable.Annotation = &entsql.Annotation{
 		Table:   "users",
 		Options: "COMMENT='用户表'",
 	}
+	UserIdentitiesTable.Annotation = &entsql.Annotation{
+		Table:   "user_identities",
+		Options: "COMMENT='用户外部身份表'",
+	}
 }
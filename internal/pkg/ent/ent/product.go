@@ -23,12 +23,18 @@ type Product struct {
 	UpdatedAt types.UnixTimestamp `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt types.UnixTimestamp `json:"deleted_at,omitempty"`
+	// 所属部门 id
+	DepartmentID int64 `json:"department_id,omitempty"`
+	// 租户 id
+	TenantID int64 `json:"tenant_id,omitempty"`
 	// 名称
 	Name string `json:"name,omitempty"`
 	// 描述
 	Desc string `json:"desc,omitempty"`
 	// 价格
-	Price        int `json:"price,omitempty"`
+	Price int `json:"price,omitempty"`
+	// 创建人 id
+	OwnerID      int64 `json:"owner_id,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -37,7 +43,7 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case product.FieldID, product.FieldPrice:
+		case product.FieldID, product.FieldDepartmentID, product.FieldTenantID, product.FieldPrice, product.FieldOwnerID:
 			values[i] = new(sql.NullInt64)
 		case product.FieldName, product.FieldDesc:
 			values[i] = new(sql.NullString)
@@ -82,6 +88,18 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				pr.DeletedAt = *value
 			}
+		case product.FieldDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value.Valid {
+				pr.DepartmentID = value.Int64
+			}
+		case product.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				pr.TenantID = value.Int64
+			}
 		case product.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -100,6 +118,12 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Price = int(value.Int64)
 			}
+		case product.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				pr.OwnerID = value.Int64
+			}
 		default:
 			pr.selectValues.Set(columns[i], values[i])
 		}
@@ -145,6 +169,12 @@ func (pr *Product) String() string {
 	builder.WriteString("deleted_at=")
 	builder.WriteString(fmt.Sprintf("%v", pr.DeletedAt))
 	builder.WriteString(", ")
+	builder.WriteString("department_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.DepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(pr.Name)
 	builder.WriteString(", ")
@@ -153,6 +183,9 @@ func (pr *Product) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("price=")
 	builder.WriteString(fmt.Sprintf("%v", pr.Price))
+	builder.WriteString(", ")
+	builder.WriteString("owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", pr.OwnerID))
 	builder.WriteByte(')')
 	return builder.String()
 }
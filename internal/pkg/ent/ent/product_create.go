@@ -62,6 +62,34 @@ func (pc *ProductCreate) SetNillableDeletedAt(tt *types.UnixTimestamp) *ProductC
 	return pc
 }
 
+// SetDepartmentID sets the "department_id" field.
+func (pc *ProductCreate) SetDepartmentID(i int64) *ProductCreate {
+	pc.mutation.SetDepartmentID(i)
+	return pc
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableDepartmentID(i *int64) *ProductCreate {
+	if i != nil {
+		pc.SetDepartmentID(*i)
+	}
+	return pc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pc *ProductCreate) SetTenantID(i int64) *ProductCreate {
+	pc.mutation.SetTenantID(i)
+	return pc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableTenantID(i *int64) *ProductCreate {
+	if i != nil {
+		pc.SetTenantID(*i)
+	}
+	return pc
+}
+
 // SetName sets the "name" field.
 func (pc *ProductCreate) SetName(s string) *ProductCreate {
 	pc.mutation.SetName(s)
@@ -104,6 +132,20 @@ func (pc *ProductCreate) SetNillablePrice(i *int) *ProductCreate {
 	return pc
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (pc *ProductCreate) SetOwnerID(i int64) *ProductCreate {
+	pc.mutation.SetOwnerID(i)
+	return pc
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableOwnerID(i *int64) *ProductCreate {
+	if i != nil {
+		pc.SetOwnerID(*i)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *ProductCreate) SetID(i int64) *ProductCreate {
 	pc.mutation.SetID(i)
@@ -161,6 +203,14 @@ func (pc *ProductCreate) defaults() error {
 		v := product.DefaultUpdatedAt()
 		pc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := pc.mutation.DepartmentID(); !ok {
+		v := product.DefaultDepartmentID
+		pc.mutation.SetDepartmentID(v)
+	}
+	if _, ok := pc.mutation.TenantID(); !ok {
+		v := product.DefaultTenantID
+		pc.mutation.SetTenantID(v)
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		v := product.DefaultName
 		pc.mutation.SetName(v)
@@ -173,6 +223,10 @@ func (pc *ProductCreate) defaults() error {
 		v := product.DefaultPrice
 		pc.mutation.SetPrice(v)
 	}
+	if _, ok := pc.mutation.OwnerID(); !ok {
+		v := product.DefaultOwnerID
+		pc.mutation.SetOwnerID(v)
+	}
 	return nil
 }
 
@@ -184,6 +238,12 @@ func (pc *ProductCreate) check() error {
 	if _, ok := pc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Product.updated_at"`)}
 	}
+	if _, ok := pc.mutation.DepartmentID(); !ok {
+		return &ValidationError{Name: "department_id", err: errors.New(`ent: missing required field "Product.department_id"`)}
+	}
+	if _, ok := pc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Product.tenant_id"`)}
+	}
 	if _, ok := pc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Product.name"`)}
 	}
@@ -193,6 +253,9 @@ func (pc *ProductCreate) check() error {
 	if _, ok := pc.mutation.Price(); !ok {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Product.price"`)}
 	}
+	if _, ok := pc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Product.owner_id"`)}
+	}
 	return nil
 }
 
@@ -237,6 +300,14 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := pc.mutation.DepartmentID(); ok {
+		_spec.SetField(product.FieldDepartmentID, field.TypeInt64, value)
+		_node.DepartmentID = value
+	}
+	if value, ok := pc.mutation.TenantID(); ok {
+		_spec.SetField(product.FieldTenantID, field.TypeInt64, value)
+		_node.TenantID = value
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -249,6 +320,10 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldPrice, field.TypeInt, value)
 		_node.Price = value
 	}
+	if value, ok := pc.mutation.OwnerID(); ok {
+		_spec.SetField(product.FieldOwnerID, field.TypeInt64, value)
+		_node.OwnerID = value
+	}
 	return _node, _spec
 }
 
@@ -49,6 +49,48 @@ func (pu *ProductUpdate) ClearDeletedAt() *ProductUpdate {
 	return pu
 }
 
+// SetDepartmentID sets the "department_id" field.
+func (pu *ProductUpdate) SetDepartmentID(i int64) *ProductUpdate {
+	pu.mutation.ResetDepartmentID()
+	pu.mutation.SetDepartmentID(i)
+	return pu
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableDepartmentID(i *int64) *ProductUpdate {
+	if i != nil {
+		pu.SetDepartmentID(*i)
+	}
+	return pu
+}
+
+// AddDepartmentID adds i to the "department_id" field.
+func (pu *ProductUpdate) AddDepartmentID(i int64) *ProductUpdate {
+	pu.mutation.AddDepartmentID(i)
+	return pu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (pu *ProductUpdate) SetTenantID(i int64) *ProductUpdate {
+	pu.mutation.ResetTenantID()
+	pu.mutation.SetTenantID(i)
+	return pu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableTenantID(i *int64) *ProductUpdate {
+	if i != nil {
+		pu.SetTenantID(*i)
+	}
+	return pu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (pu *ProductUpdate) AddTenantID(i int64) *ProductUpdate {
+	pu.mutation.AddTenantID(i)
+	return pu
+}
+
 // SetName sets the "name" field.
 func (pu *ProductUpdate) SetName(s string) *ProductUpdate {
 	pu.mutation.SetName(s)
@@ -98,6 +140,27 @@ func (pu *ProductUpdate) AddPrice(i int) *ProductUpdate {
 	return pu
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (pu *ProductUpdate) SetOwnerID(i int64) *ProductUpdate {
+	pu.mutation.ResetOwnerID()
+	pu.mutation.SetOwnerID(i)
+	return pu
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableOwnerID(i *int64) *ProductUpdate {
+	if i != nil {
+		pu.SetOwnerID(*i)
+	}
+	return pu
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (pu *ProductUpdate) AddOwnerID(i int64) *ProductUpdate {
+	pu.mutation.AddOwnerID(i)
+	return pu
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pu *ProductUpdate) Mutation() *ProductMutation {
 	return pu.mutation
@@ -169,6 +232,18 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.DeletedAtCleared() {
 		_spec.ClearField(product.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := pu.mutation.DepartmentID(); ok {
+		_spec.SetField(product.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedDepartmentID(); ok {
+		_spec.AddField(product.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.TenantID(); ok {
+		_spec.SetField(product.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedTenantID(); ok {
+		_spec.AddField(product.FieldTenantID, field.TypeInt64, value)
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 	}
@@ -181,6 +256,12 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.AddedPrice(); ok {
 		_spec.AddField(product.FieldPrice, field.TypeInt, value)
 	}
+	if value, ok := pu.mutation.OwnerID(); ok {
+		_spec.SetField(product.FieldOwnerID, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedOwnerID(); ok {
+		_spec.AddField(product.FieldOwnerID, field.TypeInt64, value)
+	}
 	_spec.AddModifiers(pu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -223,6 +304,48 @@ func (puo *ProductUpdateOne) ClearDeletedAt() *ProductUpdateOne {
 	return puo
 }
 
+// SetDepartmentID sets the "department_id" field.
+func (puo *ProductUpdateOne) SetDepartmentID(i int64) *ProductUpdateOne {
+	puo.mutation.ResetDepartmentID()
+	puo.mutation.SetDepartmentID(i)
+	return puo
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableDepartmentID(i *int64) *ProductUpdateOne {
+	if i != nil {
+		puo.SetDepartmentID(*i)
+	}
+	return puo
+}
+
+// AddDepartmentID adds i to the "department_id" field.
+func (puo *ProductUpdateOne) AddDepartmentID(i int64) *ProductUpdateOne {
+	puo.mutation.AddDepartmentID(i)
+	return puo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (puo *ProductUpdateOne) SetTenantID(i int64) *ProductUpdateOne {
+	puo.mutation.ResetTenantID()
+	puo.mutation.SetTenantID(i)
+	return puo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableTenantID(i *int64) *ProductUpdateOne {
+	if i != nil {
+		puo.SetTenantID(*i)
+	}
+	return puo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (puo *ProductUpdateOne) AddTenantID(i int64) *ProductUpdateOne {
+	puo.mutation.AddTenantID(i)
+	return puo
+}
+
 // SetName sets the "name" field.
 func (puo *ProductUpdateOne) SetName(s string) *ProductUpdateOne {
 	puo.mutation.SetName(s)
@@ -272,6 +395,27 @@ func (puo *ProductUpdateOne) AddPrice(i int) *ProductUpdateOne {
 	return puo
 }
 
+// SetOwnerID sets the "owner_id" field.
+func (puo *ProductUpdateOne) SetOwnerID(i int64) *ProductUpdateOne {
+	puo.mutation.ResetOwnerID()
+	puo.mutation.SetOwnerID(i)
+	return puo
+}
+
+// SetNillableOwnerID sets the "owner_id" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableOwnerID(i *int64) *ProductUpdateOne {
+	if i != nil {
+		puo.SetOwnerID(*i)
+	}
+	return puo
+}
+
+// AddOwnerID adds i to the "owner_id" field.
+func (puo *ProductUpdateOne) AddOwnerID(i int64) *ProductUpdateOne {
+	puo.mutation.AddOwnerID(i)
+	return puo
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (puo *ProductUpdateOne) Mutation() *ProductMutation {
 	return puo.mutation
@@ -373,6 +517,18 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if puo.mutation.DeletedAtCleared() {
 		_spec.ClearField(product.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := puo.mutation.DepartmentID(); ok {
+		_spec.SetField(product.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedDepartmentID(); ok {
+		_spec.AddField(product.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.TenantID(); ok {
+		_spec.SetField(product.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedTenantID(); ok {
+		_spec.AddField(product.FieldTenantID, field.TypeInt64, value)
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.SetField(product.FieldName, field.TypeString, value)
 	}
@@ -385,6 +541,12 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.AddedPrice(); ok {
 		_spec.AddField(product.FieldPrice, field.TypeInt, value)
 	}
+	if value, ok := puo.mutation.OwnerID(); ok {
+		_spec.SetField(product.FieldOwnerID, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedOwnerID(); ok {
+		_spec.AddField(product.FieldOwnerID, field.TypeInt64, value)
+	}
 	_spec.AddModifiers(puo.modifiers...)
 	_node = &Product{config: puo.config}
 	_spec.Assign = _node.assignValues
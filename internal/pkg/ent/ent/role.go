@@ -23,9 +23,15 @@ type Role struct {
 	UpdatedAt types.UnixTimestamp `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt types.UnixTimestamp `json:"deleted_at,omitempty"`
+	// 租户 id
+	TenantID int64 `json:"tenant_id,omitempty"`
 	// 角色名称
-	Name         string `json:"name,omitempty"`
-	selectValues sql.SelectValues
+	Name string `json:"name,omitempty"`
+	// 数据范围：all 全部，own 本人，department 本部门，custom 自定义部门
+	DataScope string `json:"data_scope,omitempty"`
+	// 自定义数据范围的部门 id，逗号分隔
+	DataScopeDepartments string `json:"data_scope_departments,omitempty"`
+	selectValues         sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -33,9 +39,9 @@ func (*Role) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case role.FieldID:
+		case role.FieldID, role.FieldTenantID:
 			values[i] = new(sql.NullInt64)
-		case role.FieldName:
+		case role.FieldName, role.FieldDataScope, role.FieldDataScopeDepartments:
 			values[i] = new(sql.NullString)
 		case role.FieldCreatedAt, role.FieldUpdatedAt, role.FieldDeletedAt:
 			values[i] = new(types.UnixTimestamp)
@@ -78,12 +84,30 @@ func (r *Role) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				r.DeletedAt = *value
 			}
+		case role.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				r.TenantID = value.Int64
+			}
 		case role.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
 				r.Name = value.String
 			}
+		case role.FieldDataScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_scope", values[i])
+			} else if value.Valid {
+				r.DataScope = value.String
+			}
+		case role.FieldDataScopeDepartments:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data_scope_departments", values[i])
+			} else if value.Valid {
+				r.DataScopeDepartments = value.String
+			}
 		default:
 			r.selectValues.Set(columns[i], values[i])
 		}
@@ -129,8 +153,17 @@ func (r *Role) String() string {
 	builder.WriteString("deleted_at=")
 	builder.WriteString(fmt.Sprintf("%v", r.DeletedAt))
 	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(r.Name)
+	builder.WriteString(", ")
+	builder.WriteString("data_scope=")
+	builder.WriteString(r.DataScope)
+	builder.WriteString(", ")
+	builder.WriteString("data_scope_departments=")
+	builder.WriteString(r.DataScopeDepartments)
 	builder.WriteByte(')')
 	return builder.String()
 }
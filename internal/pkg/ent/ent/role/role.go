@@ -20,8 +20,14 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldDataScope holds the string denoting the data_scope field in the database.
+	FieldDataScope = "data_scope"
+	// FieldDataScopeDepartments holds the string denoting the data_scope_departments field in the database.
+	FieldDataScopeDepartments = "data_scope_departments"
 	// Table holds the table name of the role in the database.
 	Table = "roles"
 )
@@ -32,7 +38,10 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldDeletedAt,
+	FieldTenantID,
 	FieldName,
+	FieldDataScope,
+	FieldDataScopeDepartments,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -59,8 +68,14 @@ var (
 	DefaultUpdatedAt func() types.UnixTimestamp
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() types.UnixTimestamp
+	// DefaultTenantID holds the default value on creation for the "tenant_id" field.
+	DefaultTenantID int64
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultDataScope holds the default value on creation for the "data_scope" field.
+	DefaultDataScope string
+	// DefaultDataScopeDepartments holds the default value on creation for the "data_scope_departments" field.
+	DefaultDataScopeDepartments string
 )
 
 // OrderOption defines the ordering options for the Role queries.
@@ -86,7 +101,22 @@ func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
 
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
+
+// ByDataScope orders the results by the data_scope field.
+func ByDataScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataScope, opts...).ToFunc()
+}
+
+// ByDataScopeDepartments orders the results by the data_scope_departments field.
+func ByDataScopeDepartments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataScopeDepartments, opts...).ToFunc()
+}
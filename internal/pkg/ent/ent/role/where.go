@@ -69,11 +69,26 @@ func DeletedAt(v types.UnixTimestamp) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldDeletedAt, v))
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int64) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldTenantID, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldName, v))
 }
 
+// DataScope applies equality check predicate on the "data_scope" field. It's identical to DataScopeEQ.
+func DataScope(v string) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldDataScope, v))
+}
+
+// DataScopeDepartments applies equality check predicate on the "data_scope_departments" field. It's identical to DataScopeDepartmentsEQ.
+func DataScopeDepartments(v string) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldDataScopeDepartments, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v types.UnixTimestamp) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldCreatedAt, v))
@@ -204,6 +219,46 @@ func DeletedAtNotNil() predicate.Role {
 	return predicate.Role(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int64) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int64) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int64) predicate.Role {
+	return predicate.Role(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int64) predicate.Role {
+	return predicate.Role(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int64) predicate.Role {
+	return predicate.Role(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int64) predicate.Role {
+	return predicate.Role(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int64) predicate.Role {
+	return predicate.Role(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int64) predicate.Role {
+	return predicate.Role(sql.FieldLTE(FieldTenantID, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Role {
 	return predicate.Role(sql.FieldEQ(FieldName, v))
@@ -269,6 +324,136 @@ func NameContainsFold(v string) predicate.Role {
 	return predicate.Role(sql.FieldContainsFold(FieldName, v))
 }
 
+// DataScopeEQ applies the EQ predicate on the "data_scope" field.
+func DataScopeEQ(v string) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldDataScope, v))
+}
+
+// DataScopeNEQ applies the NEQ predicate on the "data_scope" field.
+func DataScopeNEQ(v string) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldDataScope, v))
+}
+
+// DataScopeIn applies the In predicate on the "data_scope" field.
+func DataScopeIn(vs ...string) predicate.Role {
+	return predicate.Role(sql.FieldIn(FieldDataScope, vs...))
+}
+
+// DataScopeNotIn applies the NotIn predicate on the "data_scope" field.
+func DataScopeNotIn(vs ...string) predicate.Role {
+	return predicate.Role(sql.FieldNotIn(FieldDataScope, vs...))
+}
+
+// DataScopeGT applies the GT predicate on the "data_scope" field.
+func DataScopeGT(v string) predicate.Role {
+	return predicate.Role(sql.FieldGT(FieldDataScope, v))
+}
+
+// DataScopeGTE applies the GTE predicate on the "data_scope" field.
+func DataScopeGTE(v string) predicate.Role {
+	return predicate.Role(sql.FieldGTE(FieldDataScope, v))
+}
+
+// DataScopeLT applies the LT predicate on the "data_scope" field.
+func DataScopeLT(v string) predicate.Role {
+	return predicate.Role(sql.FieldLT(FieldDataScope, v))
+}
+
+// DataScopeLTE applies the LTE predicate on the "data_scope" field.
+func DataScopeLTE(v string) predicate.Role {
+	return predicate.Role(sql.FieldLTE(FieldDataScope, v))
+}
+
+// DataScopeContains applies the Contains predicate on the "data_scope" field.
+func DataScopeContains(v string) predicate.Role {
+	return predicate.Role(sql.FieldContains(FieldDataScope, v))
+}
+
+// DataScopeHasPrefix applies the HasPrefix predicate on the "data_scope" field.
+func DataScopeHasPrefix(v string) predicate.Role {
+	return predicate.Role(sql.FieldHasPrefix(FieldDataScope, v))
+}
+
+// DataScopeHasSuffix applies the HasSuffix predicate on the "data_scope" field.
+func DataScopeHasSuffix(v string) predicate.Role {
+	return predicate.Role(sql.FieldHasSuffix(FieldDataScope, v))
+}
+
+// DataScopeEqualFold applies the EqualFold predicate on the "data_scope" field.
+func DataScopeEqualFold(v string) predicate.Role {
+	return predicate.Role(sql.FieldEqualFold(FieldDataScope, v))
+}
+
+// DataScopeContainsFold applies the ContainsFold predicate on the "data_scope" field.
+func DataScopeContainsFold(v string) predicate.Role {
+	return predicate.Role(sql.FieldContainsFold(FieldDataScope, v))
+}
+
+// DataScopeDepartmentsEQ applies the EQ predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsEQ(v string) predicate.Role {
+	return predicate.Role(sql.FieldEQ(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsNEQ applies the NEQ predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsNEQ(v string) predicate.Role {
+	return predicate.Role(sql.FieldNEQ(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsIn applies the In predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsIn(vs ...string) predicate.Role {
+	return predicate.Role(sql.FieldIn(FieldDataScopeDepartments, vs...))
+}
+
+// DataScopeDepartmentsNotIn applies the NotIn predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsNotIn(vs ...string) predicate.Role {
+	return predicate.Role(sql.FieldNotIn(FieldDataScopeDepartments, vs...))
+}
+
+// DataScopeDepartmentsGT applies the GT predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsGT(v string) predicate.Role {
+	return predicate.Role(sql.FieldGT(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsGTE applies the GTE predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsGTE(v string) predicate.Role {
+	return predicate.Role(sql.FieldGTE(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsLT applies the LT predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsLT(v string) predicate.Role {
+	return predicate.Role(sql.FieldLT(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsLTE applies the LTE predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsLTE(v string) predicate.Role {
+	return predicate.Role(sql.FieldLTE(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsContains applies the Contains predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsContains(v string) predicate.Role {
+	return predicate.Role(sql.FieldContains(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsHasPrefix applies the HasPrefix predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsHasPrefix(v string) predicate.Role {
+	return predicate.Role(sql.FieldHasPrefix(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsHasSuffix applies the HasSuffix predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsHasSuffix(v string) predicate.Role {
+	return predicate.Role(sql.FieldHasSuffix(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsEqualFold applies the EqualFold predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsEqualFold(v string) predicate.Role {
+	return predicate.Role(sql.FieldEqualFold(FieldDataScopeDepartments, v))
+}
+
+// DataScopeDepartmentsContainsFold applies the ContainsFold predicate on the "data_scope_departments" field.
+func DataScopeDepartmentsContainsFold(v string) predicate.Role {
+	return predicate.Role(sql.FieldContainsFold(FieldDataScopeDepartments, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Role) predicate.Role {
 	return predicate.Role(sql.AndPredicates(predicates...))
@@ -62,12 +62,54 @@ func (rc *RoleCreate) SetNillableDeletedAt(tt *types.UnixTimestamp) *RoleCreate
 	return rc
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (rc *RoleCreate) SetTenantID(i int64) *RoleCreate {
+	rc.mutation.SetTenantID(i)
+	return rc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableTenantID(i *int64) *RoleCreate {
+	if i != nil {
+		rc.SetTenantID(*i)
+	}
+	return rc
+}
+
 // SetName sets the "name" field.
 func (rc *RoleCreate) SetName(s string) *RoleCreate {
 	rc.mutation.SetName(s)
 	return rc
 }
 
+// SetDataScope sets the "data_scope" field.
+func (rc *RoleCreate) SetDataScope(s string) *RoleCreate {
+	rc.mutation.SetDataScope(s)
+	return rc
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableDataScope(s *string) *RoleCreate {
+	if s != nil {
+		rc.SetDataScope(*s)
+	}
+	return rc
+}
+
+// SetDataScopeDepartments sets the "data_scope_departments" field.
+func (rc *RoleCreate) SetDataScopeDepartments(s string) *RoleCreate {
+	rc.mutation.SetDataScopeDepartments(s)
+	return rc
+}
+
+// SetNillableDataScopeDepartments sets the "data_scope_departments" field if the given value is not nil.
+func (rc *RoleCreate) SetNillableDataScopeDepartments(s *string) *RoleCreate {
+	if s != nil {
+		rc.SetDataScopeDepartments(*s)
+	}
+	return rc
+}
+
 // SetID sets the "id" field.
 func (rc *RoleCreate) SetID(i int64) *RoleCreate {
 	rc.mutation.SetID(i)
@@ -125,6 +167,18 @@ func (rc *RoleCreate) defaults() error {
 		v := role.DefaultUpdatedAt()
 		rc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := rc.mutation.TenantID(); !ok {
+		v := role.DefaultTenantID
+		rc.mutation.SetTenantID(v)
+	}
+	if _, ok := rc.mutation.DataScope(); !ok {
+		v := role.DefaultDataScope
+		rc.mutation.SetDataScope(v)
+	}
+	if _, ok := rc.mutation.DataScopeDepartments(); !ok {
+		v := role.DefaultDataScopeDepartments
+		rc.mutation.SetDataScopeDepartments(v)
+	}
 	return nil
 }
 
@@ -136,6 +190,9 @@ func (rc *RoleCreate) check() error {
 	if _, ok := rc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Role.updated_at"`)}
 	}
+	if _, ok := rc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Role.tenant_id"`)}
+	}
 	if _, ok := rc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Role.name"`)}
 	}
@@ -144,6 +201,12 @@ func (rc *RoleCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Role.name": %w`, err)}
 		}
 	}
+	if _, ok := rc.mutation.DataScope(); !ok {
+		return &ValidationError{Name: "data_scope", err: errors.New(`ent: missing required field "Role.data_scope"`)}
+	}
+	if _, ok := rc.mutation.DataScopeDepartments(); !ok {
+		return &ValidationError{Name: "data_scope_departments", err: errors.New(`ent: missing required field "Role.data_scope_departments"`)}
+	}
 	return nil
 }
 
@@ -188,10 +251,22 @@ func (rc *RoleCreate) createSpec() (*Role, *sqlgraph.CreateSpec) {
 		_spec.SetField(role.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := rc.mutation.TenantID(); ok {
+		_spec.SetField(role.FieldTenantID, field.TypeInt64, value)
+		_node.TenantID = value
+	}
 	if value, ok := rc.mutation.Name(); ok {
 		_spec.SetField(role.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := rc.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeString, value)
+		_node.DataScope = value
+	}
+	if value, ok := rc.mutation.DataScopeDepartments(); ok {
+		_spec.SetField(role.FieldDataScopeDepartments, field.TypeString, value)
+		_node.DataScopeDepartments = value
+	}
 	return _node, _spec
 }
 
@@ -49,6 +49,27 @@ func (ru *RoleUpdate) ClearDeletedAt() *RoleUpdate {
 	return ru
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (ru *RoleUpdate) SetTenantID(i int64) *RoleUpdate {
+	ru.mutation.ResetTenantID()
+	ru.mutation.SetTenantID(i)
+	return ru
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableTenantID(i *int64) *RoleUpdate {
+	if i != nil {
+		ru.SetTenantID(*i)
+	}
+	return ru
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ru *RoleUpdate) AddTenantID(i int64) *RoleUpdate {
+	ru.mutation.AddTenantID(i)
+	return ru
+}
+
 // SetName sets the "name" field.
 func (ru *RoleUpdate) SetName(s string) *RoleUpdate {
 	ru.mutation.SetName(s)
@@ -63,6 +84,34 @@ func (ru *RoleUpdate) SetNillableName(s *string) *RoleUpdate {
 	return ru
 }
 
+// SetDataScope sets the "data_scope" field.
+func (ru *RoleUpdate) SetDataScope(s string) *RoleUpdate {
+	ru.mutation.SetDataScope(s)
+	return ru
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableDataScope(s *string) *RoleUpdate {
+	if s != nil {
+		ru.SetDataScope(*s)
+	}
+	return ru
+}
+
+// SetDataScopeDepartments sets the "data_scope_departments" field.
+func (ru *RoleUpdate) SetDataScopeDepartments(s string) *RoleUpdate {
+	ru.mutation.SetDataScopeDepartments(s)
+	return ru
+}
+
+// SetNillableDataScopeDepartments sets the "data_scope_departments" field if the given value is not nil.
+func (ru *RoleUpdate) SetNillableDataScopeDepartments(s *string) *RoleUpdate {
+	if s != nil {
+		ru.SetDataScopeDepartments(*s)
+	}
+	return ru
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ru *RoleUpdate) Mutation() *RoleMutation {
 	return ru.mutation
@@ -147,9 +196,21 @@ func (ru *RoleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.DeletedAtCleared() {
 		_spec.ClearField(role.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := ru.mutation.TenantID(); ok {
+		_spec.SetField(role.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedTenantID(); ok {
+		_spec.AddField(role.FieldTenantID, field.TypeInt64, value)
+	}
 	if value, ok := ru.mutation.Name(); ok {
 		_spec.SetField(role.FieldName, field.TypeString, value)
 	}
+	if value, ok := ru.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.DataScopeDepartments(); ok {
+		_spec.SetField(role.FieldDataScopeDepartments, field.TypeString, value)
+	}
 	_spec.AddModifiers(ru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -192,6 +253,27 @@ func (ruo *RoleUpdateOne) ClearDeletedAt() *RoleUpdateOne {
 	return ruo
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (ruo *RoleUpdateOne) SetTenantID(i int64) *RoleUpdateOne {
+	ruo.mutation.ResetTenantID()
+	ruo.mutation.SetTenantID(i)
+	return ruo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableTenantID(i *int64) *RoleUpdateOne {
+	if i != nil {
+		ruo.SetTenantID(*i)
+	}
+	return ruo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (ruo *RoleUpdateOne) AddTenantID(i int64) *RoleUpdateOne {
+	ruo.mutation.AddTenantID(i)
+	return ruo
+}
+
 // SetName sets the "name" field.
 func (ruo *RoleUpdateOne) SetName(s string) *RoleUpdateOne {
 	ruo.mutation.SetName(s)
@@ -206,6 +288,34 @@ func (ruo *RoleUpdateOne) SetNillableName(s *string) *RoleUpdateOne {
 	return ruo
 }
 
+// SetDataScope sets the "data_scope" field.
+func (ruo *RoleUpdateOne) SetDataScope(s string) *RoleUpdateOne {
+	ruo.mutation.SetDataScope(s)
+	return ruo
+}
+
+// SetNillableDataScope sets the "data_scope" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableDataScope(s *string) *RoleUpdateOne {
+	if s != nil {
+		ruo.SetDataScope(*s)
+	}
+	return ruo
+}
+
+// SetDataScopeDepartments sets the "data_scope_departments" field.
+func (ruo *RoleUpdateOne) SetDataScopeDepartments(s string) *RoleUpdateOne {
+	ruo.mutation.SetDataScopeDepartments(s)
+	return ruo
+}
+
+// SetNillableDataScopeDepartments sets the "data_scope_departments" field if the given value is not nil.
+func (ruo *RoleUpdateOne) SetNillableDataScopeDepartments(s *string) *RoleUpdateOne {
+	if s != nil {
+		ruo.SetDataScopeDepartments(*s)
+	}
+	return ruo
+}
+
 // Mutation returns the RoleMutation object of the builder.
 func (ruo *RoleUpdateOne) Mutation() *RoleMutation {
 	return ruo.mutation
@@ -320,9 +430,21 @@ func (ruo *RoleUpdateOne) sqlSave(ctx context.Context) (_node *Role, err error)
 	if ruo.mutation.DeletedAtCleared() {
 		_spec.ClearField(role.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := ruo.mutation.TenantID(); ok {
+		_spec.SetField(role.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedTenantID(); ok {
+		_spec.AddField(role.FieldTenantID, field.TypeInt64, value)
+	}
 	if value, ok := ruo.mutation.Name(); ok {
 		_spec.SetField(role.FieldName, field.TypeString, value)
 	}
+	if value, ok := ruo.mutation.DataScope(); ok {
+		_spec.SetField(role.FieldDataScope, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.DataScopeDepartments(); ok {
+		_spec.SetField(role.FieldDataScopeDepartments, field.TypeString, value)
+	}
 	_spec.AddModifiers(ruo.modifiers...)
 	_node = &Role{config: ruo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RoleGrant is the model entity for the RoleGrant schema.
+type RoleGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt types.UnixTimestamp `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt types.UnixTimestamp `json:"updated_at,omitempty"`
+	// 租户 id
+	TenantID int64 `json:"tenant_id,omitempty"`
+	// 用户 id
+	UserID int64 `json:"user_id,omitempty"`
+	// 角色 id
+	RoleID int64 `json:"role_id,omitempty"`
+	// 生效时间
+	StartsAt int64 `json:"starts_at,omitempty"`
+	// 过期时间
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// 策略写入时间，0 为尚未写入
+	ActivatedAt  int64 `json:"activated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoleGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolegrant.FieldID, rolegrant.FieldTenantID, rolegrant.FieldUserID, rolegrant.FieldRoleID, rolegrant.FieldStartsAt, rolegrant.FieldExpiresAt, rolegrant.FieldActivatedAt:
+			values[i] = new(sql.NullInt64)
+		case rolegrant.FieldCreatedAt, rolegrant.FieldUpdatedAt:
+			values[i] = new(types.UnixTimestamp)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoleGrant fields.
+func (rg *RoleGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolegrant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rg.ID = int64(value.Int64)
+		case rolegrant.FieldCreatedAt:
+			if value, ok := values[i].(*types.UnixTimestamp); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value != nil {
+				rg.CreatedAt = *value
+			}
+		case rolegrant.FieldUpdatedAt:
+			if value, ok := values[i].(*types.UnixTimestamp); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value != nil {
+				rg.UpdatedAt = *value
+			}
+		case rolegrant.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				rg.TenantID = value.Int64
+			}
+		case rolegrant.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rg.UserID = value.Int64
+			}
+		case rolegrant.FieldRoleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				rg.RoleID = value.Int64
+			}
+		case rolegrant.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				rg.StartsAt = value.Int64
+			}
+		case rolegrant.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rg.ExpiresAt = value.Int64
+			}
+		case rolegrant.FieldActivatedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field activated_at", values[i])
+			} else if value.Valid {
+				rg.ActivatedAt = value.Int64
+			}
+		default:
+			rg.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoleGrant.
+// This includes values selected through modifiers, order, etc.
+func (rg *RoleGrant) Value(name string) (ent.Value, error) {
+	return rg.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RoleGrant.
+// Note that you need to call RoleGrant.Unwrap() before calling this method if this RoleGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rg *RoleGrant) Update() *RoleGrantUpdateOne {
+	return NewRoleGrantClient(rg.config).UpdateOne(rg)
+}
+
+// Unwrap unwraps the RoleGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rg *RoleGrant) Unwrap() *RoleGrant {
+	_tx, ok := rg.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoleGrant is not a transactional entity")
+	}
+	rg.config.driver = _tx.drv
+	return rg
+}
+
+// String implements the fmt.Stringer.
+func (rg *RoleGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoleGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rg.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(fmt.Sprintf("%v", rg.CreatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(fmt.Sprintf("%v", rg.UpdatedAt))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", rg.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rg.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(fmt.Sprintf("%v", rg.RoleID))
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(fmt.Sprintf("%v", rg.StartsAt))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(fmt.Sprintf("%v", rg.ExpiresAt))
+	builder.WriteString(", ")
+	builder.WriteString("activated_at=")
+	builder.WriteString(fmt.Sprintf("%v", rg.ActivatedAt))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RoleGrants is a parsable slice of RoleGrant.
+type RoleGrants []*RoleGrant
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoleGrantCreate is the builder for creating a RoleGrant entity.
+type RoleGrantCreate struct {
+	config
+	mutation *RoleGrantMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rgc *RoleGrantCreate) SetCreatedAt(tt types.UnixTimestamp) *RoleGrantCreate {
+	rgc.mutation.SetCreatedAt(tt)
+	return rgc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableCreatedAt(tt *types.UnixTimestamp) *RoleGrantCreate {
+	if tt != nil {
+		rgc.SetCreatedAt(*tt)
+	}
+	return rgc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rgc *RoleGrantCreate) SetUpdatedAt(tt types.UnixTimestamp) *RoleGrantCreate {
+	rgc.mutation.SetUpdatedAt(tt)
+	return rgc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableUpdatedAt(tt *types.UnixTimestamp) *RoleGrantCreate {
+	if tt != nil {
+		rgc.SetUpdatedAt(*tt)
+	}
+	return rgc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (rgc *RoleGrantCreate) SetTenantID(i int64) *RoleGrantCreate {
+	rgc.mutation.SetTenantID(i)
+	return rgc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableTenantID(i *int64) *RoleGrantCreate {
+	if i != nil {
+		rgc.SetTenantID(*i)
+	}
+	return rgc
+}
+
+// SetUserID sets the "user_id" field.
+func (rgc *RoleGrantCreate) SetUserID(i int64) *RoleGrantCreate {
+	rgc.mutation.SetUserID(i)
+	return rgc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableUserID(i *int64) *RoleGrantCreate {
+	if i != nil {
+		rgc.SetUserID(*i)
+	}
+	return rgc
+}
+
+// SetRoleID sets the "role_id" field.
+func (rgc *RoleGrantCreate) SetRoleID(i int64) *RoleGrantCreate {
+	rgc.mutation.SetRoleID(i)
+	return rgc
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableRoleID(i *int64) *RoleGrantCreate {
+	if i != nil {
+		rgc.SetRoleID(*i)
+	}
+	return rgc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rgc *RoleGrantCreate) SetStartsAt(i int64) *RoleGrantCreate {
+	rgc.mutation.SetStartsAt(i)
+	return rgc
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableStartsAt(i *int64) *RoleGrantCreate {
+	if i != nil {
+		rgc.SetStartsAt(*i)
+	}
+	return rgc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rgc *RoleGrantCreate) SetExpiresAt(i int64) *RoleGrantCreate {
+	rgc.mutation.SetExpiresAt(i)
+	return rgc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableExpiresAt(i *int64) *RoleGrantCreate {
+	if i != nil {
+		rgc.SetExpiresAt(*i)
+	}
+	return rgc
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (rgc *RoleGrantCreate) SetActivatedAt(i int64) *RoleGrantCreate {
+	rgc.mutation.SetActivatedAt(i)
+	return rgc
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (rgc *RoleGrantCreate) SetNillableActivatedAt(i *int64) *RoleGrantCreate {
+	if i != nil {
+		rgc.SetActivatedAt(*i)
+	}
+	return rgc
+}
+
+// SetID sets the "id" field.
+func (rgc *RoleGrantCreate) SetID(i int64) *RoleGrantCreate {
+	rgc.mutation.SetID(i)
+	return rgc
+}
+
+// Mutation returns the RoleGrantMutation object of the builder.
+func (rgc *RoleGrantCreate) Mutation() *RoleGrantMutation {
+	return rgc.mutation
+}
+
+// Save creates the RoleGrant in the database.
+func (rgc *RoleGrantCreate) Save(ctx context.Context) (*RoleGrant, error) {
+	rgc.defaults()
+	return withHooks(ctx, rgc.sqlSave, rgc.mutation, rgc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rgc *RoleGrantCreate) SaveX(ctx context.Context) *RoleGrant {
+	v, err := rgc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rgc *RoleGrantCreate) Exec(ctx context.Context) error {
+	_, err := rgc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgc *RoleGrantCreate) ExecX(ctx context.Context) {
+	if err := rgc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rgc *RoleGrantCreate) defaults() {
+	if _, ok := rgc.mutation.CreatedAt(); !ok {
+		v := rolegrant.DefaultCreatedAt()
+		rgc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rgc.mutation.UpdatedAt(); !ok {
+		v := rolegrant.DefaultUpdatedAt()
+		rgc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rgc.mutation.TenantID(); !ok {
+		v := rolegrant.DefaultTenantID
+		rgc.mutation.SetTenantID(v)
+	}
+	if _, ok := rgc.mutation.UserID(); !ok {
+		v := rolegrant.DefaultUserID
+		rgc.mutation.SetUserID(v)
+	}
+	if _, ok := rgc.mutation.RoleID(); !ok {
+		v := rolegrant.DefaultRoleID
+		rgc.mutation.SetRoleID(v)
+	}
+	if _, ok := rgc.mutation.StartsAt(); !ok {
+		v := rolegrant.DefaultStartsAt
+		rgc.mutation.SetStartsAt(v)
+	}
+	if _, ok := rgc.mutation.ExpiresAt(); !ok {
+		v := rolegrant.DefaultExpiresAt
+		rgc.mutation.SetExpiresAt(v)
+	}
+	if _, ok := rgc.mutation.ActivatedAt(); !ok {
+		v := rolegrant.DefaultActivatedAt
+		rgc.mutation.SetActivatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rgc *RoleGrantCreate) check() error {
+	if _, ok := rgc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RoleGrant.created_at"`)}
+	}
+	if _, ok := rgc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RoleGrant.updated_at"`)}
+	}
+	if _, ok := rgc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "RoleGrant.tenant_id"`)}
+	}
+	if _, ok := rgc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RoleGrant.user_id"`)}
+	}
+	if _, ok := rgc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "RoleGrant.role_id"`)}
+	}
+	if _, ok := rgc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "RoleGrant.starts_at"`)}
+	}
+	if _, ok := rgc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RoleGrant.expires_at"`)}
+	}
+	if _, ok := rgc.mutation.ActivatedAt(); !ok {
+		return &ValidationError{Name: "activated_at", err: errors.New(`ent: missing required field "RoleGrant.activated_at"`)}
+	}
+	return nil
+}
+
+func (rgc *RoleGrantCreate) sqlSave(ctx context.Context) (*RoleGrant, error) {
+	if err := rgc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rgc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rgc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	rgc.mutation.id = &_node.ID
+	rgc.mutation.done = true
+	return _node, nil
+}
+
+func (rgc *RoleGrantCreate) createSpec() (*RoleGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoleGrant{config: rgc.config}
+		_spec = sqlgraph.NewCreateSpec(rolegrant.Table, sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt64))
+	)
+	if id, ok := rgc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rgc.mutation.CreatedAt(); ok {
+		_spec.SetField(rolegrant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rgc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolegrant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rgc.mutation.TenantID(); ok {
+		_spec.SetField(rolegrant.FieldTenantID, field.TypeInt64, value)
+		_node.TenantID = value
+	}
+	if value, ok := rgc.mutation.UserID(); ok {
+		_spec.SetField(rolegrant.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := rgc.mutation.RoleID(); ok {
+		_spec.SetField(rolegrant.FieldRoleID, field.TypeInt64, value)
+		_node.RoleID = value
+	}
+	if value, ok := rgc.mutation.StartsAt(); ok {
+		_spec.SetField(rolegrant.FieldStartsAt, field.TypeInt64, value)
+		_node.StartsAt = value
+	}
+	if value, ok := rgc.mutation.ExpiresAt(); ok {
+		_spec.SetField(rolegrant.FieldExpiresAt, field.TypeInt64, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := rgc.mutation.ActivatedAt(); ok {
+		_spec.SetField(rolegrant.FieldActivatedAt, field.TypeInt64, value)
+		_node.ActivatedAt = value
+	}
+	return _node, _spec
+}
+
+// RoleGrantCreateBulk is the builder for creating many RoleGrant entities in bulk.
+type RoleGrantCreateBulk struct {
+	config
+	err      error
+	builders []*RoleGrantCreate
+}
+
+// Save creates the RoleGrant entities in the database.
+func (rgcb *RoleGrantCreateBulk) Save(ctx context.Context) ([]*RoleGrant, error) {
+	if rgcb.err != nil {
+		return nil, rgcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rgcb.builders))
+	nodes := make([]*RoleGrant, len(rgcb.builders))
+	mutators := make([]Mutator, len(rgcb.builders))
+	for i := range rgcb.builders {
+		func(i int, root context.Context) {
+			builder := rgcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoleGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rgcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rgcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rgcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rgcb *RoleGrantCreateBulk) SaveX(ctx context.Context) []*RoleGrant {
+	v, err := rgcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rgcb *RoleGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := rgcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgcb *RoleGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := rgcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoleGrantQuery is the builder for querying RoleGrant entities.
+type RoleGrantQuery struct {
+	config
+	ctx        *QueryContext
+	order      []rolegrant.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RoleGrant
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoleGrantQuery builder.
+func (rgq *RoleGrantQuery) Where(ps ...predicate.RoleGrant) *RoleGrantQuery {
+	rgq.predicates = append(rgq.predicates, ps...)
+	return rgq
+}
+
+// Limit the number of records to be returned by this query.
+func (rgq *RoleGrantQuery) Limit(limit int) *RoleGrantQuery {
+	rgq.ctx.Limit = &limit
+	return rgq
+}
+
+// Offset to start from.
+func (rgq *RoleGrantQuery) Offset(offset int) *RoleGrantQuery {
+	rgq.ctx.Offset = &offset
+	return rgq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rgq *RoleGrantQuery) Unique(unique bool) *RoleGrantQuery {
+	rgq.ctx.Unique = &unique
+	return rgq
+}
+
+// Order specifies how the records should be ordered.
+func (rgq *RoleGrantQuery) Order(o ...rolegrant.OrderOption) *RoleGrantQuery {
+	rgq.order = append(rgq.order, o...)
+	return rgq
+}
+
+// First returns the first RoleGrant entity from the query.
+// Returns a *NotFoundError when no RoleGrant was found.
+func (rgq *RoleGrantQuery) First(ctx context.Context) (*RoleGrant, error) {
+	nodes, err := rgq.Limit(1).All(setContextOp(ctx, rgq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rolegrant.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rgq *RoleGrantQuery) FirstX(ctx context.Context) *RoleGrant {
+	node, err := rgq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoleGrant ID from the query.
+// Returns a *NotFoundError when no RoleGrant ID was found.
+func (rgq *RoleGrantQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = rgq.Limit(1).IDs(setContextOp(ctx, rgq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rolegrant.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rgq *RoleGrantQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := rgq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoleGrant entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoleGrant entity is found.
+// Returns a *NotFoundError when no RoleGrant entities are found.
+func (rgq *RoleGrantQuery) Only(ctx context.Context) (*RoleGrant, error) {
+	nodes, err := rgq.Limit(2).All(setContextOp(ctx, rgq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rolegrant.Label}
+	default:
+		return nil, &NotSingularError{rolegrant.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rgq *RoleGrantQuery) OnlyX(ctx context.Context) *RoleGrant {
+	node, err := rgq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoleGrant ID in the query.
+// Returns a *NotSingularError when more than one RoleGrant ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rgq *RoleGrantQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = rgq.Limit(2).IDs(setContextOp(ctx, rgq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rolegrant.Label}
+	default:
+		err = &NotSingularError{rolegrant.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rgq *RoleGrantQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := rgq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoleGrants.
+func (rgq *RoleGrantQuery) All(ctx context.Context) ([]*RoleGrant, error) {
+	ctx = setContextOp(ctx, rgq.ctx, ent.OpQueryAll)
+	if err := rgq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoleGrant, *RoleGrantQuery]()
+	return withInterceptors[[]*RoleGrant](ctx, rgq, qr, rgq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rgq *RoleGrantQuery) AllX(ctx context.Context) []*RoleGrant {
+	nodes, err := rgq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoleGrant IDs.
+func (rgq *RoleGrantQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if rgq.ctx.Unique == nil && rgq.path != nil {
+		rgq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rgq.ctx, ent.OpQueryIDs)
+	if err = rgq.Select(rolegrant.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rgq *RoleGrantQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := rgq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rgq *RoleGrantQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rgq.ctx, ent.OpQueryCount)
+	if err := rgq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rgq, querierCount[*RoleGrantQuery](), rgq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rgq *RoleGrantQuery) CountX(ctx context.Context) int {
+	count, err := rgq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rgq *RoleGrantQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rgq.ctx, ent.OpQueryExist)
+	switch _, err := rgq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rgq *RoleGrantQuery) ExistX(ctx context.Context) bool {
+	exist, err := rgq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoleGrantQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rgq *RoleGrantQuery) Clone() *RoleGrantQuery {
+	if rgq == nil {
+		return nil
+	}
+	return &RoleGrantQuery{
+		config:     rgq.config,
+		ctx:        rgq.ctx.Clone(),
+		order:      append([]rolegrant.OrderOption{}, rgq.order...),
+		inters:     append([]Interceptor{}, rgq.inters...),
+		predicates: append([]predicate.RoleGrant{}, rgq.predicates...),
+		// clone intermediate query.
+		sql:  rgq.sql.Clone(),
+		path: rgq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt types.UnixTimestamp `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoleGrant.Query().
+//		GroupBy(rolegrant.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rgq *RoleGrantQuery) GroupBy(field string, fields ...string) *RoleGrantGroupBy {
+	rgq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoleGrantGroupBy{build: rgq}
+	grbuild.flds = &rgq.ctx.Fields
+	grbuild.label = rolegrant.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt types.UnixTimestamp `json:"created_at,omitempty"`
+//	}
+//
+//	client.RoleGrant.Query().
+//		Select(rolegrant.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (rgq *RoleGrantQuery) Select(fields ...string) *RoleGrantSelect {
+	rgq.ctx.Fields = append(rgq.ctx.Fields, fields...)
+	sbuild := &RoleGrantSelect{RoleGrantQuery: rgq}
+	sbuild.label = rolegrant.Label
+	sbuild.flds, sbuild.scan = &rgq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoleGrantSelect configured with the given aggregations.
+func (rgq *RoleGrantQuery) Aggregate(fns ...AggregateFunc) *RoleGrantSelect {
+	return rgq.Select().Aggregate(fns...)
+}
+
+func (rgq *RoleGrantQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rgq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rgq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rgq.ctx.Fields {
+		if !rolegrant.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rgq.path != nil {
+		prev, err := rgq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rgq.sql = prev
+	}
+	return nil
+}
+
+func (rgq *RoleGrantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoleGrant, error) {
+	var (
+		nodes = []*RoleGrant{}
+		_spec = rgq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoleGrant).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoleGrant{config: rgq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(rgq.modifiers) > 0 {
+		_spec.Modifiers = rgq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rgq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rgq *RoleGrantQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rgq.querySpec()
+	if len(rgq.modifiers) > 0 {
+		_spec.Modifiers = rgq.modifiers
+	}
+	_spec.Node.Columns = rgq.ctx.Fields
+	if len(rgq.ctx.Fields) > 0 {
+		_spec.Unique = rgq.ctx.Unique != nil && *rgq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rgq.driver, _spec)
+}
+
+func (rgq *RoleGrantQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rolegrant.Table, rolegrant.Columns, sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt64))
+	_spec.From = rgq.sql
+	if unique := rgq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rgq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rgq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolegrant.FieldID)
+		for i := range fields {
+			if fields[i] != rolegrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rgq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rgq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rgq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rgq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rgq *RoleGrantQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rgq.driver.Dialect())
+	t1 := builder.Table(rolegrant.Table)
+	columns := rgq.ctx.Fields
+	if len(columns) == 0 {
+		columns = rolegrant.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rgq.sql != nil {
+		selector = rgq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rgq.ctx.Unique != nil && *rgq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rgq.modifiers {
+		m(selector)
+	}
+	for _, p := range rgq.predicates {
+		p(selector)
+	}
+	for _, p := range rgq.order {
+		p(selector)
+	}
+	if offset := rgq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rgq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rgq *RoleGrantQuery) Modify(modifiers ...func(s *sql.Selector)) *RoleGrantSelect {
+	rgq.modifiers = append(rgq.modifiers, modifiers...)
+	return rgq.Select()
+}
+
+// RoleGrantGroupBy is the group-by builder for RoleGrant entities.
+type RoleGrantGroupBy struct {
+	selector
+	build *RoleGrantQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rggb *RoleGrantGroupBy) Aggregate(fns ...AggregateFunc) *RoleGrantGroupBy {
+	rggb.fns = append(rggb.fns, fns...)
+	return rggb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rggb *RoleGrantGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rggb.build.ctx, ent.OpQueryGroupBy)
+	if err := rggb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleGrantQuery, *RoleGrantGroupBy](ctx, rggb.build, rggb, rggb.build.inters, v)
+}
+
+func (rggb *RoleGrantGroupBy) sqlScan(ctx context.Context, root *RoleGrantQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rggb.fns))
+	for _, fn := range rggb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rggb.flds)+len(rggb.fns))
+		for _, f := range *rggb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rggb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rggb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoleGrantSelect is the builder for selecting fields of RoleGrant entities.
+type RoleGrantSelect struct {
+	*RoleGrantQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rgs *RoleGrantSelect) Aggregate(fns ...AggregateFunc) *RoleGrantSelect {
+	rgs.fns = append(rgs.fns, fns...)
+	return rgs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rgs *RoleGrantSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rgs.ctx, ent.OpQuerySelect)
+	if err := rgs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoleGrantQuery, *RoleGrantSelect](ctx, rgs.RoleGrantQuery, rgs, rgs.inters, v)
+}
+
+func (rgs *RoleGrantSelect) sqlScan(ctx context.Context, root *RoleGrantQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rgs.fns))
+	for _, fn := range rgs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rgs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rgs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rgs *RoleGrantSelect) Modify(modifiers ...func(s *sql.Selector)) *RoleGrantSelect {
+	rgs.modifiers = append(rgs.modifiers, modifiers...)
+	return rgs
+}
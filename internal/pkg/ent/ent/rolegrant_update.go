@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoleGrantUpdate is the builder for updating RoleGrant entities.
+type RoleGrantUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RoleGrantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RoleGrantUpdate builder.
+func (rgu *RoleGrantUpdate) Where(ps ...predicate.RoleGrant) *RoleGrantUpdate {
+	rgu.mutation.Where(ps...)
+	return rgu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (rgu *RoleGrantUpdate) SetTenantID(i int64) *RoleGrantUpdate {
+	rgu.mutation.ResetTenantID()
+	rgu.mutation.SetTenantID(i)
+	return rgu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (rgu *RoleGrantUpdate) SetNillableTenantID(i *int64) *RoleGrantUpdate {
+	if i != nil {
+		rgu.SetTenantID(*i)
+	}
+	return rgu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (rgu *RoleGrantUpdate) AddTenantID(i int64) *RoleGrantUpdate {
+	rgu.mutation.AddTenantID(i)
+	return rgu
+}
+
+// SetUserID sets the "user_id" field.
+func (rgu *RoleGrantUpdate) SetUserID(i int64) *RoleGrantUpdate {
+	rgu.mutation.ResetUserID()
+	rgu.mutation.SetUserID(i)
+	return rgu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rgu *RoleGrantUpdate) SetNillableUserID(i *int64) *RoleGrantUpdate {
+	if i != nil {
+		rgu.SetUserID(*i)
+	}
+	return rgu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (rgu *RoleGrantUpdate) AddUserID(i int64) *RoleGrantUpdate {
+	rgu.mutation.AddUserID(i)
+	return rgu
+}
+
+// SetRoleID sets the "role_id" field.
+func (rgu *RoleGrantUpdate) SetRoleID(i int64) *RoleGrantUpdate {
+	rgu.mutation.ResetRoleID()
+	rgu.mutation.SetRoleID(i)
+	return rgu
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rgu *RoleGrantUpdate) SetNillableRoleID(i *int64) *RoleGrantUpdate {
+	if i != nil {
+		rgu.SetRoleID(*i)
+	}
+	return rgu
+}
+
+// AddRoleID adds i to the "role_id" field.
+func (rgu *RoleGrantUpdate) AddRoleID(i int64) *RoleGrantUpdate {
+	rgu.mutation.AddRoleID(i)
+	return rgu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rgu *RoleGrantUpdate) SetStartsAt(i int64) *RoleGrantUpdate {
+	rgu.mutation.ResetStartsAt()
+	rgu.mutation.SetStartsAt(i)
+	return rgu
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (rgu *RoleGrantUpdate) SetNillableStartsAt(i *int64) *RoleGrantUpdate {
+	if i != nil {
+		rgu.SetStartsAt(*i)
+	}
+	return rgu
+}
+
+// AddStartsAt adds i to the "starts_at" field.
+func (rgu *RoleGrantUpdate) AddStartsAt(i int64) *RoleGrantUpdate {
+	rgu.mutation.AddStartsAt(i)
+	return rgu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rgu *RoleGrantUpdate) SetExpiresAt(i int64) *RoleGrantUpdate {
+	rgu.mutation.ResetExpiresAt()
+	rgu.mutation.SetExpiresAt(i)
+	return rgu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rgu *RoleGrantUpdate) SetNillableExpiresAt(i *int64) *RoleGrantUpdate {
+	if i != nil {
+		rgu.SetExpiresAt(*i)
+	}
+	return rgu
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (rgu *RoleGrantUpdate) AddExpiresAt(i int64) *RoleGrantUpdate {
+	rgu.mutation.AddExpiresAt(i)
+	return rgu
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (rgu *RoleGrantUpdate) SetActivatedAt(i int64) *RoleGrantUpdate {
+	rgu.mutation.ResetActivatedAt()
+	rgu.mutation.SetActivatedAt(i)
+	return rgu
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (rgu *RoleGrantUpdate) SetNillableActivatedAt(i *int64) *RoleGrantUpdate {
+	if i != nil {
+		rgu.SetActivatedAt(*i)
+	}
+	return rgu
+}
+
+// AddActivatedAt adds i to the "activated_at" field.
+func (rgu *RoleGrantUpdate) AddActivatedAt(i int64) *RoleGrantUpdate {
+	rgu.mutation.AddActivatedAt(i)
+	return rgu
+}
+
+// Mutation returns the RoleGrantMutation object of the builder.
+func (rgu *RoleGrantUpdate) Mutation() *RoleGrantMutation {
+	return rgu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rgu *RoleGrantUpdate) Save(ctx context.Context) (int, error) {
+	rgu.defaults()
+	return withHooks(ctx, rgu.sqlSave, rgu.mutation, rgu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rgu *RoleGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := rgu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rgu *RoleGrantUpdate) Exec(ctx context.Context) error {
+	_, err := rgu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rgu *RoleGrantUpdate) ExecX(ctx context.Context) {
+	if err := rgu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rgu *RoleGrantUpdate) defaults() {
+	if _, ok := rgu.mutation.UpdatedAt(); !ok {
+		v := rolegrant.UpdateDefaultUpdatedAt()
+		rgu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rgu *RoleGrantUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoleGrantUpdate {
+	rgu.modifiers = append(rgu.modifiers, modifiers...)
+	return rgu
+}
+
+func (rgu *RoleGrantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rolegrant.Table, rolegrant.Columns, sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt64))
+	if ps := rgu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rgu.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolegrant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rgu.mutation.TenantID(); ok {
+		_spec.SetField(rolegrant.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.AddedTenantID(); ok {
+		_spec.AddField(rolegrant.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.UserID(); ok {
+		_spec.SetField(rolegrant.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.AddedUserID(); ok {
+		_spec.AddField(rolegrant.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.RoleID(); ok {
+		_spec.SetField(rolegrant.FieldRoleID, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.AddedRoleID(); ok {
+		_spec.AddField(rolegrant.FieldRoleID, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.StartsAt(); ok {
+		_spec.SetField(rolegrant.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.AddedStartsAt(); ok {
+		_spec.AddField(rolegrant.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.ExpiresAt(); ok {
+		_spec.SetField(rolegrant.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(rolegrant.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.ActivatedAt(); ok {
+		_spec.SetField(rolegrant.FieldActivatedAt, field.TypeInt64, value)
+	}
+	if value, ok := rgu.mutation.AddedActivatedAt(); ok {
+		_spec.AddField(rolegrant.FieldActivatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(rgu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rgu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolegrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rgu.mutation.done = true
+	return n, nil
+}
+
+// RoleGrantUpdateOne is the builder for updating a single RoleGrant entity.
+type RoleGrantUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RoleGrantMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (rguo *RoleGrantUpdateOne) SetTenantID(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.ResetTenantID()
+	rguo.mutation.SetTenantID(i)
+	return rguo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (rguo *RoleGrantUpdateOne) SetNillableTenantID(i *int64) *RoleGrantUpdateOne {
+	if i != nil {
+		rguo.SetTenantID(*i)
+	}
+	return rguo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (rguo *RoleGrantUpdateOne) AddTenantID(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.AddTenantID(i)
+	return rguo
+}
+
+// SetUserID sets the "user_id" field.
+func (rguo *RoleGrantUpdateOne) SetUserID(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.ResetUserID()
+	rguo.mutation.SetUserID(i)
+	return rguo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rguo *RoleGrantUpdateOne) SetNillableUserID(i *int64) *RoleGrantUpdateOne {
+	if i != nil {
+		rguo.SetUserID(*i)
+	}
+	return rguo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (rguo *RoleGrantUpdateOne) AddUserID(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.AddUserID(i)
+	return rguo
+}
+
+// SetRoleID sets the "role_id" field.
+func (rguo *RoleGrantUpdateOne) SetRoleID(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.ResetRoleID()
+	rguo.mutation.SetRoleID(i)
+	return rguo
+}
+
+// SetNillableRoleID sets the "role_id" field if the given value is not nil.
+func (rguo *RoleGrantUpdateOne) SetNillableRoleID(i *int64) *RoleGrantUpdateOne {
+	if i != nil {
+		rguo.SetRoleID(*i)
+	}
+	return rguo
+}
+
+// AddRoleID adds i to the "role_id" field.
+func (rguo *RoleGrantUpdateOne) AddRoleID(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.AddRoleID(i)
+	return rguo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (rguo *RoleGrantUpdateOne) SetStartsAt(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.ResetStartsAt()
+	rguo.mutation.SetStartsAt(i)
+	return rguo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (rguo *RoleGrantUpdateOne) SetNillableStartsAt(i *int64) *RoleGrantUpdateOne {
+	if i != nil {
+		rguo.SetStartsAt(*i)
+	}
+	return rguo
+}
+
+// AddStartsAt adds i to the "starts_at" field.
+func (rguo *RoleGrantUpdateOne) AddStartsAt(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.AddStartsAt(i)
+	return rguo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rguo *RoleGrantUpdateOne) SetExpiresAt(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.ResetExpiresAt()
+	rguo.mutation.SetExpiresAt(i)
+	return rguo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rguo *RoleGrantUpdateOne) SetNillableExpiresAt(i *int64) *RoleGrantUpdateOne {
+	if i != nil {
+		rguo.SetExpiresAt(*i)
+	}
+	return rguo
+}
+
+// AddExpiresAt adds i to the "expires_at" field.
+func (rguo *RoleGrantUpdateOne) AddExpiresAt(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.AddExpiresAt(i)
+	return rguo
+}
+
+// SetActivatedAt sets the "activated_at" field.
+func (rguo *RoleGrantUpdateOne) SetActivatedAt(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.ResetActivatedAt()
+	rguo.mutation.SetActivatedAt(i)
+	return rguo
+}
+
+// SetNillableActivatedAt sets the "activated_at" field if the given value is not nil.
+func (rguo *RoleGrantUpdateOne) SetNillableActivatedAt(i *int64) *RoleGrantUpdateOne {
+	if i != nil {
+		rguo.SetActivatedAt(*i)
+	}
+	return rguo
+}
+
+// AddActivatedAt adds i to the "activated_at" field.
+func (rguo *RoleGrantUpdateOne) AddActivatedAt(i int64) *RoleGrantUpdateOne {
+	rguo.mutation.AddActivatedAt(i)
+	return rguo
+}
+
+// Mutation returns the RoleGrantMutation object of the builder.
+func (rguo *RoleGrantUpdateOne) Mutation() *RoleGrantMutation {
+	return rguo.mutation
+}
+
+// Where appends a list predicates to the RoleGrantUpdate builder.
+func (rguo *RoleGrantUpdateOne) Where(ps ...predicate.RoleGrant) *RoleGrantUpdateOne {
+	rguo.mutation.Where(ps...)
+	return rguo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rguo *RoleGrantUpdateOne) Select(field string, fields ...string) *RoleGrantUpdateOne {
+	rguo.fields = append([]string{field}, fields...)
+	return rguo
+}
+
+// Save executes the query and returns the updated RoleGrant entity.
+func (rguo *RoleGrantUpdateOne) Save(ctx context.Context) (*RoleGrant, error) {
+	rguo.defaults()
+	return withHooks(ctx, rguo.sqlSave, rguo.mutation, rguo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rguo *RoleGrantUpdateOne) SaveX(ctx context.Context) *RoleGrant {
+	node, err := rguo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rguo *RoleGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := rguo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rguo *RoleGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := rguo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rguo *RoleGrantUpdateOne) defaults() {
+	if _, ok := rguo.mutation.UpdatedAt(); !ok {
+		v := rolegrant.UpdateDefaultUpdatedAt()
+		rguo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rguo *RoleGrantUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RoleGrantUpdateOne {
+	rguo.modifiers = append(rguo.modifiers, modifiers...)
+	return rguo
+}
+
+func (rguo *RoleGrantUpdateOne) sqlSave(ctx context.Context) (_node *RoleGrant, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rolegrant.Table, rolegrant.Columns, sqlgraph.NewFieldSpec(rolegrant.FieldID, field.TypeInt64))
+	id, ok := rguo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoleGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rguo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolegrant.FieldID)
+		for _, f := range fields {
+			if !rolegrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rolegrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rguo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rguo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolegrant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rguo.mutation.TenantID(); ok {
+		_spec.SetField(rolegrant.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.AddedTenantID(); ok {
+		_spec.AddField(rolegrant.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.UserID(); ok {
+		_spec.SetField(rolegrant.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.AddedUserID(); ok {
+		_spec.AddField(rolegrant.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.RoleID(); ok {
+		_spec.SetField(rolegrant.FieldRoleID, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.AddedRoleID(); ok {
+		_spec.AddField(rolegrant.FieldRoleID, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.StartsAt(); ok {
+		_spec.SetField(rolegrant.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.AddedStartsAt(); ok {
+		_spec.AddField(rolegrant.FieldStartsAt, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.ExpiresAt(); ok {
+		_spec.SetField(rolegrant.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.AddedExpiresAt(); ok {
+		_spec.AddField(rolegrant.FieldExpiresAt, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.ActivatedAt(); ok {
+		_spec.SetField(rolegrant.FieldActivatedAt, field.TypeInt64, value)
+	}
+	if value, ok := rguo.mutation.AddedActivatedAt(); ok {
+		_spec.AddField(rolegrant.FieldActivatedAt, field.TypeInt64, value)
+	}
+	_spec.AddModifiers(rguo.modifiers...)
+	_node = &RoleGrant{config: rguo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rguo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolegrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rguo.mutation.done = true
+	return _node, nil
+}
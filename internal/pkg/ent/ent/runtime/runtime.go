@@ -5,16 +5,123 @@ package runtime
 import (
 	"go-scaffold/internal/app/repository/schema"
 	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/apikey"
+	"go-scaffold/internal/pkg/ent/ent/auditlog"
 	"go-scaffold/internal/pkg/ent/ent/permission"
 	"go-scaffold/internal/pkg/ent/ent/product"
 	"go-scaffold/internal/pkg/ent/ent/role"
+	"go-scaffold/internal/pkg/ent/ent/rolegrant"
+	"go-scaffold/internal/pkg/ent/ent/tenant"
 	"go-scaffold/internal/pkg/ent/ent/user"
+	"go-scaffold/internal/pkg/ent/ent/useridentity"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinHooks1 := apikeyMixin[1].Hooks()
+	apikey.Hooks[0] = apikeyMixinHooks1[0]
+	apikeyMixinInters1 := apikeyMixin[1].Interceptors()
+	apikey.Interceptors[0] = apikeyMixinInters1[0]
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() types.UnixTimestamp)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() types.UnixTimestamp)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// apikeyDescUserID is the schema descriptor for user_id field.
+	apikeyDescUserID := apikeyFields[1].Descriptor()
+	// apikey.DefaultUserID holds the default value on creation for the user_id field.
+	apikey.DefaultUserID = apikeyDescUserID.Default.(int64)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.DefaultName holds the default value on creation for the name field.
+	apikey.DefaultName = apikeyDescName.Default.(string)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.DefaultPrefix holds the default value on creation for the prefix field.
+	apikey.DefaultPrefix = apikeyDescPrefix.Default.(string)
+	// apikeyDescHash is the schema descriptor for hash field.
+	apikeyDescHash := apikeyFields[4].Descriptor()
+	// apikey.DefaultHash holds the default value on creation for the hash field.
+	apikey.DefaultHash = apikeyDescHash.Default.(string)
+	// apikeyDescScopes is the schema descriptor for scopes field.
+	apikeyDescScopes := apikeyFields[5].Descriptor()
+	// apikey.DefaultScopes holds the default value on creation for the scopes field.
+	apikey.DefaultScopes = apikeyDescScopes.Default.(string)
+	// apikeyDescExpiresAt is the schema descriptor for expires_at field.
+	apikeyDescExpiresAt := apikeyFields[6].Descriptor()
+	// apikey.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	apikey.DefaultExpiresAt = apikeyDescExpiresAt.Default.(int64)
+	// apikeyDescLastUsedAt is the schema descriptor for last_used_at field.
+	apikeyDescLastUsedAt := apikeyFields[7].Descriptor()
+	// apikey.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	apikey.DefaultLastUsedAt = apikeyDescLastUsedAt.Default.(int64)
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinFields0 := auditlogMixin[0].Fields()
+	_ = auditlogMixinFields0
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogMixinFields0[0].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() types.UnixTimestamp)
+	// auditlogDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogDescUpdatedAt := auditlogMixinFields0[1].Descriptor()
+	// auditlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlog.DefaultUpdatedAt = auditlogDescUpdatedAt.Default.(func() types.UnixTimestamp)
+	// auditlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlog.UpdateDefaultUpdatedAt = auditlogDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// auditlogDescTenantID is the schema descriptor for tenant_id field.
+	auditlogDescTenantID := auditlogFields[1].Descriptor()
+	// auditlog.DefaultTenantID holds the default value on creation for the tenant_id field.
+	auditlog.DefaultTenantID = auditlogDescTenantID.Default.(int64)
+	// auditlogDescActorID is the schema descriptor for actor_id field.
+	auditlogDescActorID := auditlogFields[2].Descriptor()
+	// auditlog.DefaultActorID holds the default value on creation for the actor_id field.
+	auditlog.DefaultActorID = auditlogDescActorID.Default.(int64)
+	// auditlogDescUserID is the schema descriptor for user_id field.
+	auditlogDescUserID := auditlogFields[3].Descriptor()
+	// auditlog.DefaultUserID holds the default value on creation for the user_id field.
+	auditlog.DefaultUserID = auditlogDescUserID.Default.(int64)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[4].Descriptor()
+	// auditlog.DefaultAction holds the default value on creation for the action field.
+	auditlog.DefaultAction = auditlogDescAction.Default.(string)
+	// auditlogDescMethod is the schema descriptor for method field.
+	auditlogDescMethod := auditlogFields[5].Descriptor()
+	// auditlog.DefaultMethod holds the default value on creation for the method field.
+	auditlog.DefaultMethod = auditlogDescMethod.Default.(string)
+	// auditlogDescPath is the schema descriptor for path field.
+	auditlogDescPath := auditlogFields[6].Descriptor()
+	// auditlog.DefaultPath holds the default value on creation for the path field.
+	auditlog.DefaultPath = auditlogDescPath.Default.(string)
+	// auditlogDescStatus is the schema descriptor for status field.
+	auditlogDescStatus := auditlogFields[7].Descriptor()
+	// auditlog.DefaultStatus holds the default value on creation for the status field.
+	auditlog.DefaultStatus = auditlogDescStatus.Default.(int)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[8].Descriptor()
+	// auditlog.DefaultIP holds the default value on creation for the ip field.
+	auditlog.DefaultIP = auditlogDescIP.Default.(string)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[9].Descriptor()
+	// auditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditlog.DefaultUserAgent = auditlogDescUserAgent.Default.(string)
+	// auditlogDescDetail is the schema descriptor for detail field.
+	auditlogDescDetail := auditlogFields[10].Descriptor()
+	// auditlog.DefaultDetail holds the default value on creation for the detail field.
+	auditlog.DefaultDetail = auditlogDescDetail.Default.(string)
 	permissionMixin := schema.Permission{}.Mixin()
 	permissionMixinHooks1 := permissionMixin[1].Hooks()
 	permission.Hooks[0] = permissionMixinHooks1[0]
@@ -58,9 +165,13 @@ func init() {
 	productMixinHooks1 := productMixin[1].Hooks()
 	product.Hooks[0] = productMixinHooks1[0]
 	productMixinInters1 := productMixin[1].Interceptors()
+	productMixinInters2 := productMixin[2].Interceptors()
 	product.Interceptors[0] = productMixinInters1[0]
+	product.Interceptors[1] = productMixinInters2[0]
 	productMixinFields0 := productMixin[0].Fields()
 	_ = productMixinFields0
+	productMixinFields2 := productMixin[2].Fields()
+	_ = productMixinFields2
 	productFields := schema.Product{}.Fields()
 	_ = productFields
 	// productDescCreatedAt is the schema descriptor for created_at field.
@@ -73,18 +184,30 @@ func init() {
 	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() types.UnixTimestamp)
 	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	product.UpdateDefaultUpdatedAt = productDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// productDescDepartmentID is the schema descriptor for department_id field.
+	productDescDepartmentID := productMixinFields2[0].Descriptor()
+	// product.DefaultDepartmentID holds the default value on creation for the department_id field.
+	product.DefaultDepartmentID = productDescDepartmentID.Default.(int64)
+	// productDescTenantID is the schema descriptor for tenant_id field.
+	productDescTenantID := productFields[1].Descriptor()
+	// product.DefaultTenantID holds the default value on creation for the tenant_id field.
+	product.DefaultTenantID = productDescTenantID.Default.(int64)
 	// productDescName is the schema descriptor for name field.
-	productDescName := productFields[1].Descriptor()
+	productDescName := productFields[2].Descriptor()
 	// product.DefaultName holds the default value on creation for the name field.
 	product.DefaultName = productDescName.Default.(string)
 	// productDescDesc is the schema descriptor for desc field.
-	productDescDesc := productFields[2].Descriptor()
+	productDescDesc := productFields[3].Descriptor()
 	// product.DefaultDesc holds the default value on creation for the desc field.
 	product.DefaultDesc = productDescDesc.Default.(string)
 	// productDescPrice is the schema descriptor for price field.
-	productDescPrice := productFields[3].Descriptor()
+	productDescPrice := productFields[4].Descriptor()
 	// product.DefaultPrice holds the default value on creation for the price field.
 	product.DefaultPrice = productDescPrice.Default.(int)
+	// productDescOwnerID is the schema descriptor for owner_id field.
+	productDescOwnerID := productFields[5].Descriptor()
+	// product.DefaultOwnerID holds the default value on creation for the owner_id field.
+	product.DefaultOwnerID = productDescOwnerID.Default.(int64)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinHooks1 := roleMixin[1].Hooks()
 	role.Hooks[0] = roleMixinHooks1[0]
@@ -104,17 +227,95 @@ func init() {
 	role.DefaultUpdatedAt = roleDescUpdatedAt.Default.(func() types.UnixTimestamp)
 	// role.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	role.UpdateDefaultUpdatedAt = roleDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// roleDescTenantID is the schema descriptor for tenant_id field.
+	roleDescTenantID := roleFields[1].Descriptor()
+	// role.DefaultTenantID holds the default value on creation for the tenant_id field.
+	role.DefaultTenantID = roleDescTenantID.Default.(int64)
 	// roleDescName is the schema descriptor for name field.
-	roleDescName := roleFields[1].Descriptor()
+	roleDescName := roleFields[2].Descriptor()
 	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescDataScope is the schema descriptor for data_scope field.
+	roleDescDataScope := roleFields[3].Descriptor()
+	// role.DefaultDataScope holds the default value on creation for the data_scope field.
+	role.DefaultDataScope = roleDescDataScope.Default.(string)
+	// roleDescDataScopeDepartments is the schema descriptor for data_scope_departments field.
+	roleDescDataScopeDepartments := roleFields[4].Descriptor()
+	// role.DefaultDataScopeDepartments holds the default value on creation for the data_scope_departments field.
+	role.DefaultDataScopeDepartments = roleDescDataScopeDepartments.Default.(string)
+	rolegrantMixin := schema.RoleGrant{}.Mixin()
+	rolegrantMixinFields0 := rolegrantMixin[0].Fields()
+	_ = rolegrantMixinFields0
+	rolegrantFields := schema.RoleGrant{}.Fields()
+	_ = rolegrantFields
+	// rolegrantDescCreatedAt is the schema descriptor for created_at field.
+	rolegrantDescCreatedAt := rolegrantMixinFields0[0].Descriptor()
+	// rolegrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolegrant.DefaultCreatedAt = rolegrantDescCreatedAt.Default.(func() types.UnixTimestamp)
+	// rolegrantDescUpdatedAt is the schema descriptor for updated_at field.
+	rolegrantDescUpdatedAt := rolegrantMixinFields0[1].Descriptor()
+	// rolegrant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rolegrant.DefaultUpdatedAt = rolegrantDescUpdatedAt.Default.(func() types.UnixTimestamp)
+	// rolegrant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rolegrant.UpdateDefaultUpdatedAt = rolegrantDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// rolegrantDescTenantID is the schema descriptor for tenant_id field.
+	rolegrantDescTenantID := rolegrantFields[1].Descriptor()
+	// rolegrant.DefaultTenantID holds the default value on creation for the tenant_id field.
+	rolegrant.DefaultTenantID = rolegrantDescTenantID.Default.(int64)
+	// rolegrantDescUserID is the schema descriptor for user_id field.
+	rolegrantDescUserID := rolegrantFields[2].Descriptor()
+	// rolegrant.DefaultUserID holds the default value on creation for the user_id field.
+	rolegrant.DefaultUserID = rolegrantDescUserID.Default.(int64)
+	// rolegrantDescRoleID is the schema descriptor for role_id field.
+	rolegrantDescRoleID := rolegrantFields[3].Descriptor()
+	// rolegrant.DefaultRoleID holds the default value on creation for the role_id field.
+	rolegrant.DefaultRoleID = rolegrantDescRoleID.Default.(int64)
+	// rolegrantDescStartsAt is the schema descriptor for starts_at field.
+	rolegrantDescStartsAt := rolegrantFields[4].Descriptor()
+	// rolegrant.DefaultStartsAt holds the default value on creation for the starts_at field.
+	rolegrant.DefaultStartsAt = rolegrantDescStartsAt.Default.(int64)
+	// rolegrantDescExpiresAt is the schema descriptor for expires_at field.
+	rolegrantDescExpiresAt := rolegrantFields[5].Descriptor()
+	// rolegrant.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	rolegrant.DefaultExpiresAt = rolegrantDescExpiresAt.Default.(int64)
+	// rolegrantDescActivatedAt is the schema descriptor for activated_at field.
+	rolegrantDescActivatedAt := rolegrantFields[6].Descriptor()
+	// rolegrant.DefaultActivatedAt holds the default value on creation for the activated_at field.
+	rolegrant.DefaultActivatedAt = rolegrantDescActivatedAt.Default.(int64)
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantMixinHooks1 := tenantMixin[1].Hooks()
+	tenant.Hooks[0] = tenantMixinHooks1[0]
+	tenantMixinInters1 := tenantMixin[1].Interceptors()
+	tenant.Interceptors[0] = tenantMixinInters1[0]
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescCreatedAt is the schema descriptor for created_at field.
+	tenantDescCreatedAt := tenantMixinFields0[0].Descriptor()
+	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() types.UnixTimestamp)
+	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantDescUpdatedAt := tenantMixinFields0[1].Descriptor()
+	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() types.UnixTimestamp)
+	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenant.UpdateDefaultUpdatedAt = tenantDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// tenantDescName is the schema descriptor for name field.
+	tenantDescName := tenantFields[1].Descriptor()
+	// tenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenant.NameValidator = tenantDescName.Validators[0].(func(string) error)
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks1 := userMixin[1].Hooks()
 	user.Hooks[0] = userMixinHooks1[0]
 	userMixinInters1 := userMixin[1].Interceptors()
+	userMixinInters2 := userMixin[2].Interceptors()
 	user.Interceptors[0] = userMixinInters1[0]
+	user.Interceptors[1] = userMixinInters2[0]
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
+	userMixinFields2 := userMixin[2].Fields()
+	_ = userMixinFields2
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescCreatedAt is the schema descriptor for created_at field.
@@ -127,26 +328,89 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() types.UnixTimestamp)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// userDescDepartmentID is the schema descriptor for department_id field.
+	userDescDepartmentID := userMixinFields2[0].Descriptor()
+	// user.DefaultDepartmentID holds the default value on creation for the department_id field.
+	user.DefaultDepartmentID = userDescDepartmentID.Default.(int64)
+	// userDescTenantID is the schema descriptor for tenant_id field.
+	userDescTenantID := userFields[1].Descriptor()
+	// user.DefaultTenantID holds the default value on creation for the tenant_id field.
+	user.DefaultTenantID = userDescTenantID.Default.(int64)
 	// userDescUsername is the schema descriptor for username field.
-	userDescUsername := userFields[1].Descriptor()
+	userDescUsername := userFields[2].Descriptor()
 	// user.DefaultUsername holds the default value on creation for the username field.
 	user.DefaultUsername = userDescUsername.Default.(string)
 	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[2].Descriptor()
+	userDescPassword := userFields[3].Descriptor()
 	// user.DefaultPassword holds the default value on creation for the password field.
 	user.DefaultPassword = userDescPassword.Default.(string)
 	// userDescNickname is the schema descriptor for nickname field.
-	userDescNickname := userFields[3].Descriptor()
+	userDescNickname := userFields[4].Descriptor()
 	// user.DefaultNickname holds the default value on creation for the nickname field.
 	user.DefaultNickname = userDescNickname.Default.(string)
 	// userDescPhone is the schema descriptor for phone field.
-	userDescPhone := userFields[4].Descriptor()
+	userDescPhone := userFields[5].Descriptor()
 	// user.DefaultPhone holds the default value on creation for the phone field.
 	user.DefaultPhone = userDescPhone.Default.(string)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[6].Descriptor()
+	// user.DefaultEmail holds the default value on creation for the email field.
+	user.DefaultEmail = userDescEmail.Default.(string)
+	// userDescEmailVerifiedAt is the schema descriptor for email_verified_at field.
+	userDescEmailVerifiedAt := userFields[7].Descriptor()
+	// user.DefaultEmailVerifiedAt holds the default value on creation for the email_verified_at field.
+	user.DefaultEmailVerifiedAt = userDescEmailVerifiedAt.Default.(int64)
+	// userDescServiceAccount is the schema descriptor for service_account field.
+	userDescServiceAccount := userFields[8].Descriptor()
+	// user.DefaultServiceAccount holds the default value on creation for the service_account field.
+	user.DefaultServiceAccount = userDescServiceAccount.Default.(bool)
 	// userDescSalt is the schema descriptor for salt field.
-	userDescSalt := userFields[5].Descriptor()
+	userDescSalt := userFields[9].Descriptor()
 	// user.DefaultSalt holds the default value on creation for the salt field.
 	user.DefaultSalt = userDescSalt.Default.(string)
+	// userDescTotpSecret is the schema descriptor for totp_secret field.
+	userDescTotpSecret := userFields[10].Descriptor()
+	// user.DefaultTotpSecret holds the default value on creation for the totp_secret field.
+	user.DefaultTotpSecret = userDescTotpSecret.Default.(string)
+	// userDescTotpEnabledAt is the schema descriptor for totp_enabled_at field.
+	userDescTotpEnabledAt := userFields[11].Descriptor()
+	// user.DefaultTotpEnabledAt holds the default value on creation for the totp_enabled_at field.
+	user.DefaultTotpEnabledAt = userDescTotpEnabledAt.Default.(int64)
+	// userDescTotpRecoveryCodes is the schema descriptor for totp_recovery_codes field.
+	userDescTotpRecoveryCodes := userFields[12].Descriptor()
+	// user.DefaultTotpRecoveryCodes holds the default value on creation for the totp_recovery_codes field.
+	user.DefaultTotpRecoveryCodes = userDescTotpRecoveryCodes.Default.(string)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[13].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	useridentityMixin := schema.UserIdentity{}.Mixin()
+	useridentityMixinFields0 := useridentityMixin[0].Fields()
+	_ = useridentityMixinFields0
+	useridentityFields := schema.UserIdentity{}.Fields()
+	_ = useridentityFields
+	// useridentityDescCreatedAt is the schema descriptor for created_at field.
+	useridentityDescCreatedAt := useridentityMixinFields0[0].Descriptor()
+	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() types.UnixTimestamp)
+	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
+	useridentityDescUpdatedAt := useridentityMixinFields0[1].Descriptor()
+	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() types.UnixTimestamp)
+	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	useridentity.UpdateDefaultUpdatedAt = useridentityDescUpdatedAt.UpdateDefault.(func() types.UnixTimestamp)
+	// useridentityDescUserID is the schema descriptor for user_id field.
+	useridentityDescUserID := useridentityFields[1].Descriptor()
+	// useridentity.DefaultUserID holds the default value on creation for the user_id field.
+	useridentity.DefaultUserID = useridentityDescUserID.Default.(int64)
+	// useridentityDescProvider is the schema descriptor for provider field.
+	useridentityDescProvider := useridentityFields[2].Descriptor()
+	// useridentity.DefaultProvider holds the default value on creation for the provider field.
+	useridentity.DefaultProvider = useridentityDescProvider.Default.(string)
+	// useridentityDescSubject is the schema descriptor for subject field.
+	useridentityDescSubject := useridentityFields[3].Descriptor()
+	// useridentity.DefaultSubject holds the default value on creation for the subject field.
+	useridentity.DefaultSubject = useridentityDescSubject.Default.(string)
 }
 
 const (
@@ -23,6 +23,10 @@ type User struct {
 	UpdatedAt types.UnixTimestamp `json:"updated_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt types.UnixTimestamp `json:"deleted_at,omitempty"`
+	// 所属部门 id
+	DepartmentID int64 `json:"department_id,omitempty"`
+	// 所属租户 id
+	TenantID int64 `json:"tenant_id,omitempty"`
 	// 用户名
 	Username string `json:"username,omitempty"`
 	// 密码
@@ -31,8 +35,22 @@ type User struct {
 	Nickname string `json:"nickname,omitempty"`
 	// 电话
 	Phone string `json:"phone,omitempty"`
+	// 邮箱
+	Email string `json:"email,omitempty"`
+	// 邮箱验证时间
+	EmailVerifiedAt int64 `json:"email_verified_at,omitempty"`
+	// 是否为服务账号
+	ServiceAccount bool `json:"service_account,omitempty"`
 	// 盐值
-	Salt         string `json:"salt,omitempty"`
+	Salt string `json:"salt,omitempty"`
+	// TOTP 密钥
+	TotpSecret string `json:"totp_secret,omitempty"`
+	// TOTP 启用时间
+	TotpEnabledAt int64 `json:"totp_enabled_at,omitempty"`
+	// TOTP 恢复码摘要
+	TotpRecoveryCodes string `json:"totp_recovery_codes,omitempty"`
+	// 最后使用的 TOTP 时间步
+	TotpLastStep int64 `json:"totp_last_step,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -41,9 +59,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldID:
+		case user.FieldServiceAccount:
+			values[i] = new(sql.NullBool)
+		case user.FieldID, user.FieldDepartmentID, user.FieldTenantID, user.FieldEmailVerifiedAt, user.FieldTotpEnabledAt, user.FieldTotpLastStep:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPassword, user.FieldNickname, user.FieldPhone, user.FieldSalt:
+		case user.FieldUsername, user.FieldPassword, user.FieldNickname, user.FieldPhone, user.FieldEmail, user.FieldSalt, user.FieldTotpSecret, user.FieldTotpRecoveryCodes:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
 			values[i] = new(types.UnixTimestamp)
@@ -86,6 +106,18 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				u.DeletedAt = *value
 			}
+		case user.FieldDepartmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field department_id", values[i])
+			} else if value.Valid {
+				u.DepartmentID = value.Int64
+			}
+		case user.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				u.TenantID = value.Int64
+			}
 		case user.FieldUsername:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field username", values[i])
@@ -110,12 +142,54 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Phone = value.String
 			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				u.Email = value.String
+			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				u.EmailVerifiedAt = value.Int64
+			}
+		case user.FieldServiceAccount:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field service_account", values[i])
+			} else if value.Valid {
+				u.ServiceAccount = value.Bool
+			}
 		case user.FieldSalt:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field salt", values[i])
 			} else if value.Valid {
 				u.Salt = value.String
 			}
+		case user.FieldTotpSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret", values[i])
+			} else if value.Valid {
+				u.TotpSecret = value.String
+			}
+		case user.FieldTotpEnabledAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_enabled_at", values[i])
+			} else if value.Valid {
+				u.TotpEnabledAt = value.Int64
+			}
+		case user.FieldTotpRecoveryCodes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_recovery_codes", values[i])
+			} else if value.Valid {
+				u.TotpRecoveryCodes = value.String
+			}
+		case user.FieldTotpLastStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_step", values[i])
+			} else if value.Valid {
+				u.TotpLastStep = value.Int64
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -161,6 +235,12 @@ func (u *User) String() string {
 	builder.WriteString("deleted_at=")
 	builder.WriteString(fmt.Sprintf("%v", u.DeletedAt))
 	builder.WriteString(", ")
+	builder.WriteString("department_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.DepartmentID))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", u.TenantID))
+	builder.WriteString(", ")
 	builder.WriteString("username=")
 	builder.WriteString(u.Username)
 	builder.WriteString(", ")
@@ -173,8 +253,29 @@ func (u *User) String() string {
 	builder.WriteString("phone=")
 	builder.WriteString(u.Phone)
 	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(u.Email)
+	builder.WriteString(", ")
+	builder.WriteString("email_verified_at=")
+	builder.WriteString(fmt.Sprintf("%v", u.EmailVerifiedAt))
+	builder.WriteString(", ")
+	builder.WriteString("service_account=")
+	builder.WriteString(fmt.Sprintf("%v", u.ServiceAccount))
+	builder.WriteString(", ")
 	builder.WriteString("salt=")
 	builder.WriteString(u.Salt)
+	builder.WriteString(", ")
+	builder.WriteString("totp_secret=")
+	builder.WriteString(u.TotpSecret)
+	builder.WriteString(", ")
+	builder.WriteString("totp_enabled_at=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpEnabledAt))
+	builder.WriteString(", ")
+	builder.WriteString("totp_recovery_codes=")
+	builder.WriteString(u.TotpRecoveryCodes)
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_step=")
+	builder.WriteString(fmt.Sprintf("%v", u.TotpLastStep))
 	builder.WriteByte(')')
 	return builder.String()
 }
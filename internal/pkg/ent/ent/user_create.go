@@ -62,6 +62,34 @@ func (uc *UserCreate) SetNillableDeletedAt(tt *types.UnixTimestamp) *UserCreate
 	return uc
 }
 
+// SetDepartmentID sets the "department_id" field.
+func (uc *UserCreate) SetDepartmentID(i int64) *UserCreate {
+	uc.mutation.SetDepartmentID(i)
+	return uc
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableDepartmentID(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetDepartmentID(*i)
+	}
+	return uc
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (uc *UserCreate) SetTenantID(i int64) *UserCreate {
+	uc.mutation.SetTenantID(i)
+	return uc
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTenantID(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTenantID(*i)
+	}
+	return uc
+}
+
 // SetUsername sets the "username" field.
 func (uc *UserCreate) SetUsername(s string) *UserCreate {
 	uc.mutation.SetUsername(s)
@@ -118,6 +146,48 @@ func (uc *UserCreate) SetNillablePhone(s *string) *UserCreate {
 	return uc
 }
 
+// SetEmail sets the "email" field.
+func (uc *UserCreate) SetEmail(s string) *UserCreate {
+	uc.mutation.SetEmail(s)
+	return uc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmail(*s)
+	}
+	return uc
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uc *UserCreate) SetEmailVerifiedAt(i int64) *UserCreate {
+	uc.mutation.SetEmailVerifiedAt(i)
+	return uc
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailVerifiedAt(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetEmailVerifiedAt(*i)
+	}
+	return uc
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (uc *UserCreate) SetServiceAccount(b bool) *UserCreate {
+	uc.mutation.SetServiceAccount(b)
+	return uc
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (uc *UserCreate) SetNillableServiceAccount(b *bool) *UserCreate {
+	if b != nil {
+		uc.SetServiceAccount(*b)
+	}
+	return uc
+}
+
 // SetSalt sets the "salt" field.
 func (uc *UserCreate) SetSalt(s string) *UserCreate {
 	uc.mutation.SetSalt(s)
@@ -132,6 +202,62 @@ func (uc *UserCreate) SetNillableSalt(s *string) *UserCreate {
 	return uc
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uc *UserCreate) SetTotpEnabledAt(i int64) *UserCreate {
+	uc.mutation.SetTotpEnabledAt(i)
+	return uc
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabledAt(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpEnabledAt(*i)
+	}
+	return uc
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uc *UserCreate) SetTotpRecoveryCodes(s string) *UserCreate {
+	uc.mutation.SetTotpRecoveryCodes(s)
+	return uc
+}
+
+// SetNillableTotpRecoveryCodes sets the "totp_recovery_codes" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpRecoveryCodes(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpRecoveryCodes(*s)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
+	}
+	return uc
+}
+
 // SetID sets the "id" field.
 func (uc *UserCreate) SetID(i int64) *UserCreate {
 	uc.mutation.SetID(i)
@@ -189,6 +315,14 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultUpdatedAt()
 		uc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := uc.mutation.DepartmentID(); !ok {
+		v := user.DefaultDepartmentID
+		uc.mutation.SetDepartmentID(v)
+	}
+	if _, ok := uc.mutation.TenantID(); !ok {
+		v := user.DefaultTenantID
+		uc.mutation.SetTenantID(v)
+	}
 	if _, ok := uc.mutation.Username(); !ok {
 		v := user.DefaultUsername
 		uc.mutation.SetUsername(v)
@@ -205,10 +339,38 @@ func (uc *UserCreate) defaults() error {
 		v := user.DefaultPhone
 		uc.mutation.SetPhone(v)
 	}
+	if _, ok := uc.mutation.Email(); !ok {
+		v := user.DefaultEmail
+		uc.mutation.SetEmail(v)
+	}
+	if _, ok := uc.mutation.EmailVerifiedAt(); !ok {
+		v := user.DefaultEmailVerifiedAt
+		uc.mutation.SetEmailVerifiedAt(v)
+	}
+	if _, ok := uc.mutation.ServiceAccount(); !ok {
+		v := user.DefaultServiceAccount
+		uc.mutation.SetServiceAccount(v)
+	}
 	if _, ok := uc.mutation.Salt(); !ok {
 		v := user.DefaultSalt
 		uc.mutation.SetSalt(v)
 	}
+	if _, ok := uc.mutation.TotpSecret(); !ok {
+		v := user.DefaultTotpSecret
+		uc.mutation.SetTotpSecret(v)
+	}
+	if _, ok := uc.mutation.TotpEnabledAt(); !ok {
+		v := user.DefaultTotpEnabledAt
+		uc.mutation.SetTotpEnabledAt(v)
+	}
+	if _, ok := uc.mutation.TotpRecoveryCodes(); !ok {
+		v := user.DefaultTotpRecoveryCodes
+		uc.mutation.SetTotpRecoveryCodes(v)
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		uc.mutation.SetTotpLastStep(v)
+	}
 	return nil
 }
 
@@ -220,6 +382,12 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "User.updated_at"`)}
 	}
+	if _, ok := uc.mutation.DepartmentID(); !ok {
+		return &ValidationError{Name: "department_id", err: errors.New(`ent: missing required field "User.department_id"`)}
+	}
+	if _, ok := uc.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "User.tenant_id"`)}
+	}
 	if _, ok := uc.mutation.Username(); !ok {
 		return &ValidationError{Name: "username", err: errors.New(`ent: missing required field "User.username"`)}
 	}
@@ -232,9 +400,30 @@ func (uc *UserCreate) check() error {
 	if _, ok := uc.mutation.Phone(); !ok {
 		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "User.phone"`)}
 	}
+	if _, ok := uc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "User.email"`)}
+	}
+	if _, ok := uc.mutation.EmailVerifiedAt(); !ok {
+		return &ValidationError{Name: "email_verified_at", err: errors.New(`ent: missing required field "User.email_verified_at"`)}
+	}
+	if _, ok := uc.mutation.ServiceAccount(); !ok {
+		return &ValidationError{Name: "service_account", err: errors.New(`ent: missing required field "User.service_account"`)}
+	}
 	if _, ok := uc.mutation.Salt(); !ok {
 		return &ValidationError{Name: "salt", err: errors.New(`ent: missing required field "User.salt"`)}
 	}
+	if _, ok := uc.mutation.TotpSecret(); !ok {
+		return &ValidationError{Name: "totp_secret", err: errors.New(`ent: missing required field "User.totp_secret"`)}
+	}
+	if _, ok := uc.mutation.TotpEnabledAt(); !ok {
+		return &ValidationError{Name: "totp_enabled_at", err: errors.New(`ent: missing required field "User.totp_enabled_at"`)}
+	}
+	if _, ok := uc.mutation.TotpRecoveryCodes(); !ok {
+		return &ValidationError{Name: "totp_recovery_codes", err: errors.New(`ent: missing required field "User.totp_recovery_codes"`)}
+	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
 	return nil
 }
 
@@ -279,6 +468,14 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = value
 	}
+	if value, ok := uc.mutation.DepartmentID(); ok {
+		_spec.SetField(user.FieldDepartmentID, field.TypeInt64, value)
+		_node.DepartmentID = value
+	}
+	if value, ok := uc.mutation.TenantID(); ok {
+		_spec.SetField(user.FieldTenantID, field.TypeInt64, value)
+		_node.TenantID = value
+	}
 	if value, ok := uc.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 		_node.Username = value
@@ -295,10 +492,38 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 		_node.Phone = value
 	}
+	if value, ok := uc.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := uc.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeInt64, value)
+		_node.EmailVerifiedAt = value
+	}
+	if value, ok := uc.mutation.ServiceAccount(); ok {
+		_spec.SetField(user.FieldServiceAccount, field.TypeBool, value)
+		_node.ServiceAccount = value
+	}
 	if value, ok := uc.mutation.Salt(); ok {
 		_spec.SetField(user.FieldSalt, field.TypeString, value)
 		_node.Salt = value
 	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := uc.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeInt64, value)
+		_node.TotpEnabledAt = value
+	}
+	if value, ok := uc.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeString, value)
+		_node.TotpRecoveryCodes = value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
 	return _node, _spec
 }
 
@@ -49,6 +49,48 @@ func (uu *UserUpdate) ClearDeletedAt() *UserUpdate {
 	return uu
 }
 
+// SetDepartmentID sets the "department_id" field.
+func (uu *UserUpdate) SetDepartmentID(i int64) *UserUpdate {
+	uu.mutation.ResetDepartmentID()
+	uu.mutation.SetDepartmentID(i)
+	return uu
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableDepartmentID(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetDepartmentID(*i)
+	}
+	return uu
+}
+
+// AddDepartmentID adds i to the "department_id" field.
+func (uu *UserUpdate) AddDepartmentID(i int64) *UserUpdate {
+	uu.mutation.AddDepartmentID(i)
+	return uu
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (uu *UserUpdate) SetTenantID(i int64) *UserUpdate {
+	uu.mutation.ResetTenantID()
+	uu.mutation.SetTenantID(i)
+	return uu
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTenantID(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTenantID(*i)
+	}
+	return uu
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (uu *UserUpdate) AddTenantID(i int64) *UserUpdate {
+	uu.mutation.AddTenantID(i)
+	return uu
+}
+
 // SetUsername sets the "username" field.
 func (uu *UserUpdate) SetUsername(s string) *UserUpdate {
 	uu.mutation.SetUsername(s)
@@ -105,6 +147,55 @@ func (uu *UserUpdate) SetNillablePhone(s *string) *UserUpdate {
 	return uu
 }
 
+// SetEmail sets the "email" field.
+func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
+	uu.mutation.SetEmail(s)
+	return uu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmail(*s)
+	}
+	return uu
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uu *UserUpdate) SetEmailVerifiedAt(i int64) *UserUpdate {
+	uu.mutation.ResetEmailVerifiedAt()
+	uu.mutation.SetEmailVerifiedAt(i)
+	return uu
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmailVerifiedAt(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetEmailVerifiedAt(*i)
+	}
+	return uu
+}
+
+// AddEmailVerifiedAt adds i to the "email_verified_at" field.
+func (uu *UserUpdate) AddEmailVerifiedAt(i int64) *UserUpdate {
+	uu.mutation.AddEmailVerifiedAt(i)
+	return uu
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (uu *UserUpdate) SetServiceAccount(b bool) *UserUpdate {
+	uu.mutation.SetServiceAccount(b)
+	return uu
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableServiceAccount(b *bool) *UserUpdate {
+	if b != nil {
+		uu.SetServiceAccount(*b)
+	}
+	return uu
+}
+
 // SetSalt sets the "salt" field.
 func (uu *UserUpdate) SetSalt(s string) *UserUpdate {
 	uu.mutation.SetSalt(s)
@@ -119,6 +210,76 @@ func (uu *UserUpdate) SetNillableSalt(s *string) *UserUpdate {
 	return uu
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (uu *UserUpdate) SetTotpSecret(s string) *UserUpdate {
+	uu.mutation.SetTotpSecret(s)
+	return uu
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpSecret(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTotpSecret(*s)
+	}
+	return uu
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uu *UserUpdate) SetTotpEnabledAt(i int64) *UserUpdate {
+	uu.mutation.ResetTotpEnabledAt()
+	uu.mutation.SetTotpEnabledAt(i)
+	return uu
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpEnabledAt(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTotpEnabledAt(*i)
+	}
+	return uu
+}
+
+// AddTotpEnabledAt adds i to the "totp_enabled_at" field.
+func (uu *UserUpdate) AddTotpEnabledAt(i int64) *UserUpdate {
+	uu.mutation.AddTotpEnabledAt(i)
+	return uu
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uu *UserUpdate) SetTotpRecoveryCodes(s string) *UserUpdate {
+	uu.mutation.SetTotpRecoveryCodes(s)
+	return uu
+}
+
+// SetNillableTotpRecoveryCodes sets the "totp_recovery_codes" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpRecoveryCodes(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTotpRecoveryCodes(*s)
+	}
+	return uu
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uu *UserUpdate) SetTotpLastStep(i int64) *UserUpdate {
+	uu.mutation.ResetTotpLastStep()
+	uu.mutation.SetTotpLastStep(i)
+	return uu
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTotpLastStep(i *int64) *UserUpdate {
+	if i != nil {
+		uu.SetTotpLastStep(*i)
+	}
+	return uu
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (uu *UserUpdate) AddTotpLastStep(i int64) *UserUpdate {
+	uu.mutation.AddTotpLastStep(i)
+	return uu
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -190,6 +351,18 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.DeletedAtCleared() {
 		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := uu.mutation.DepartmentID(); ok {
+		_spec.SetField(user.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedDepartmentID(); ok {
+		_spec.AddField(user.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.TenantID(); ok {
+		_spec.SetField(user.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedTenantID(); ok {
+		_spec.AddField(user.FieldTenantID, field.TypeInt64, value)
+	}
 	if value, ok := uu.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 	}
@@ -202,9 +375,39 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Phone(); ok {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedEmailVerifiedAt(); ok {
+		_spec.AddField(user.FieldEmailVerifiedAt, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.ServiceAccount(); ok {
+		_spec.SetField(user.FieldServiceAccount, field.TypeBool, value)
+	}
 	if value, ok := uu.mutation.Salt(); ok {
 		_spec.SetField(user.FieldSalt, field.TypeString, value)
 	}
+	if value, ok := uu.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedTotpEnabledAt(); ok {
+		_spec.AddField(user.FieldTotpEnabledAt, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uu.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
 	_spec.AddModifiers(uu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -247,6 +450,48 @@ func (uuo *UserUpdateOne) ClearDeletedAt() *UserUpdateOne {
 	return uuo
 }
 
+// SetDepartmentID sets the "department_id" field.
+func (uuo *UserUpdateOne) SetDepartmentID(i int64) *UserUpdateOne {
+	uuo.mutation.ResetDepartmentID()
+	uuo.mutation.SetDepartmentID(i)
+	return uuo
+}
+
+// SetNillableDepartmentID sets the "department_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableDepartmentID(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetDepartmentID(*i)
+	}
+	return uuo
+}
+
+// AddDepartmentID adds i to the "department_id" field.
+func (uuo *UserUpdateOne) AddDepartmentID(i int64) *UserUpdateOne {
+	uuo.mutation.AddDepartmentID(i)
+	return uuo
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (uuo *UserUpdateOne) SetTenantID(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTenantID()
+	uuo.mutation.SetTenantID(i)
+	return uuo
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTenantID(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTenantID(*i)
+	}
+	return uuo
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (uuo *UserUpdateOne) AddTenantID(i int64) *UserUpdateOne {
+	uuo.mutation.AddTenantID(i)
+	return uuo
+}
+
 // SetUsername sets the "username" field.
 func (uuo *UserUpdateOne) SetUsername(s string) *UserUpdateOne {
 	uuo.mutation.SetUsername(s)
@@ -303,6 +548,55 @@ func (uuo *UserUpdateOne) SetNillablePhone(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetEmail sets the "email" field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uuo *UserUpdateOne) SetEmailVerifiedAt(i int64) *UserUpdateOne {
+	uuo.mutation.ResetEmailVerifiedAt()
+	uuo.mutation.SetEmailVerifiedAt(i)
+	return uuo
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmailVerifiedAt(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetEmailVerifiedAt(*i)
+	}
+	return uuo
+}
+
+// AddEmailVerifiedAt adds i to the "email_verified_at" field.
+func (uuo *UserUpdateOne) AddEmailVerifiedAt(i int64) *UserUpdateOne {
+	uuo.mutation.AddEmailVerifiedAt(i)
+	return uuo
+}
+
+// SetServiceAccount sets the "service_account" field.
+func (uuo *UserUpdateOne) SetServiceAccount(b bool) *UserUpdateOne {
+	uuo.mutation.SetServiceAccount(b)
+	return uuo
+}
+
+// SetNillableServiceAccount sets the "service_account" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableServiceAccount(b *bool) *UserUpdateOne {
+	if b != nil {
+		uuo.SetServiceAccount(*b)
+	}
+	return uuo
+}
+
 // SetSalt sets the "salt" field.
 func (uuo *UserUpdateOne) SetSalt(s string) *UserUpdateOne {
 	uuo.mutation.SetSalt(s)
@@ -317,6 +611,76 @@ func (uuo *UserUpdateOne) SetNillableSalt(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// SetTotpSecret sets the "totp_secret" field.
+func (uuo *UserUpdateOne) SetTotpSecret(s string) *UserUpdateOne {
+	uuo.mutation.SetTotpSecret(s)
+	return uuo
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpSecret(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTotpSecret(*s)
+	}
+	return uuo
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uuo *UserUpdateOne) SetTotpEnabledAt(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTotpEnabledAt()
+	uuo.mutation.SetTotpEnabledAt(i)
+	return uuo
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpEnabledAt(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTotpEnabledAt(*i)
+	}
+	return uuo
+}
+
+// AddTotpEnabledAt adds i to the "totp_enabled_at" field.
+func (uuo *UserUpdateOne) AddTotpEnabledAt(i int64) *UserUpdateOne {
+	uuo.mutation.AddTotpEnabledAt(i)
+	return uuo
+}
+
+// SetTotpRecoveryCodes sets the "totp_recovery_codes" field.
+func (uuo *UserUpdateOne) SetTotpRecoveryCodes(s string) *UserUpdateOne {
+	uuo.mutation.SetTotpRecoveryCodes(s)
+	return uuo
+}
+
+// SetNillableTotpRecoveryCodes sets the "totp_recovery_codes" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpRecoveryCodes(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTotpRecoveryCodes(*s)
+	}
+	return uuo
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uuo *UserUpdateOne) SetTotpLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.ResetTotpLastStep()
+	uuo.mutation.SetTotpLastStep(i)
+	return uuo
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTotpLastStep(i *int64) *UserUpdateOne {
+	if i != nil {
+		uuo.SetTotpLastStep(*i)
+	}
+	return uuo
+}
+
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (uuo *UserUpdateOne) AddTotpLastStep(i int64) *UserUpdateOne {
+	uuo.mutation.AddTotpLastStep(i)
+	return uuo
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -418,6 +782,18 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(user.FieldDeletedAt, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.DepartmentID(); ok {
+		_spec.SetField(user.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedDepartmentID(); ok {
+		_spec.AddField(user.FieldDepartmentID, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.TenantID(); ok {
+		_spec.SetField(user.FieldTenantID, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedTenantID(); ok {
+		_spec.AddField(user.FieldTenantID, field.TypeInt64, value)
+	}
 	if value, ok := uuo.mutation.Username(); ok {
 		_spec.SetField(user.FieldUsername, field.TypeString, value)
 	}
@@ -430,9 +806,39 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.Phone(); ok {
 		_spec.SetField(user.FieldPhone, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedEmailVerifiedAt(); ok {
+		_spec.AddField(user.FieldEmailVerifiedAt, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.ServiceAccount(); ok {
+		_spec.SetField(user.FieldServiceAccount, field.TypeBool, value)
+	}
 	if value, ok := uuo.mutation.Salt(); ok {
 		_spec.SetField(user.FieldSalt, field.TypeString, value)
 	}
+	if value, ok := uuo.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedTotpEnabledAt(); ok {
+		_spec.AddField(user.FieldTotpEnabledAt, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.TotpRecoveryCodes(); ok {
+		_spec.SetField(user.FieldTotpRecoveryCodes, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
+	if value, ok := uuo.mutation.AddedTotpLastStep(); ok {
+		_spec.AddField(user.FieldTotpLastStep, field.TypeInt64, value)
+	}
 	_spec.AddModifiers(uuo.modifiers...)
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,314 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/app/repository/schema/types"
+	"go-scaffold/internal/pkg/ent/ent/useridentity"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserIdentityCreate is the builder for creating a UserIdentity entity.
+type UserIdentityCreate struct {
+	config
+	mutation *UserIdentityMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (uic *UserIdentityCreate) SetCreatedAt(tt types.UnixTimestamp) *UserIdentityCreate {
+	uic.mutation.SetCreatedAt(tt)
+	return uic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableCreatedAt(tt *types.UnixTimestamp) *UserIdentityCreate {
+	if tt != nil {
+		uic.SetCreatedAt(*tt)
+	}
+	return uic
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (uic *UserIdentityCreate) SetUpdatedAt(tt types.UnixTimestamp) *UserIdentityCreate {
+	uic.mutation.SetUpdatedAt(tt)
+	return uic
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableUpdatedAt(tt *types.UnixTimestamp) *UserIdentityCreate {
+	if tt != nil {
+		uic.SetUpdatedAt(*tt)
+	}
+	return uic
+}
+
+// SetUserID sets the "user_id" field.
+func (uic *UserIdentityCreate) SetUserID(i int64) *UserIdentityCreate {
+	uic.mutation.SetUserID(i)
+	return uic
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableUserID(i *int64) *UserIdentityCreate {
+	if i != nil {
+		uic.SetUserID(*i)
+	}
+	return uic
+}
+
+// SetProvider sets the "provider" field.
+func (uic *UserIdentityCreate) SetProvider(s string) *UserIdentityCreate {
+	uic.mutation.SetProvider(s)
+	return uic
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableProvider(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetProvider(*s)
+	}
+	return uic
+}
+
+// SetSubject sets the "subject" field.
+func (uic *UserIdentityCreate) SetSubject(s string) *UserIdentityCreate {
+	uic.mutation.SetSubject(s)
+	return uic
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableSubject(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetSubject(*s)
+	}
+	return uic
+}
+
+// SetID sets the "id" field.
+func (uic *UserIdentityCreate) SetID(i int64) *UserIdentityCreate {
+	uic.mutation.SetID(i)
+	return uic
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uic *UserIdentityCreate) Mutation() *UserIdentityMutation {
+	return uic.mutation
+}
+
+// Save creates the UserIdentity in the database.
+func (uic *UserIdentityCreate) Save(ctx context.Context) (*UserIdentity, error) {
+	uic.defaults()
+	return withHooks(ctx, uic.sqlSave, uic.mutation, uic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uic *UserIdentityCreate) SaveX(ctx context.Context) *UserIdentity {
+	v, err := uic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uic *UserIdentityCreate) Exec(ctx context.Context) error {
+	_, err := uic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uic *UserIdentityCreate) ExecX(ctx context.Context) {
+	if err := uic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uic *UserIdentityCreate) defaults() {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		v := useridentity.DefaultCreatedAt()
+		uic.mutation.SetCreatedAt(v)
+	}
+	if _, ok := uic.mutation.UpdatedAt(); !ok {
+		v := useridentity.DefaultUpdatedAt()
+		uic.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := uic.mutation.UserID(); !ok {
+		v := useridentity.DefaultUserID
+		uic.mutation.SetUserID(v)
+	}
+	if _, ok := uic.mutation.Provider(); !ok {
+		v := useridentity.DefaultProvider
+		uic.mutation.SetProvider(v)
+	}
+	if _, ok := uic.mutation.Subject(); !ok {
+		v := useridentity.DefaultSubject
+		uic.mutation.SetSubject(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uic *UserIdentityCreate) check() error {
+	if _, ok := uic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserIdentity.created_at"`)}
+	}
+	if _, ok := uic.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserIdentity.updated_at"`)}
+	}
+	if _, ok := uic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserIdentity.user_id"`)}
+	}
+	if _, ok := uic.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "UserIdentity.provider"`)}
+	}
+	if _, ok := uic.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "UserIdentity.subject"`)}
+	}
+	return nil
+}
+
+func (uic *UserIdentityCreate) sqlSave(ctx context.Context) (*UserIdentity, error) {
+	if err := uic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	uic.mutation.id = &_node.ID
+	uic.mutation.done = true
+	return _node, nil
+}
+
+func (uic *UserIdentityCreate) createSpec() (*UserIdentity, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserIdentity{config: uic.config}
+		_spec = sqlgraph.NewCreateSpec(useridentity.Table, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt64))
+	)
+	if id, ok := uic.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := uic.mutation.CreatedAt(); ok {
+		_spec.SetField(useridentity.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := uic.mutation.UpdatedAt(); ok {
+		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := uic.mutation.UserID(); ok {
+		_spec.SetField(useridentity.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := uic.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := uic.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	return _node, _spec
+}
+
+// UserIdentityCreateBulk is the builder for creating many UserIdentity entities in bulk.
+type UserIdentityCreateBulk struct {
+	config
+	err      error
+	builders []*UserIdentityCreate
+}
+
+// Save creates the UserIdentity entities in the database.
+func (uicb *UserIdentityCreateBulk) Save(ctx context.Context) ([]*UserIdentity, error) {
+	if uicb.err != nil {
+		return nil, uicb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uicb.builders))
+	nodes := make([]*UserIdentity, len(uicb.builders))
+	mutators := make([]Mutator, len(uicb.builders))
+	for i := range uicb.builders {
+		func(i int, root context.Context) {
+			builder := uicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserIdentityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uicb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uicb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uicb *UserIdentityCreateBulk) SaveX(ctx context.Context) []*UserIdentity {
+	v, err := uicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uicb *UserIdentityCreateBulk) Exec(ctx context.Context) error {
+	_, err := uicb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uicb *UserIdentityCreateBulk) ExecX(ctx context.Context) {
+	if err := uicb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
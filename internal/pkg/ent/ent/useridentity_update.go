@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-scaffold/internal/pkg/ent/ent/predicate"
+	"go-scaffold/internal/pkg/ent/ent/useridentity"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserIdentityUpdate is the builder for updating UserIdentity entities.
+type UserIdentityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserIdentityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserIdentityUpdate builder.
+func (uiu *UserIdentityUpdate) Where(ps ...predicate.UserIdentity) *UserIdentityUpdate {
+	uiu.mutation.Where(ps...)
+	return uiu
+}
+
+// SetUserID sets the "user_id" field.
+func (uiu *UserIdentityUpdate) SetUserID(i int64) *UserIdentityUpdate {
+	uiu.mutation.ResetUserID()
+	uiu.mutation.SetUserID(i)
+	return uiu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableUserID(i *int64) *UserIdentityUpdate {
+	if i != nil {
+		uiu.SetUserID(*i)
+	}
+	return uiu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (uiu *UserIdentityUpdate) AddUserID(i int64) *UserIdentityUpdate {
+	uiu.mutation.AddUserID(i)
+	return uiu
+}
+
+// SetProvider sets the "provider" field.
+func (uiu *UserIdentityUpdate) SetProvider(s string) *UserIdentityUpdate {
+	uiu.mutation.SetProvider(s)
+	return uiu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableProvider(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetProvider(*s)
+	}
+	return uiu
+}
+
+// SetSubject sets the "subject" field.
+func (uiu *UserIdentityUpdate) SetSubject(s string) *UserIdentityUpdate {
+	uiu.mutation.SetSubject(s)
+	return uiu
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableSubject(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetSubject(*s)
+	}
+	return uiu
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uiu *UserIdentityUpdate) Mutation() *UserIdentityMutation {
+	return uiu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uiu *UserIdentityUpdate) Save(ctx context.Context) (int, error) {
+	uiu.defaults()
+	return withHooks(ctx, uiu.sqlSave, uiu.mutation, uiu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uiu *UserIdentityUpdate) SaveX(ctx context.Context) int {
+	affected, err := uiu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uiu *UserIdentityUpdate) Exec(ctx context.Context) error {
+	_, err := uiu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uiu *UserIdentityUpdate) ExecX(ctx context.Context) {
+	if err := uiu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uiu *UserIdentityUpdate) defaults() {
+	if _, ok := uiu.mutation.UpdatedAt(); !ok {
+		v := useridentity.UpdateDefaultUpdatedAt()
+		uiu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uiu *UserIdentityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserIdentityUpdate {
+	uiu.modifiers = append(uiu.modifiers, modifiers...)
+	return uiu
+}
+
+func (uiu *UserIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(useridentity.Table, useridentity.Columns, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt64))
+	if ps := uiu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uiu.mutation.UpdatedAt(); ok {
+		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiu.mutation.UserID(); ok {
+		_spec.SetField(useridentity.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := uiu.mutation.AddedUserID(); ok {
+		_spec.AddField(useridentity.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := uiu.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := uiu.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+	}
+	_spec.AddModifiers(uiu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, uiu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useridentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uiu.mutation.done = true
+	return n, nil
+}
+
+// UserIdentityUpdateOne is the builder for updating a single UserIdentity entity.
+type UserIdentityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserIdentityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (uiuo *UserIdentityUpdateOne) SetUserID(i int64) *UserIdentityUpdateOne {
+	uiuo.mutation.ResetUserID()
+	uiuo.mutation.SetUserID(i)
+	return uiuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableUserID(i *int64) *UserIdentityUpdateOne {
+	if i != nil {
+		uiuo.SetUserID(*i)
+	}
+	return uiuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (uiuo *UserIdentityUpdateOne) AddUserID(i int64) *UserIdentityUpdateOne {
+	uiuo.mutation.AddUserID(i)
+	return uiuo
+}
+
+// SetProvider sets the "provider" field.
+func (uiuo *UserIdentityUpdateOne) SetProvider(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetProvider(s)
+	return uiuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableProvider(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetProvider(*s)
+	}
+	return uiuo
+}
+
+// SetSubject sets the "subject" field.
+func (uiuo *UserIdentityUpdateOne) SetSubject(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetSubject(s)
+	return uiuo
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableSubject(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetSubject(*s)
+	}
+	return uiuo
+}
+
+// Mutation returns the UserIdentityMutation object of the builder.
+func (uiuo *UserIdentityUpdateOne) Mutation() *UserIdentityMutation {
+	return uiuo.mutation
+}
+
+// Where appends a list predicates to the UserIdentityUpdate builder.
+func (uiuo *UserIdentityUpdateOne) Where(ps ...predicate.UserIdentity) *UserIdentityUpdateOne {
+	uiuo.mutation.Where(ps...)
+	return uiuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uiuo *UserIdentityUpdateOne) Select(field string, fields ...string) *UserIdentityUpdateOne {
+	uiuo.fields = append([]string{field}, fields...)
+	return uiuo
+}
+
+// Save executes the query and returns the updated UserIdentity entity.
+func (uiuo *UserIdentityUpdateOne) Save(ctx context.Context) (*UserIdentity, error) {
+	uiuo.defaults()
+	return withHooks(ctx, uiuo.sqlSave, uiuo.mutation, uiuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uiuo *UserIdentityUpdateOne) SaveX(ctx context.Context) *UserIdentity {
+	node, err := uiuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uiuo *UserIdentityUpdateOne) Exec(ctx context.Context) error {
+	_, err := uiuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uiuo *UserIdentityUpdateOne) ExecX(ctx context.Context) {
+	if err := uiuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uiuo *UserIdentityUpdateOne) defaults() {
+	if _, ok := uiuo.mutation.UpdatedAt(); !ok {
+		v := useridentity.UpdateDefaultUpdatedAt()
+		uiuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (uiuo *UserIdentityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserIdentityUpdateOne {
+	uiuo.modifiers = append(uiuo.modifiers, modifiers...)
+	return uiuo
+}
+
+func (uiuo *UserIdentityUpdateOne) sqlSave(ctx context.Context) (_node *UserIdentity, err error) {
+	_spec := sqlgraph.NewUpdateSpec(useridentity.Table, useridentity.Columns, sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeInt64))
+	id, ok := uiuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserIdentity.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uiuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, useridentity.FieldID)
+		for _, f := range fields {
+			if !useridentity.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != useridentity.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uiuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uiuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := uiuo.mutation.UserID(); ok {
+		_spec.SetField(useridentity.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := uiuo.mutation.AddedUserID(); ok {
+		_spec.AddField(useridentity.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := uiuo.mutation.Provider(); ok {
+		_spec.SetField(useridentity.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := uiuo.mutation.Subject(); ok {
+		_spec.SetField(useridentity.FieldSubject, field.TypeString, value)
+	}
+	_spec.AddModifiers(uiuo.modifiers...)
+	_node = &UserIdentity{config: uiuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uiuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{useridentity.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uiuo.mutation.done = true
+	return _node, nil
+}
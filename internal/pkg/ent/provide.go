@@ -1,7 +1,6 @@
 package ent
 
 import (
-	"context"
 	"log/slog"
 
 	"go-scaffold/internal/config"
@@ -11,24 +10,8 @@ import (
 
 type DefaultClient = ent.Client
 
-// ProvideDefault default db client
-func ProvideDefault(ctx context.Context, env config.Env, conf config.DefaultDatabase, logger *slog.Logger) (*DefaultClient, func(), error) {
-	sdb, err := db.New(ctx, conf.DatabaseConn)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	client, err := New(env, conf.DatabaseConn, logger, sdb)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	cleanup := func() {
-		err = client.Close()
-		if err != nil {
-			panic(err)
-		}
-	}
-
-	return client, cleanup, nil
+// ProvideDefault default db client,
+// the connection is closed by the cleanup of db.ProvideDefault
+func ProvideDefault(env config.Env, conf config.DefaultDatabase, logger *slog.Logger, sdb *db.DefaultDB) (*DefaultClient, error) {
+	return New(env, conf.DatabaseConn, logger, sdb.DB)
 }
@@ -0,0 +1,50 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var _ Mailer = (*FileMailer)(nil)
+
+// FileMailer write every mail to a .eml file in the directory instead of sending it,
+// which is useful in development and testing
+type FileMailer struct {
+	from string
+	dir  string
+}
+
+// NewFile build file mailer, the directory is created if not exist
+func NewFile(from, dir string) (*FileMailer, error) {
+	if dir == "" {
+		return nil, errors.New("the mail directory is required")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &FileMailer{
+		from: from,
+		dir:  dir,
+	}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	if msg.From == "" {
+		msg.From = m.from
+	}
+
+	data, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s.eml", time.Now().Format("20060102150405.000000000"))
+	return errors.WithStack(os.WriteFile(filepath.Join(m.dir, name), data, 0o600))
+}
@@ -0,0 +1,39 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+)
+
+var _ Mailer = (*LogMailer)(nil)
+
+// LogMailer log the mails instead of sending them, which is useful in development and testing
+//
+// the body may contain secrets such as the password reset link, never use it in production
+type LogMailer struct {
+	from   string
+	logger *slog.Logger
+}
+
+// NewLog build log mailer
+func NewLog(from string, logger *slog.Logger) *LogMailer {
+	return &LogMailer{
+		from:   from,
+		logger: logger,
+	}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	if msg.From == "" {
+		msg.From = m.from
+	}
+
+	m.logger.InfoContext(ctx, "mail sent",
+		slog.String("from", msg.From),
+		slog.Any("to", msg.To),
+		slog.String("subject", msg.Subject),
+		slog.String("body", msg.Body),
+	)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/config"
+)
+
+// Mailer the interface that the mail sender must implement
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New build mailer according to the driver,
+// the log driver is only allowed in the debug environments, since the mails carry the password reset and verification tokens
+func New(logger *slog.Logger, env config.Env, conf config.Mail) (Mailer, error) {
+	switch conf.Driver {
+	case config.SMTPMailDriver:
+		return NewSMTP(conf.From, conf.SMTP)
+	case config.FileMailDriver:
+		return NewFile(conf.From, conf.File.Dir)
+	case config.LogMailDriver, "":
+		if !env.IsDebug() {
+			return nil, errors.Errorf("the log mail driver is not allowed in the %s environment, please configure the smtp mail driver", env)
+		}
+		return NewLog(conf.From, logger), nil
+	default:
+		return nil, errors.Errorf("unsupported mail driver: %s", conf.Driver)
+	}
+}
+
+// Message the plain text mail
+type Message struct {
+	From    string
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Bytes encode the message in RFC 5322 format
+func (m Message) Bytes() ([]byte, error) {
+	if len(m.To) == 0 {
+		return nil, errors.New("the recipient of the mail is required")
+	}
+
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "From: %s\r\n", m.From)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(m.To, ", "))
+	fmt.Fprintf(buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes(), nil
+}
+
+// addresses returns the bare addresses of the recipients
+func (m Message) addresses() ([]string, error) {
+	list := make([]string, 0, len(m.To))
+	for _, to := range m.To {
+		addr, err := mail.ParseAddress(to)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		list = append(list, addr.Address)
+	}
+	return list, nil
+}
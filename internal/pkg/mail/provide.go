@@ -0,0 +1,12 @@
+package mail
+
+import (
+	"log/slog"
+
+	"go-scaffold/internal/config"
+)
+
+// Provide mailer
+func Provide(logger *slog.Logger, env config.Env, conf config.App) (Mailer, error) {
+	return New(logger, env, conf.Mail)
+}
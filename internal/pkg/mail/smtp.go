@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"go-scaffold/internal/config"
+)
+
+var _ Mailer = (*SMTPMailer)(nil)
+
+// SMTPMailer send the mails by the SMTP server,
+// STARTTLS is used if the server supports it
+type SMTPMailer struct {
+	from string
+	addr string
+	auth smtp.Auth
+}
+
+// NewSMTP build smtp mailer
+func NewSMTP(from string, conf config.MailSMTP) (*SMTPMailer, error) {
+	if conf.Host == "" {
+		return nil, errors.New("the smtp host is required")
+	}
+
+	port := conf.Port
+	if port == 0 {
+		port = 587
+	}
+
+	m := &SMTPMailer{
+		from: from,
+		addr: net.JoinHostPort(conf.Host, strconv.Itoa(port)),
+	}
+	if conf.Username != "" {
+		m.auth = smtp.PlainAuth("", conf.Username, conf.Password, conf.Host)
+	}
+
+	return m, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if msg.From == "" {
+		msg.From = m.from
+	}
+
+	from, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	to, err := msg.addresses()
+	if err != nil {
+		return err
+	}
+
+	data, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	return errors.WithStack(smtp.SendMail(m.addr, m.auth, from.Address, to, data))
+}
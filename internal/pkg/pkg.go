@@ -9,17 +9,22 @@ import (
 	"go-scaffold/internal/pkg/discovery"
 	"go-scaffold/internal/pkg/ent"
 	"go-scaffold/internal/pkg/gorm"
+	"go-scaffold/internal/pkg/mail"
 	"go-scaffold/internal/pkg/redis"
 	"go-scaffold/internal/pkg/uid"
 )
 
 var ProviderSet = wire.NewSet(
 	casbin.Provide,
+	casbin.ProvideWatcher,
+	casbin.ProvideDecisionCache,
 	client.ProvideGRPC,
 	db.Provide,
+	db.ProvideDefault,
 	discovery.Provide,
 	ent.ProvideDefault,
 	gorm.ProvideDefault,
+	mail.Provide,
 	redis.ProvideDefault,
 	uid.Provide,
 )
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE `users` MODIFY `password` varchar(255) NOT NULL DEFAULT '' COMMENT '密码';
+
+-- +migrate Down
+
+ALTER TABLE `users` MODIFY `password` varchar(64) NOT NULL DEFAULT '' COMMENT '密码';
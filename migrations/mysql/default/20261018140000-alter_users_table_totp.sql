@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE `users`
+    ADD `totp_secret`         varchar(64)   NOT NULL DEFAULT '' COMMENT 'TOTP 密钥' AFTER `salt`,
+    ADD `totp_enabled_at`     bigint        NOT NULL DEFAULT 0 COMMENT 'TOTP 启用时间' AFTER `totp_secret`,
+    ADD `totp_recovery_codes` varchar(1024) NOT NULL DEFAULT '' COMMENT 'TOTP 恢复码摘要' AFTER `totp_enabled_at`;
+
+-- +migrate Down
+
+ALTER TABLE `users`
+    DROP `totp_secret`,
+    DROP `totp_enabled_at`,
+    DROP `totp_recovery_codes`;
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE `users`
+    ADD `email`             varchar(255) NOT NULL DEFAULT '' COMMENT '邮箱' AFTER `phone`,
+    ADD `email_verified_at` bigint       NOT NULL DEFAULT 0 COMMENT '邮箱验证时间' AFTER `email`,
+    ADD KEY `email` (`email`);
+
+-- +migrate Down
+
+ALTER TABLE `users`
+    DROP KEY `email`,
+    DROP `email`,
+    DROP `email_verified_at`;
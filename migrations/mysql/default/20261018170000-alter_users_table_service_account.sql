@@ -0,0 +1,9 @@
+-- +migrate Up
+
+ALTER TABLE `users`
+    ADD `service_account` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否为服务账号' AFTER `email_verified_at`;
+
+-- +migrate Down
+
+ALTER TABLE `users`
+    DROP `service_account`;
@@ -0,0 +1,25 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `api_keys`
+(
+    `id`           int unsigned    NOT NULL AUTO_INCREMENT,
+    `user_id`      int unsigned    NOT NULL DEFAULT 0 COMMENT '服务账号 id',
+    `name`         varchar(64)     NOT NULL DEFAULT '' COMMENT '名称',
+    `prefix`       varchar(32)     NOT NULL DEFAULT '' COMMENT '密钥前缀',
+    `hash`         varchar(64)     NOT NULL DEFAULT '' COMMENT '密钥摘要',
+    `scopes`       text            NOT NULL COMMENT '授权范围',
+    `expires_at`   bigint          NOT NULL DEFAULT 0 COMMENT '过期时间',
+    `last_used_at` bigint          NOT NULL DEFAULT 0 COMMENT '最后使用时间',
+    `created_at`   bigint          NOT NULL DEFAULT 0,
+    `updated_at`   bigint          NOT NULL DEFAULT 0,
+    `deleted_at`   bigint unsigned NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    UNIQUE `prefix` (`prefix`),
+    KEY `user_id` (`user_id`),
+    KEY `deleted_at` (`deleted_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='API 密钥表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `api_keys`;
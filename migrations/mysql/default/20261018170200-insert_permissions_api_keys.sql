@@ -0,0 +1,11 @@
+-- +migrate Up
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at) VALUES ('/api-keys', 'API 密钥管理', 0, unix_timestamp(), unix_timestamp());
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('GET /api/v1/api-keys', 'API 密钥列表', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/api-keys') AS t), unix_timestamp(), unix_timestamp()),
+       ('POST /api/v1/api-key', 'API 密钥新增', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/api-keys') AS t), unix_timestamp(), unix_timestamp()),
+       ('DELETE /api/v1/api-key/:id', 'API 密钥删除', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/api-keys') AS t), unix_timestamp(), unix_timestamp());
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE `key` IN ('GET /api/v1/api-keys', 'POST /api/v1/api-key', 'DELETE /api/v1/api-key/:id', '/api-keys');
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `user_identities`
+(
+    `id`         int unsigned NOT NULL AUTO_INCREMENT,
+    `user_id`    int unsigned NOT NULL DEFAULT 0 COMMENT '用户 id',
+    `provider`   varchar(64)  NOT NULL DEFAULT '' COMMENT '身份提供方',
+    `subject`    varchar(255) NOT NULL DEFAULT '' COMMENT '身份提供方的用户标识',
+    `created_at` bigint       NOT NULL DEFAULT 0,
+    `updated_at` bigint       NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    UNIQUE `provider_subject` (`provider`, `subject`),
+    KEY `user_id` (`user_id`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='用户外部身份表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `user_identities`;
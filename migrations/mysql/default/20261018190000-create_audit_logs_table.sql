@@ -0,0 +1,26 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `audit_logs`
+(
+    `id`         int unsigned NOT NULL AUTO_INCREMENT,
+    `actor_id`   int unsigned NOT NULL DEFAULT 0 COMMENT '操作人 id',
+    `user_id`    int unsigned NOT NULL DEFAULT 0 COMMENT '被模拟的用户 id',
+    `action`     varchar(32)  NOT NULL DEFAULT '' COMMENT '操作',
+    `method`     varchar(16)  NOT NULL DEFAULT '' COMMENT '请求方法',
+    `path`       varchar(255) NOT NULL DEFAULT '' COMMENT '请求路径',
+    `status`     int          NOT NULL DEFAULT 0 COMMENT '响应状态码',
+    `ip`         varchar(64)  NOT NULL DEFAULT '' COMMENT '客户端 IP',
+    `user_agent` varchar(255) NOT NULL DEFAULT '' COMMENT '客户端 User-Agent',
+    `detail`     varchar(255) NOT NULL DEFAULT '' COMMENT '详情',
+    `created_at` bigint       NOT NULL DEFAULT 0,
+    `updated_at` bigint       NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    KEY `actor_id` (`actor_id`),
+    KEY `user_id` (`user_id`),
+    KEY `created_at` (`created_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='审计日志表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `audit_logs`;
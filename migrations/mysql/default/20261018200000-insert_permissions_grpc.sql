@@ -0,0 +1,40 @@
+-- +migrate Up
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('/internal.app.adapter.grpc.api.v1.user.User/List', '用户列表（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/Detail', '用户详情（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/Create', '用户新增（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/Update', '用户更新（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/Delete', '用户删除（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/AssignRoles', '分配用户角色（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/GetRoles', '获取用户角色（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.user.User/Unlock', '解除用户锁定（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.account.Account/ListSessions', '用户会话列表（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.account.Account/RevokeSession', '撤销用户会话（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), unix_timestamp(), unix_timestamp());
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('/internal.app.adapter.grpc.api.v1.role.Role/List', '角色列表（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/Detail', '角色详情（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/Create', '角色新增（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/Update', '角色更新（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/Delete', '角色删除（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/GrantPermissions', '授予角色权限（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/GetPermissions', '获取角色权限（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/roles') AS t), unix_timestamp(), unix_timestamp());
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('/internal.app.adapter.grpc.api.v1.permission.Permission/List', '权限列表（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/permissions') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.permission.Permission/Detail', '权限详情（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/permissions') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.permission.Permission/Create', '权限新增（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/permissions') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.permission.Permission/Update', '权限更新（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/permissions') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.permission.Permission/Delete', '权限删除（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/permissions') AS t), unix_timestamp(), unix_timestamp());
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('/internal.app.adapter.grpc.api.v1.product.Product/List', '产品列表（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/products') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.product.Product/Detail', '产品详情（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/products') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.product.Product/Create', '产品新增（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/products') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.product.Product/Update', '产品更新（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/products') AS t), unix_timestamp(), unix_timestamp()),
+       ('/internal.app.adapter.grpc.api.v1.product.Product/Delete', '产品删除（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/products') AS t), unix_timestamp(), unix_timestamp());
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE `key` LIKE '/internal.app.adapter.grpc.api.v1.%';
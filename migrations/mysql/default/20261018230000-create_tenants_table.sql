@@ -0,0 +1,39 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `tenants`
+(
+    `id`         int unsigned    NOT NULL AUTO_INCREMENT,
+    `name`       varchar(64)     NOT NULL DEFAULT '' COMMENT '租户名称',
+    `created_at` bigint          NOT NULL DEFAULT 0,
+    `updated_at` bigint          NOT NULL DEFAULT 0,
+    `deleted_at` bigint unsigned NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    UNIQUE `name` (`name`),
+    KEY `deleted_at` (`deleted_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='租户表';
+
+INSERT INTO `tenants` (`id`, `name`, `created_at`, `updated_at`)
+VALUES (1, 'default', unix_timestamp(), unix_timestamp());
+
+ALTER TABLE `users`
+    ADD `tenant_id` int unsigned NOT NULL DEFAULT 1 COMMENT '所属租户 id' AFTER `id`,
+    ADD KEY `tenant_id` (`tenant_id`);
+
+ALTER TABLE `roles`
+    ADD `tenant_id` int unsigned NOT NULL DEFAULT 1 COMMENT '租户 id' AFTER `id`,
+    DROP KEY `name`,
+    ADD UNIQUE `tenant_id_name` (`tenant_id`, `name`);
+
+-- +migrate Down
+
+ALTER TABLE `roles`
+    DROP KEY `tenant_id_name`,
+    DROP `tenant_id`,
+    ADD UNIQUE `name` (`name`);
+
+ALTER TABLE `users`
+    DROP KEY `tenant_id`,
+    DROP `tenant_id`;
+
+DROP TABLE IF EXISTS `tenants`;
@@ -0,0 +1,31 @@
+-- +migrate Up
+
+ALTER TABLE `users`
+    ADD `department_id` int unsigned NOT NULL DEFAULT 0 COMMENT '所属部门 id' AFTER `tenant_id`,
+    ADD KEY `department_id` (`department_id`);
+
+ALTER TABLE `products`
+    ADD `owner_id`      int unsigned NOT NULL DEFAULT 0 COMMENT '创建人 id' AFTER `price`,
+    ADD `department_id` int unsigned NOT NULL DEFAULT 0 COMMENT '所属部门 id' AFTER `owner_id`,
+    ADD KEY `owner_id` (`owner_id`),
+    ADD KEY `department_id` (`department_id`);
+
+ALTER TABLE `roles`
+    ADD `data_scope`             varchar(16)  NOT NULL DEFAULT 'all' COMMENT '数据范围：all 全部，own 本人，department 本部门，custom 自定义部门' AFTER `name`,
+    ADD `data_scope_departments` varchar(255) NOT NULL DEFAULT '' COMMENT '自定义数据范围的部门 id，逗号分隔' AFTER `data_scope`;
+
+-- +migrate Down
+
+ALTER TABLE `roles`
+    DROP `data_scope`,
+    DROP `data_scope_departments`;
+
+ALTER TABLE `products`
+    DROP KEY `owner_id`,
+    DROP KEY `department_id`,
+    DROP `owner_id`,
+    DROP `department_id`;
+
+ALTER TABLE `users`
+    DROP KEY `department_id`,
+    DROP `department_id`;
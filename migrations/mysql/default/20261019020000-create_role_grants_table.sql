@@ -0,0 +1,22 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `role_grants`
+(
+    `id`         int unsigned NOT NULL AUTO_INCREMENT,
+    `tenant_id`  int unsigned NOT NULL DEFAULT 0 COMMENT '租户 id',
+    `user_id`    int unsigned NOT NULL DEFAULT 0 COMMENT '用户 id',
+    `role_id`    int unsigned NOT NULL DEFAULT 0 COMMENT '角色 id',
+    `starts_at`  bigint       NOT NULL DEFAULT 0 COMMENT '生效时间',
+    `expires_at` bigint       NOT NULL DEFAULT 0 COMMENT '过期时间',
+    `created_at` bigint       NOT NULL DEFAULT 0,
+    `updated_at` bigint       NOT NULL DEFAULT 0,
+    PRIMARY KEY (`id`),
+    KEY `tenant_id_user_id` (`tenant_id`, `user_id`),
+    KEY `starts_at` (`starts_at`),
+    KEY `expires_at` (`expires_at`)
+) ENGINE = InnoDB
+  DEFAULT CHARSET = utf8mb4 COMMENT ='角色授予表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `role_grants`;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+ALTER TABLE `users`
+    ADD `totp_last_step` bigint NOT NULL DEFAULT 0 COMMENT '最后使用的 TOTP 时间步' AFTER `totp_recovery_codes`;
+
+-- +migrate Down
+
+ALTER TABLE `users`
+    DROP `totp_last_step`;
@@ -0,0 +1,15 @@
+-- +migrate Up
+
+ALTER TABLE `audit_logs`
+    ADD `tenant_id` int unsigned NOT NULL DEFAULT 1 COMMENT '租户 id' AFTER `id`,
+    ADD KEY `tenant_id` (`tenant_id`);
+
+UPDATE `audit_logs`
+    INNER JOIN `users` ON `users`.`id` = `audit_logs`.`user_id`
+SET `audit_logs`.`tenant_id` = `users`.`tenant_id`;
+
+-- +migrate Down
+
+ALTER TABLE `audit_logs`
+    DROP KEY `tenant_id`,
+    DROP `tenant_id`;
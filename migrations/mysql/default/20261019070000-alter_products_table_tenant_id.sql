@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE `products`
+    ADD `tenant_id` int unsigned NOT NULL DEFAULT 1 COMMENT '租户 id' AFTER `id`,
+    ADD KEY `tenant_id` (`tenant_id`);
+
+-- +migrate Down
+
+ALTER TABLE `products`
+    DROP KEY `tenant_id`,
+    DROP `tenant_id`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE users ALTER COLUMN password TYPE varchar(255);
+
+-- +migrate Down
+
+ALTER TABLE users ALTER COLUMN password TYPE varchar(64);
@@ -0,0 +1,17 @@
+-- +migrate Up
+
+ALTER TABLE users
+    ADD totp_secret         varchar(64)   NOT NULL DEFAULT '',
+    ADD totp_enabled_at     bigint        NOT NULL DEFAULT 0,
+    ADD totp_recovery_codes varchar(1024) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN users.totp_secret IS 'TOTP 密钥';
+COMMENT ON COLUMN users.totp_enabled_at IS 'TOTP 启用时间';
+COMMENT ON COLUMN users.totp_recovery_codes IS 'TOTP 恢复码摘要';
+
+-- +migrate Down
+
+ALTER TABLE users
+    DROP totp_secret,
+    DROP totp_enabled_at,
+    DROP totp_recovery_codes;
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+INSERT INTO permissions (key, name, parent_id, created_at, updated_at)
+VALUES ('DELETE /api/v1/user/:id/lockout', '解除用户锁定', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/users') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))));
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE key = 'DELETE /api/v1/user/:id/lockout';
@@ -0,0 +1,18 @@
+-- +migrate Up
+
+ALTER TABLE users
+    ADD email             varchar(255) NOT NULL DEFAULT '',
+    ADD email_verified_at bigint       NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN users.email IS '邮箱';
+COMMENT ON COLUMN users.email_verified_at IS '邮箱验证时间';
+
+CREATE INDEX users_email_idx ON users (email);
+
+-- +migrate Down
+
+DROP INDEX users_email_idx;
+
+ALTER TABLE users
+    DROP email,
+    DROP email_verified_at;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE users
+    ADD service_account boolean NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN users.service_account IS '是否为服务账号';
+
+-- +migrate Down
+
+ALTER TABLE users
+    DROP service_account;
@@ -0,0 +1,35 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id           bigserial   NOT NULL,
+    user_id      bigint      NOT NULL DEFAULT 0,
+    name         varchar(64) NOT NULL DEFAULT '',
+    prefix       varchar(32) NOT NULL DEFAULT '',
+    hash         varchar(64) NOT NULL DEFAULT '',
+    scopes       text        NOT NULL DEFAULT '',
+    expires_at   bigint      NOT NULL DEFAULT 0,
+    last_used_at bigint      NOT NULL DEFAULT 0,
+    created_at   bigint      NOT NULL DEFAULT 0,
+    updated_at   bigint      NOT NULL DEFAULT 0,
+    deleted_at   bigint      NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    UNIQUE (prefix)
+);
+
+CREATE INDEX ON api_keys (user_id);
+CREATE INDEX ON api_keys (deleted_at);
+
+COMMENT ON COLUMN api_keys.user_id IS '服务账号 id';
+COMMENT ON COLUMN api_keys.name IS '名称';
+COMMENT ON COLUMN api_keys.prefix IS '密钥前缀';
+COMMENT ON COLUMN api_keys.hash IS '密钥摘要';
+COMMENT ON COLUMN api_keys.scopes IS '授权范围';
+COMMENT ON COLUMN api_keys.expires_at IS '过期时间';
+COMMENT ON COLUMN api_keys.last_used_at IS '最后使用时间';
+
+COMMENT ON TABLE api_keys IS 'API 密钥表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS api_keys;
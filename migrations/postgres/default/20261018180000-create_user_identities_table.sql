@@ -0,0 +1,25 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS user_identities
+(
+    id         bigserial    NOT NULL,
+    user_id    bigint       NOT NULL DEFAULT 0,
+    provider   varchar(64)  NOT NULL DEFAULT '',
+    subject    varchar(255) NOT NULL DEFAULT '',
+    created_at bigint       NOT NULL DEFAULT 0,
+    updated_at bigint       NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX ON user_identities (user_id);
+
+COMMENT ON COLUMN user_identities.user_id IS '用户 id';
+COMMENT ON COLUMN user_identities.provider IS '身份提供方';
+COMMENT ON COLUMN user_identities.subject IS '身份提供方的用户标识';
+
+COMMENT ON TABLE user_identities IS '用户外部身份表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS user_identities;
@@ -0,0 +1,38 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS audit_logs
+(
+    id         bigserial    NOT NULL,
+    actor_id   bigint       NOT NULL DEFAULT 0,
+    user_id    bigint       NOT NULL DEFAULT 0,
+    action     varchar(32)  NOT NULL DEFAULT '',
+    method     varchar(16)  NOT NULL DEFAULT '',
+    path       varchar(255) NOT NULL DEFAULT '',
+    status     integer      NOT NULL DEFAULT 0,
+    ip         varchar(64)  NOT NULL DEFAULT '',
+    user_agent varchar(255) NOT NULL DEFAULT '',
+    detail     varchar(255) NOT NULL DEFAULT '',
+    created_at bigint       NOT NULL DEFAULT 0,
+    updated_at bigint       NOT NULL DEFAULT 0,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX ON audit_logs (actor_id);
+CREATE INDEX ON audit_logs (user_id);
+CREATE INDEX ON audit_logs (created_at);
+
+COMMENT ON COLUMN audit_logs.actor_id IS '操作人 id';
+COMMENT ON COLUMN audit_logs.user_id IS '被模拟的用户 id';
+COMMENT ON COLUMN audit_logs.action IS '操作';
+COMMENT ON COLUMN audit_logs.method IS '请求方法';
+COMMENT ON COLUMN audit_logs.path IS '请求路径';
+COMMENT ON COLUMN audit_logs.status IS '响应状态码';
+COMMENT ON COLUMN audit_logs.ip IS '客户端 IP';
+COMMENT ON COLUMN audit_logs.user_agent IS '客户端 User-Agent';
+COMMENT ON COLUMN audit_logs.detail IS '详情';
+
+COMMENT ON TABLE audit_logs IS '审计日志表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS audit_logs;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+INSERT INTO permissions (key, name, parent_id, created_at, updated_at)
+VALUES ('GET /api/v1/permissions/tree', '权限树', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/permissions') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0)))),
+       ('PUT /api/v1/permission/move', '权限移动', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/permissions') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0)))),
+       ('/internal.app.adapter.grpc.api.v1.permission.Permission/Tree', '权限树（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/permissions') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0)))),
+       ('/internal.app.adapter.grpc.api.v1.permission.Permission/Move', '权限移动（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/permissions') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))));
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE key IN ('GET /api/v1/permissions/tree', 'PUT /api/v1/permission/move', '/internal.app.adapter.grpc.api.v1.permission.Permission/Tree', '/internal.app.adapter.grpc.api.v1.permission.Permission/Move');
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+INSERT INTO permissions (key, name, parent_id, created_at, updated_at)
+VALUES ('GET /api/v1/role/parents', '获取父级角色', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/roles') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0)))),
+       ('POST /api/v1/role/parents', '设置父级角色', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/roles') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0)))),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/GetParents', '获取父级角色（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/roles') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0)))),
+       ('/internal.app.adapter.grpc.api.v1.role.Role/SetParents', '设置父级角色（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/roles') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))));
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE key IN ('GET /api/v1/role/parents', 'POST /api/v1/role/parents', '/internal.app.adapter.grpc.api.v1.role.Role/GetParents', '/internal.app.adapter.grpc.api.v1.role.Role/SetParents');
@@ -0,0 +1,51 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS tenants
+(
+    id         bigserial   NOT NULL,
+    name       varchar(64) NOT NULL DEFAULT '',
+    created_at bigint      NOT NULL DEFAULT 0,
+    updated_at bigint      NOT NULL DEFAULT 0,
+    deleted_at bigint      NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    UNIQUE (name)
+);
+
+CREATE INDEX ON tenants (deleted_at);
+
+COMMENT ON COLUMN tenants.name IS '租户名称';
+
+COMMENT ON TABLE tenants IS '租户表';
+
+INSERT INTO tenants (id, name, created_at, updated_at)
+VALUES (1, 'default', (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))));
+
+SELECT setval('tenants_id_seq', (SELECT MAX(id) FROM tenants));
+
+ALTER TABLE users
+    ADD tenant_id bigint NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN users.tenant_id IS '所属租户 id';
+
+CREATE INDEX users_tenant_id_idx ON users (tenant_id);
+
+ALTER TABLE roles
+    ADD tenant_id bigint NOT NULL DEFAULT 1,
+    DROP CONSTRAINT roles_name_key,
+    ADD CONSTRAINT roles_tenant_id_name_key UNIQUE (tenant_id, name);
+
+COMMENT ON COLUMN roles.tenant_id IS '租户 id';
+
+-- +migrate Down
+
+ALTER TABLE roles
+    DROP CONSTRAINT roles_tenant_id_name_key,
+    DROP tenant_id,
+    ADD CONSTRAINT roles_name_key UNIQUE (name);
+
+DROP INDEX users_tenant_id_idx;
+
+ALTER TABLE users
+    DROP tenant_id;
+
+DROP TABLE IF EXISTS tenants;
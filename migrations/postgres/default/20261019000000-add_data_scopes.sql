@@ -0,0 +1,43 @@
+-- +migrate Up
+
+ALTER TABLE users
+    ADD department_id bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN users.department_id IS '所属部门 id';
+
+CREATE INDEX users_department_id_idx ON users (department_id);
+
+ALTER TABLE products
+    ADD owner_id      bigint NOT NULL DEFAULT 0,
+    ADD department_id bigint NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN products.owner_id IS '创建人 id';
+COMMENT ON COLUMN products.department_id IS '所属部门 id';
+
+CREATE INDEX products_owner_id_idx ON products (owner_id);
+CREATE INDEX products_department_id_idx ON products (department_id);
+
+ALTER TABLE roles
+    ADD data_scope             varchar(16)  NOT NULL DEFAULT 'all',
+    ADD data_scope_departments varchar(255) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN roles.data_scope IS '数据范围：all 全部，own 本人，department 本部门，custom 自定义部门';
+COMMENT ON COLUMN roles.data_scope_departments IS '自定义数据范围的部门 id，逗号分隔';
+
+-- +migrate Down
+
+ALTER TABLE roles
+    DROP data_scope,
+    DROP data_scope_departments;
+
+DROP INDEX products_owner_id_idx;
+DROP INDEX products_department_id_idx;
+
+ALTER TABLE products
+    DROP owner_id,
+    DROP department_id;
+
+DROP INDEX users_department_id_idx;
+
+ALTER TABLE users
+    DROP department_id;
@@ -0,0 +1,8 @@
+-- +migrate Up
+
+INSERT INTO permissions (key, name, parent_id, created_at, updated_at)
+VALUES ('GET /api/v1/authz/explain', '解释授权决策', (SELECT id FROM (SELECT id FROM permissions WHERE key = '/permissions') AS t), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))), (SELECT EXTRACT(EPOCH FROM now()::timestamp(0))));
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE key IN ('GET /api/v1/authz/explain');
@@ -0,0 +1,30 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS role_grants
+(
+    id         bigserial NOT NULL,
+    tenant_id  bigint    NOT NULL DEFAULT 0,
+    user_id    bigint    NOT NULL DEFAULT 0,
+    role_id    bigint    NOT NULL DEFAULT 0,
+    starts_at  bigint    NOT NULL DEFAULT 0,
+    expires_at bigint    NOT NULL DEFAULT 0,
+    created_at bigint    NOT NULL DEFAULT 0,
+    updated_at bigint    NOT NULL DEFAULT 0,
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX ON role_grants (tenant_id, user_id);
+CREATE INDEX ON role_grants (starts_at);
+CREATE INDEX ON role_grants (expires_at);
+
+COMMENT ON COLUMN role_grants.tenant_id IS '租户 id';
+COMMENT ON COLUMN role_grants.user_id IS '用户 id';
+COMMENT ON COLUMN role_grants.role_id IS '角色 id';
+COMMENT ON COLUMN role_grants.starts_at IS '生效时间';
+COMMENT ON COLUMN role_grants.expires_at IS '过期时间';
+
+COMMENT ON TABLE role_grants IS '角色授予表';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS role_grants;
@@ -0,0 +1,20 @@
+-- +migrate Up
+
+ALTER TABLE audit_logs
+    ADD tenant_id bigint NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN audit_logs.tenant_id IS '租户 id';
+
+CREATE INDEX audit_logs_tenant_id_idx ON audit_logs (tenant_id);
+
+UPDATE audit_logs
+SET tenant_id = users.tenant_id
+FROM users
+WHERE users.id = audit_logs.user_id;
+
+-- +migrate Down
+
+DROP INDEX audit_logs_tenant_id_idx;
+
+ALTER TABLE audit_logs
+    DROP tenant_id;
@@ -0,0 +1,15 @@
+-- +migrate Up
+
+ALTER TABLE products
+    ADD tenant_id bigint NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN products.tenant_id IS '租户 id';
+
+CREATE INDEX products_tenant_id_idx ON products (tenant_id);
+
+-- +migrate Down
+
+DROP INDEX products_tenant_id_idx;
+
+ALTER TABLE products
+    DROP tenant_id;
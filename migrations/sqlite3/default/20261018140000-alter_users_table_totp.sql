@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE `users` ADD `totp_secret` varchar(64) NOT NULL DEFAULT ''; -- TOTP 密钥
+ALTER TABLE `users` ADD `totp_enabled_at` bigint NOT NULL DEFAULT 0; -- TOTP 启用时间
+ALTER TABLE `users` ADD `totp_recovery_codes` varchar(1024) NOT NULL DEFAULT ''; -- TOTP 恢复码摘要
+
+-- +migrate Down
+
+ALTER TABLE `users` DROP `totp_secret`;
+ALTER TABLE `users` DROP `totp_enabled_at`;
+ALTER TABLE `users` DROP `totp_recovery_codes`;
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE `users` ADD `email` varchar(255) NOT NULL DEFAULT ''; -- 邮箱
+ALTER TABLE `users` ADD `email_verified_at` bigint NOT NULL DEFAULT 0; -- 邮箱验证时间
+
+CREATE INDEX users_email ON users (email);
+
+-- +migrate Down
+
+DROP INDEX users_email;
+
+ALTER TABLE `users` DROP `email`;
+ALTER TABLE `users` DROP `email_verified_at`;
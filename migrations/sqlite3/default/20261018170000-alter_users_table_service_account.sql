@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE `users` ADD `service_account` boolean NOT NULL DEFAULT false; -- 是否为服务账号
+
+-- +migrate Down
+
+ALTER TABLE `users` DROP `service_account`;
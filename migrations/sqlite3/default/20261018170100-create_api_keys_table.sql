@@ -0,0 +1,23 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `api_keys`
+(
+    `id`           integer PRIMARY KEY AUTOINCREMENT,
+    `user_id`      integer            NOT NULL DEFAULT 0,  -- 服务账号 id
+    `name`         varchar(64)        NOT NULL DEFAULT '', -- 名称
+    `prefix`       varchar(32) UNIQUE NOT NULL DEFAULT '', -- 密钥前缀
+    `hash`         varchar(64)        NOT NULL DEFAULT '', -- 密钥摘要
+    `scopes`       text               NOT NULL DEFAULT '', -- 授权范围
+    `expires_at`   bigint             NOT NULL DEFAULT 0,  -- 过期时间
+    `last_used_at` bigint             NOT NULL DEFAULT 0,  -- 最后使用时间
+    `created_at`   bigint             NOT NULL DEFAULT 0,
+    `updated_at`   bigint             NOT NULL DEFAULT 0,
+    `deleted_at`   bigint unsigned    NOT NULL DEFAULT 0
+);
+
+CREATE INDEX api_keys_user_id ON api_keys (user_id);
+CREATE INDEX api_keys_deleted_at ON api_keys (deleted_at);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `api_keys`;
@@ -0,0 +1,18 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `user_identities`
+(
+    `id`         integer PRIMARY KEY AUTOINCREMENT,
+    `user_id`    integer      NOT NULL DEFAULT 0,  -- 用户 id
+    `provider`   varchar(64)  NOT NULL DEFAULT '', -- 身份提供方
+    `subject`    varchar(255) NOT NULL DEFAULT '', -- 身份提供方的用户标识
+    `created_at` bigint       NOT NULL DEFAULT 0,
+    `updated_at` bigint       NOT NULL DEFAULT 0,
+    UNIQUE (`provider`, `subject`)
+);
+
+CREATE INDEX user_identities_user_id ON user_identities (user_id);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `user_identities`;
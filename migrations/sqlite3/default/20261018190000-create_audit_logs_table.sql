@@ -0,0 +1,25 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `audit_logs`
+(
+    `id`         integer PRIMARY KEY AUTOINCREMENT,
+    `actor_id`   integer      NOT NULL DEFAULT 0,  -- 操作人 id
+    `user_id`    integer      NOT NULL DEFAULT 0,  -- 被模拟的用户 id
+    `action`     varchar(32)  NOT NULL DEFAULT '', -- 操作
+    `method`     varchar(16)  NOT NULL DEFAULT '', -- 请求方法
+    `path`       varchar(255) NOT NULL DEFAULT '', -- 请求路径
+    `status`     integer      NOT NULL DEFAULT 0,  -- 响应状态码
+    `ip`         varchar(64)  NOT NULL DEFAULT '', -- 客户端 IP
+    `user_agent` varchar(255) NOT NULL DEFAULT '', -- 客户端 User-Agent
+    `detail`     varchar(255) NOT NULL DEFAULT '', -- 详情
+    `created_at` bigint       NOT NULL DEFAULT 0,
+    `updated_at` bigint       NOT NULL DEFAULT 0
+);
+
+CREATE INDEX audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX audit_logs_user_id ON audit_logs (user_id);
+CREATE INDEX audit_logs_created_at ON audit_logs (created_at);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `audit_logs`;
@@ -0,0 +1,12 @@
+-- +migrate Up
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('POST /api/v1/user/:id/impersonate', '模拟用户登录', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), strftime('%s', 'now'), strftime('%s', 'now'));
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at) VALUES ('/audit-logs', '审计日志', 0, strftime('%s', 'now'), strftime('%s', 'now'));
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('GET /api/v1/audit-logs', '审计日志列表', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/audit-logs') AS t), strftime('%s', 'now'), strftime('%s', 'now'));
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE `key` IN ('POST /api/v1/user/:id/impersonate', 'GET /api/v1/audit-logs', '/audit-logs');
@@ -0,0 +1,68 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `tenants`
+(
+    `id`         integer PRIMARY KEY AUTOINCREMENT,
+    `name`       varchar(64) UNIQUE NOT NULL DEFAULT '', -- 租户名称
+    `created_at` bigint             NOT NULL DEFAULT 0,
+    `updated_at` bigint             NOT NULL DEFAULT 0,
+    `deleted_at` bigint unsigned    NOT NULL DEFAULT 0
+);
+
+CREATE INDEX tenants_deleted_at ON tenants (deleted_at);
+
+INSERT INTO `tenants` (`id`, `name`, `created_at`, `updated_at`)
+VALUES (1, 'default', strftime('%s', 'now'), strftime('%s', 'now'));
+
+ALTER TABLE `users` ADD `tenant_id` integer NOT NULL DEFAULT 1; -- 所属租户 id
+
+CREATE INDEX users_tenant_id ON users (tenant_id);
+
+-- the unique constraint of the role name can not be dropped, the table is rebuilt
+CREATE TABLE IF NOT EXISTS `roles_tmp`
+(
+    `id`         integer PRIMARY KEY AUTOINCREMENT,
+    `tenant_id`  integer         NOT NULL DEFAULT 1,  -- 租户 id
+    `name`       varchar(32)     NOT NULL DEFAULT '', -- 角色名称
+    `created_at` bigint          NOT NULL DEFAULT 0,
+    `updated_at` bigint          NOT NULL DEFAULT 0,
+    `deleted_at` bigint unsigned NOT NULL DEFAULT 0
+);
+
+INSERT INTO `roles_tmp` (`id`, `name`, `created_at`, `updated_at`, `deleted_at`)
+SELECT `id`, `name`, `created_at`, `updated_at`, `deleted_at`
+FROM `roles`;
+
+DROP TABLE `roles`;
+
+ALTER TABLE `roles_tmp` RENAME TO `roles`;
+
+CREATE UNIQUE INDEX roles_tenant_id_name ON roles (tenant_id, name);
+CREATE INDEX roles_deleted_at ON roles (deleted_at);
+
+-- +migrate Down
+
+CREATE TABLE IF NOT EXISTS `roles_tmp`
+(
+    `id`         integer PRIMARY KEY AUTOINCREMENT,
+    `name`       varchar(32) UNIQUE NOT NULL DEFAULT '', -- 角色名称
+    `created_at` bigint             NOT NULL DEFAULT 0,
+    `updated_at` bigint             NOT NULL DEFAULT 0,
+    `deleted_at` bigint unsigned    NOT NULL DEFAULT 0
+);
+
+INSERT INTO `roles_tmp` (`id`, `name`, `created_at`, `updated_at`, `deleted_at`)
+SELECT `id`, `name`, `created_at`, `updated_at`, `deleted_at`
+FROM `roles`;
+
+DROP TABLE `roles`;
+
+ALTER TABLE `roles_tmp` RENAME TO `roles`;
+
+CREATE INDEX roles_deleted_at ON roles (deleted_at);
+
+DROP INDEX users_tenant_id;
+
+ALTER TABLE `users` DROP `tenant_id`;
+
+DROP TABLE IF EXISTS `tenants`;
@@ -0,0 +1,29 @@
+-- +migrate Up
+
+ALTER TABLE `users` ADD `department_id` bigint NOT NULL DEFAULT 0; -- 所属部门 id
+
+CREATE INDEX users_department_id ON users (department_id);
+
+ALTER TABLE `products` ADD `owner_id` bigint NOT NULL DEFAULT 0; -- 创建人 id
+ALTER TABLE `products` ADD `department_id` bigint NOT NULL DEFAULT 0; -- 所属部门 id
+
+CREATE INDEX products_owner_id ON products (owner_id);
+CREATE INDEX products_department_id ON products (department_id);
+
+ALTER TABLE `roles` ADD `data_scope` varchar(16) NOT NULL DEFAULT 'all'; -- 数据范围：all 全部，own 本人，department 本部门，custom 自定义部门
+ALTER TABLE `roles` ADD `data_scope_departments` varchar(255) NOT NULL DEFAULT ''; -- 自定义数据范围的部门 id，逗号分隔
+
+-- +migrate Down
+
+ALTER TABLE `roles` DROP `data_scope`;
+ALTER TABLE `roles` DROP `data_scope_departments`;
+
+DROP INDEX products_owner_id;
+DROP INDEX products_department_id;
+
+ALTER TABLE `products` DROP `owner_id`;
+ALTER TABLE `products` DROP `department_id`;
+
+DROP INDEX users_department_id;
+
+ALTER TABLE `users` DROP `department_id`;
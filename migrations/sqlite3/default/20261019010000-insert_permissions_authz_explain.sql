@@ -0,0 +1,8 @@
+-- +migrate Up
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('GET /api/v1/authz/explain', '解释授权决策', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/permissions') AS t), strftime('%s', 'now'), strftime('%s', 'now'));
+
+-- +migrate Down
+
+DELETE FROM permissions WHERE `key` IN ('GET /api/v1/authz/explain');
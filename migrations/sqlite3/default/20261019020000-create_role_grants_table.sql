@@ -0,0 +1,21 @@
+-- +migrate Up
+
+CREATE TABLE IF NOT EXISTS `role_grants`
+(
+    `id`         integer PRIMARY KEY AUTOINCREMENT,
+    `tenant_id`  integer NOT NULL DEFAULT 0, -- 租户 id
+    `user_id`    integer NOT NULL DEFAULT 0, -- 用户 id
+    `role_id`    integer NOT NULL DEFAULT 0, -- 角色 id
+    `starts_at`  bigint  NOT NULL DEFAULT 0, -- 生效时间
+    `expires_at` bigint  NOT NULL DEFAULT 0, -- 过期时间
+    `created_at` bigint  NOT NULL DEFAULT 0,
+    `updated_at` bigint  NOT NULL DEFAULT 0
+);
+
+CREATE INDEX role_grants_tenant_id_user_id ON role_grants (tenant_id, user_id);
+CREATE INDEX role_grants_starts_at ON role_grants (starts_at);
+CREATE INDEX role_grants_expires_at ON role_grants (expires_at);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS `role_grants`;
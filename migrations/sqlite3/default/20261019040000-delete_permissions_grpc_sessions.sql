@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- the account sessions are self-service over gRPC as well, they require no permission
+DELETE FROM permissions WHERE `key` IN ('/internal.app.adapter.grpc.api.v1.account.Account/ListSessions', '/internal.app.adapter.grpc.api.v1.account.Account/RevokeSession');
+
+-- +migrate Down
+
+INSERT INTO permissions (`key`, name, parent_id, created_at, updated_at)
+VALUES ('/internal.app.adapter.grpc.api.v1.account.Account/ListSessions', '用户会话列表（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), strftime('%s', 'now'), strftime('%s', 'now')),
+       ('/internal.app.adapter.grpc.api.v1.account.Account/RevokeSession', '撤销用户会话（gRPC）', (SELECT id FROM (SELECT id FROM permissions WHERE `key` = '/users') AS t), strftime('%s', 'now'), strftime('%s', 'now'));
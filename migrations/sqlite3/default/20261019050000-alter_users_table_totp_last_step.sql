@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE `users` ADD `totp_last_step` bigint NOT NULL DEFAULT 0; -- 最后使用的 TOTP 时间步
+
+-- +migrate Down
+
+ALTER TABLE `users` DROP `totp_last_step`;
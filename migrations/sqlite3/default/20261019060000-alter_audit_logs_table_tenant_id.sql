@@ -0,0 +1,15 @@
+-- +migrate Up
+
+ALTER TABLE `audit_logs` ADD `tenant_id` integer NOT NULL DEFAULT 1; -- 租户 id
+
+CREATE INDEX audit_logs_tenant_id ON audit_logs (tenant_id);
+
+UPDATE `audit_logs`
+SET `tenant_id` = (SELECT `tenant_id` FROM `users` WHERE `users`.`id` = `audit_logs`.`user_id`)
+WHERE EXISTS (SELECT 1 FROM `users` WHERE `users`.`id` = `audit_logs`.`user_id`);
+
+-- +migrate Down
+
+DROP INDEX audit_logs_tenant_id;
+
+ALTER TABLE `audit_logs` DROP `tenant_id`;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE `products` ADD `tenant_id` integer NOT NULL DEFAULT 1; -- 租户 id
+
+CREATE INDEX products_tenant_id ON products (tenant_id);
+
+-- +migrate Down
+
+DROP INDEX products_tenant_id;
+
+ALTER TABLE `products` DROP `tenant_id`;
@@ -0,0 +1,7 @@
+-- +migrate Up
+
+ALTER TABLE `role_grants` ADD `activated_at` bigint NOT NULL DEFAULT 0; -- 策略写入时间，0 为尚未写入
+
+-- +migrate Down
+
+ALTER TABLE `role_grants` DROP `activated_at`;
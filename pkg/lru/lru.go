@@ -0,0 +1,121 @@
+package lru
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru"
+)
+
+// Stats the statistics of the cache
+type Stats struct {
+	Size     int    // the number of the entries, including the expired ones that have not been evicted
+	Capacity int    // the maximum number of the entries
+	Hits     uint64 // the lookups that find the entry
+	Misses   uint64 // the lookups that find no entry or the expired one
+}
+
+// HitRatio the ratio of the hits to the lookups, 0 if there is no lookup
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Cache the thread-safe LRU cache, the entries expire after the ttl,
+// the hits and misses of the lookups are counted.
+// The nil cache is valid, it caches nothing
+type Cache[K comparable, V any] struct {
+	capacity int
+	ttl      time.Duration
+	entries  *lru.Cache
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time // zero if never expires
+}
+
+// New create a cache holding at most size entries, the entries never expire if ttl is 0
+func New[K comparable, V any](size int, ttl time.Duration) (*Cache[K, V], error) {
+	entries, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache[K, V]{
+		capacity: size,
+		ttl:      ttl,
+		entries:  entries,
+	}, nil
+}
+
+// Get look up the entry of the key, the expired entry is removed
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	if c == nil {
+		return value, false
+	}
+
+	v, ok := c.entries.Get(key)
+	if !ok {
+		c.misses.Add(1)
+		return value, false
+	}
+
+	e := v.(entry[V])
+	if !e.expiresAt.IsZero() && !time.Now().Before(e.expiresAt) {
+		c.entries.Remove(key)
+		c.misses.Add(1)
+		return value, false
+	}
+
+	c.hits.Add(1)
+	return e.value, true
+}
+
+// Add add or replace the entry of the key, the least recently used entry is evicted if the cache is full
+func (c *Cache[K, V]) Add(key K, value V) {
+	if c == nil {
+		return
+	}
+
+	e := entry[V]{value: value}
+	if c.ttl > 0 {
+		e.expiresAt = time.Now().Add(c.ttl)
+	}
+	c.entries.Add(key, e)
+}
+
+// Remove remove the entry of the key
+func (c *Cache[K, V]) Remove(key K) {
+	if c == nil {
+		return
+	}
+	c.entries.Remove(key)
+}
+
+// Purge remove all the entries, the statistics are kept
+func (c *Cache[K, V]) Purge() {
+	if c == nil {
+		return
+	}
+	c.entries.Purge()
+}
+
+// Stats returns the statistics of the cache
+func (c *Cache[K, V]) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+
+	return Stats{
+		Size:     c.entries.Len(),
+		Capacity: c.capacity,
+		Hits:     c.hits.Load(),
+		Misses:   c.misses.Load(),
+	}
+}
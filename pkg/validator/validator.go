@@ -2,6 +2,7 @@ package validator
 
 import (
 	"errors"
+	"net/mail"
 	"unicode"
 
 	"github.com/nyaruka/phonenumbers"
@@ -14,6 +15,9 @@ var (
 	// ErrInvalidPhoneNumber invalid phone number
 	ErrInvalidPhoneNumber = errors.New("phone number format is invalid")
 
+	// ErrInvalidEmail invalid email address
+	ErrInvalidEmail = errors.New("email format is invalid")
+
 	// ErrPasswordComplexityTooLow password complexity too low
 	ErrPasswordComplexityTooLow = errors.New("password complexity too low")
 )
@@ -39,6 +43,26 @@ func IsPhoneNumber(value any) error {
 	return nil
 }
 
+// IsEmail check whether it is a bare email address, such as "user@example.com"
+//
+// the empty string is allowed, use validation.Required if the email is required
+func IsEmail(value any) error {
+	email, ok := value.(string)
+	if !ok {
+		return ErrAssertTypeToStringFailed
+	}
+	if email == "" {
+		return nil
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return ErrInvalidEmail
+	}
+
+	return nil
+}
+
 // PasswordComplexity validate password complexity
 func PasswordComplexity(value any) error {
 	password, ok := value.(string)